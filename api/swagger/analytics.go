@@ -15,3 +15,24 @@ type WarehouseAnalyticsAtListResponseWrapper struct {
 	// in: body
 	Body []dto.WarehouseAnalyticsAtListResponse
 }
+
+// SalesTimeSeriesResponse swagger response
+// swagger:response SalesTimeSeriesResponse
+type SalesTimeSeriesResponseWrapper struct {
+	// in: body
+	Body dto.SalesTimeSeriesResponse
+}
+
+// TopProductsResponse swagger response
+// swagger:response TopProductsResponse
+type TopProductsResponseWrapper struct {
+	// in: body
+	Body []dto.TopProductResponse
+}
+
+// ProductAnalyticsResponse swagger response
+// swagger:response ProductAnalyticsResponse
+type ProductAnalyticsResponseWrapper struct {
+	// in: body
+	Body dto.ProductAnalyticsResponse
+}
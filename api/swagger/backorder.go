@@ -0,0 +1,10 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// BackordersResponse swagger response
+// swagger:response BackordersResponse
+type BackordersResponseWrapper struct {
+	// in: body
+	Body dto.BackordersResponse
+}
@@ -0,0 +1,24 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// BatchResponse swagger response
+// swagger:response BatchResponse
+type BatchResponseWrapper struct {
+	// in: body
+	Body dto.BatchResponse
+}
+
+// BatchesResponse swagger response
+// swagger:response BatchesResponse
+type BatchesResponseWrapper struct {
+	// in: body
+	Body dto.BatchesResponse
+}
+
+// ExpiringBatchesResponse swagger response
+// swagger:response ExpiringBatchesResponse
+type ExpiringBatchesResponseWrapper struct {
+	// in: body
+	Body dto.ExpiringBatchesResponse
+}
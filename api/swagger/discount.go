@@ -0,0 +1,17 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// DiscountRuleResponse swagger response
+// swagger:response DiscountRuleResponse
+type DiscountRuleResponseWrapper struct {
+	// in: body
+	Body dto.DiscountRuleResponse
+}
+
+// DiscountRulesResponse swagger response
+// swagger:response DiscountRulesResponse
+type DiscountRulesResponseWrapper struct {
+	// in: body
+	Body dto.DiscountRulesResponse
+}
@@ -0,0 +1,10 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// FulfillmentResponse swagger response
+// swagger:response FulfillmentResponse
+type FulfillmentResponseWrapper struct {
+	// in: body
+	Body dto.FulfillmentResponse
+}
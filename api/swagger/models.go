@@ -77,3 +77,135 @@ type ProductAnalytic dto.ProductAnalytic
 
 // swagger:model WarehouseAnalyticsAtListResponse
 type WarehouseAnalyticsAtListResponse dto.WarehouseAnalyticsAtListResponse
+
+// swagger:model SalesTimeSeriesResponse
+type SalesTimeSeriesResponse dto.SalesTimeSeriesResponse
+
+// swagger:model SalesPointResponse
+type SalesPointResponse dto.SalesPointResponse
+
+// swagger:model TopProductResponse
+type TopProductResponse dto.TopProductResponse
+
+// swagger:model ProductAnalyticsResponse
+type ProductAnalyticsResponse dto.ProductAnalyticsResponse
+
+// swagger:model ProductAtWarehouseAnalytic
+type ProductAtWarehouseAnalytic dto.ProductAtWarehouseAnalytic
+
+// swagger:model StockMovementsResponse
+type StockMovementsResponse dto.StockMovementsResponse
+
+// swagger:model StockMovementResponse
+type StockMovementResponse dto.StockMovementResponse
+
+// swagger:model TransferRequest
+type TransferRequest dto.TransferRequest
+
+// swagger:model TransferProductRequest
+type TransferProductRequest dto.TransferProductRequest
+
+// swagger:model TransferResponse
+type TransferResponse dto.TransferResponse
+
+// swagger:model TransferProductResponse
+type TransferProductResponse dto.TransferProductResponse
+
+// swagger:model OrderStatusRequest
+type OrderStatusRequest dto.OrderStatusRequest
+
+// swagger:model OrderResponse
+type OrderResponse dto.OrderResponse
+
+// swagger:model OrderLineResponse
+type OrderLineResponse dto.OrderLineResponse
+
+// swagger:model OrderCancelRequest
+type OrderCancelRequest dto.OrderCancelRequest
+
+// swagger:model OrderReturnRequest
+type OrderReturnRequest dto.OrderReturnRequest
+
+// swagger:model OrderReturnProductRequest
+type OrderReturnProductRequest dto.OrderReturnProductRequest
+
+// swagger:model OrderReturnResponse
+type OrderReturnResponse dto.OrderReturnResponse
+
+// swagger:model OrderReturnProductResponse
+type OrderReturnProductResponse dto.OrderReturnProductResponse
+
+// swagger:model DiscountRuleRequest
+type DiscountRuleRequest dto.DiscountRuleRequest
+
+// swagger:model DiscountRuleResponse
+type DiscountRuleResponse dto.DiscountRuleResponse
+
+// swagger:model PromoCodeRequest
+type PromoCodeRequest dto.PromoCodeRequest
+
+// swagger:model PromoCodeResponse
+type PromoCodeResponse dto.PromoCodeResponse
+
+// swagger:model PricingRuleRequest
+type PricingRuleRequest dto.PricingRuleRequest
+
+// swagger:model PricingRuleResponse
+type PricingRuleResponse dto.PricingRuleResponse
+
+// swagger:model AppliedPricingRuleResponse
+type AppliedPricingRuleResponse dto.AppliedPricingRuleResponse
+
+// swagger:model ChangeProductPriceRequest
+type ChangeProductPriceRequest dto.ChangeProductPriceRequest
+
+// swagger:model PriceChangeResponse
+type PriceChangeResponse dto.PriceChangeResponse
+
+// swagger:model StockThresholdsRequest
+type StockThresholdsRequest dto.StockThresholdsRequest
+
+// swagger:model LowStockProductResponse
+type LowStockProductResponse dto.LowStockProductResponse
+
+// swagger:model BackorderLimitRequest
+type BackorderLimitRequest dto.BackorderLimitRequest
+
+// swagger:model BackorderResponse
+type BackorderResponse dto.BackorderResponse
+
+// swagger:model BatchRequest
+type BatchRequest dto.BatchRequest
+
+// swagger:model BatchResponse
+type BatchResponse dto.BatchResponse
+
+// swagger:model SerialResponse
+type SerialResponse dto.SerialResponse
+
+// swagger:model StocktakeRequest
+type StocktakeRequest dto.StocktakeRequest
+
+// swagger:model StocktakeCountsRequest
+type StocktakeCountsRequest dto.StocktakeCountsRequest
+
+// swagger:model StocktakePostRequest
+type StocktakePostRequest dto.StocktakePostRequest
+
+// swagger:model SupplierRequest
+type SupplierRequest dto.SupplierRequest
+
+// swagger:model PurchaseOrderRequest
+type PurchaseOrderRequest dto.PurchaseOrderRequest
+
+// swagger:model PurchaseOrderReceiptRequest
+type PurchaseOrderReceiptRequest dto.PurchaseOrderReceiptRequest
+
+// swagger:model ProductValuationResponse
+type ProductValuationResponse dto.ProductValuationResponse
+
+// swagger:model FulfillmentRequest
+type FulfillmentRequest dto.FulfillmentRequest
+
+// swagger:model ShipmentResponse
+type ShipmentResponse dto.ShipmentResponse
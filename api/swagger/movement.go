@@ -0,0 +1,10 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// StockMovementsResponse swagger response
+// swagger:response StockMovementsResponse
+type StockMovementsResponseWrapper struct {
+	// in: body
+	Body dto.StockMovementsResponse
+}
@@ -0,0 +1,24 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// OrderResponse swagger response
+// swagger:response OrderResponse
+type OrderResponseWrapper struct {
+	// in: body
+	Body dto.OrderResponse
+}
+
+// OrdersResponse swagger response
+// swagger:response OrdersResponse
+type OrdersResponseWrapper struct {
+	// in: body
+	Body dto.OrdersResponse
+}
+
+// OrderReturnResponse swagger response
+// swagger:response OrderReturnResponse
+type OrderReturnResponseWrapper struct {
+	// in: body
+	Body dto.OrderReturnResponse
+}
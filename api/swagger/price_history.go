@@ -0,0 +1,17 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// PriceChangeResponse swagger response
+// swagger:response PriceChangeResponse
+type PriceChangeResponseWrapper struct {
+	// in: body
+	Body dto.PriceChangeResponse
+}
+
+// PriceHistoryResponse swagger response
+// swagger:response PriceHistoryResponse
+type PriceHistoryResponseWrapper struct {
+	// in: body
+	Body dto.PriceHistoryResponse
+}
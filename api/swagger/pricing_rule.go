@@ -0,0 +1,17 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// PricingRuleResponse swagger response
+// swagger:response PricingRuleResponse
+type PricingRuleResponseWrapper struct {
+	// in: body
+	Body dto.PricingRuleResponse
+}
+
+// PricingRulesResponse swagger response
+// swagger:response PricingRulesResponse
+type PricingRulesResponseWrapper struct {
+	// in: body
+	Body dto.PricingRulesResponse
+}
@@ -5,7 +5,7 @@ import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
 // ProductResponse represents a response containing product details.
 // swagger:response ProductResponse
 type ProductResponse struct {
-	// A list of products
+	// A page of products with total count of matching products
 	// in: body
-	Body []dto.ProductAtListResponse
+	Body dto.ProductListResponse
 }
@@ -0,0 +1,17 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// PromoCodeResponse swagger response
+// swagger:response PromoCodeResponse
+type PromoCodeResponseWrapper struct {
+	// in: body
+	Body dto.PromoCodeResponse
+}
+
+// PromoCodesResponse swagger response
+// swagger:response PromoCodesResponse
+type PromoCodesResponseWrapper struct {
+	// in: body
+	Body dto.PromoCodesResponse
+}
@@ -0,0 +1,31 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// SupplierResponse swagger response
+// swagger:response SupplierResponse
+type SupplierResponseWrapper struct {
+	// in: body
+	Body dto.SupplierResponse
+}
+
+// SuppliersResponse swagger response
+// swagger:response SuppliersResponse
+type SuppliersResponseWrapper struct {
+	// in: body
+	Body []dto.SupplierResponse
+}
+
+// PurchaseOrderResponse swagger response
+// swagger:response PurchaseOrderResponse
+type PurchaseOrderResponseWrapper struct {
+	// in: body
+	Body dto.PurchaseOrderResponse
+}
+
+// PurchaseOrdersResponse swagger response
+// swagger:response PurchaseOrdersResponse
+type PurchaseOrdersResponseWrapper struct {
+	// in: body
+	Body dto.PurchaseOrdersResponse
+}
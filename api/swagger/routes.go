@@ -16,7 +16,7 @@ package swagger
 //   500: ErrorResponse
 
 // swagger:route POST /warehouses warehouses createWarehouse
-// Creates a warehouse
+// Creates a warehouse. Latitude and longitude are optional and must be set together
 //
 // responses:
 //   201: none
@@ -24,14 +24,17 @@ package swagger
 //   422: ErrorResponse
 
 // swagger:route GET /products products getProducts
-// Returns list of products
+// Returns page of products with total count. Supports name (substring of name), q (full-text search
+// over name and description), params.<key>=<value> filters on product params, sort (name or weight),
+// order (asc or desc), archived (true to list archived products instead of active ones), page and limit query params
 //
 // responses:
 //   200: ProductResponse
+//   400: ErrorResponse
 //   500: ErrorResponse
 
 // swagger:route POST /products products addProduct
-// Adds a product
+// Adds a product. Set serialized form field to true to track every unit by serial number
 //
 // responses:
 //   201: none
@@ -54,26 +57,109 @@ package swagger
 //   400: ErrorResponse
 //   500: ErrorResponse
 
+// swagger:route DELETE /product/{id} products deleteProduct
+// Delete product. Product that is still in stock at some warehouse cannot be deleted.
+// Product referenced by orders or history cannot be deleted either: archive it instead
+//
+// responses:
+//   204: none
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /product/{id}/archive products archiveProduct
+// Archive product. Archived product is hidden from catalog and warehouse stock and cannot be sold
+//
+// responses:
+//   204: none
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /product/{id}/restore products restoreProduct
+// Restore archived product
+//
+// responses:
+//   204: none
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   500: ErrorResponse
+
 // swagger:route POST /inventory inventory createInventory
-// Create inventory record
+// Create inventory record. Serialized products require one serial number per unit.
+// Supports Idempotency-Key header
 //
 // responses:
 //   201: none
 //   400: ErrorResponse
 //   409: ErrorResponse
+//   422: ErrorResponse
 //   500: ErrorResponse
 
 // swagger:route POST /inventory/change_count inventory changeProductCount
-// Change product count in warehouse
+// Change product count in warehouse. Serialized products require one serial number per unit.
+// Supports Idempotency-Key header
+//
+// responses:
+//   204: none
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /inventory/stock_thresholds inventory setStockThresholds
+// Set min_quantity and reorder_quantity of product in warehouse. When product count falls to min_quantity
+// after a purchase, or rises above it after change_count, a stock alert is sent. min_quantity 0 disables alerts
 //
 // responses:
 //   204: none
 //   400: ErrorResponse
 //   404: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /inventory/backorder_limit inventory setBackorderLimit
+// Set backorder_limit of product in warehouse: how many units may be sold beyond stock.
+// The shortfall of a purchase is recorded as a backorder and allocated first-in-first-out when stock arrives.
+// backorder_limit 0 disables backorders. Serialized products cannot be backordered
+//
+// responses:
+//   204: none
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /inventory/batches inventory receiveBatch
+// Receive a batch of product at warehouse with lot number and optional expiry date.
+// Product count grows by batch quantity. Supports Idempotency-Key header
+//
+// responses:
+//   201: BatchResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /inventory/change_price inventory changeProductPrice
+// Change product price in warehouse and optionally its discount. Old and new values,
+// changed_by and request id are written to price history
+//
+// responses:
+//   200: PriceChangeResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   422: ErrorResponse
 //   500: ErrorResponse
 
 // swagger:route POST /inventory/add_discount inventory addDiscount
-// Add discount to products
+// Add discount to products. The discount is used only when no discount rule is active for the product.
+// Every change is written to price history
 //
 // responses:
 //   204: none
@@ -81,16 +167,107 @@ package swagger
 //   404: ErrorResponse
 //   500: ErrorResponse
 
+// swagger:route POST /inventory/transfer transfers createTransfer
+// Transfer products between warehouses. If in_transit is set, products stay in transit until received.
+// Supports Idempotency-Key header
+//
+// responses:
+//   201: TransferResponse
+//   400: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /inventory/transfer/{id} transfers getTransfer
+// Get transfer information
+//
+// responses:
+//   200: TransferResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /inventory/transfer/{id}/receive transfers receiveTransfer
+// Receive in-transit transfer at destination warehouse. Supports Idempotency-Key header
+//
+// responses:
+//   200: TransferResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   500: ErrorResponse
+
 // swagger:route GET /warehouse/{id} inventory getWarehouseProducts
-// Returns products at warehouse or one product if query provided
+// Returns products at warehouse or one product if query provided.
+// The list supports sort (name, price, discount_price or count), order (asc or desc), in_stock, discounted,
+// min_price and max_price (by price with discount) query params. Pages are taken by limit and cursor:
+// pass next_cursor of previous response as cursor with the same sort and order. page is used without cursor
 //
 // responses:
 //   200: ProductsResponse
+//   400: ErrorResponse
 //   404: ErrorResponse
 //   500: ErrorResponse
 
+// swagger:route GET /warehouse/{id}/movements inventory getStockMovements
+// Returns stock movement ledger of warehouse. Supports product_id, from, to, page and limit query params
+// Corrections posted by stock-take carry stocktake_id, receipts by purchase order carry purchase_order_id
+//
+// responses:
+//   200: StockMovementsResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /warehouse/{id}/low_stock inventory getLowStock
+// Returns products of warehouse with count not greater than min_quantity. Supports page and limit query params
+//
+// responses:
+//   200: LowStockResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /warehouse/{id}/backorders inventory getBackorders
+// Returns open backorders of warehouse in allocation order. Supports page and limit query params
+//
+// responses:
+//   200: BackordersResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /warehouse/{id}/batches inventory getBatches
+// Returns batches of warehouse that still hold stock, in picking order. Supports product_id, page and limit query params
+//
+// responses:
+//   200: BatchesResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /warehouse/{id}/expiring inventory getExpiringBatches
+// Returns batches of warehouse that expire within days (7 by default, at most 36500), including already expired ones.
+// Supports days, page and limit query params
+//
+// responses:
+//   200: ExpiringBatchesResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /warehouse/{id}/valuation inventory getStockValuation
+// Returns cost of warehouse stock by cost layers and the configured valuation method (fifo or average).
+// Units without known cost are reported as uncosted_count. Supports page and limit query params
+//
+// responses:
+//   200: StockValuationResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /warehouse/{id}/price_history inventory getPriceHistory
+// Returns price and discount changes of warehouse from newest to oldest. Supports product_id, from, to, page and limit query params
+//
+// responses:
+//   200: PriceHistoryResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
 // swagger:route POST /inventory/check_cart inventory checkCart
-// Calculate cart
+// Calculate cart. Active pricing rules are applied and each product lists the rules that fired.
+// If promo_code is set, it is checked and its savings are shown, but it is not redeemed
 //
 // responses:
 //   200: CartResponse
@@ -98,23 +275,405 @@ package swagger
 //   500: ErrorResponse
 
 // swagger:route POST /inventory/buy inventory buyProducts
-// Buy products
+// Buy products and create an order. Active pricing rules are applied and stored in order lines.
+// Stock is taken from batches first-expired-first-out, then from stock without batch.
+// Units of serialized products are allocated oldest first and their serials are returned.
+// If promo_code is set, it is redeemed in the same transaction.
+// Products with backorder_limit may be sold beyond stock: the shortfall is returned as backordered_count.
+// Supports Idempotency-Key header: retries with the same key replay the first response
 //
 // responses:
 //   200: CartResponse
 //   400: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /fulfillment/plan fulfillment planFulfillment
+// Plan an order without a warehouse: products are split across warehouses by availability.
+// Strategy is fewest_shipments, cheapest or nearest; nearest requires latitude and longitude of the delivery.
+// preferred_warehouse_id is used first. Nothing is bought or held
+//
+// responses:
+//   200: FulfillmentResponse
+//   400: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /fulfillment/buy fulfillment fulfillOrder
+// Split an order across warehouses and buy every part in one transaction, one order per warehouse.
+// If stock is not enough, nothing is bought.
+// Supports Idempotency-Key header: retries with the same key replay the first response
+//
+// responses:
+//   200: FulfillmentResponse
+//   400: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /discounts discounts getDiscounts
+// Returns discount rules. Supports warehouse_id, product_id, active, page and limit query params
+//
+// responses:
+//   200: DiscountRulesResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /discounts discounts createDiscount
+// Create percent or fixed discount rule for product at warehouse with optional valid_from and valid_to.
+// Active rules are applied by priority: the top rule always applies; if it is stackable, other stackable rules apply too
+//
+// responses:
+//   201: DiscountRuleResponse
+//   400: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /discounts/{id} discounts getDiscount
+// Get discount rule
+//
+// responses:
+//   200: DiscountRuleResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route PUT /discounts/{id} discounts updateDiscount
+// Replace discount rule
+//
+// responses:
+//   200: DiscountRuleResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route DELETE /discounts/{id} discounts deleteDiscount
+// Delete discount rule
+//
+// responses:
+//   204: none
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /promo_codes promo_codes getPromoCodes
+// Returns promo codes. Supports warehouse_id, page and limit query params
+//
+// responses:
+//   200: PromoCodesResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /promo_codes promo_codes createPromoCode
+// Create percent or fixed promo code with optional min_total, warehouse_id, usage_limit and expires_at.
+// Promo code without warehouse_id works at every warehouse
+//
+// responses:
+//   201: PromoCodeResponse
+//   400: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /promo_codes/{id} promo_codes getPromoCode
+// Get promo code
+//
+// responses:
+//   200: PromoCodeResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route DELETE /promo_codes/{id} promo_codes deletePromoCode
+// Delete promo code. Orders keep the applied code and discount
+//
+// responses:
+//   204: none
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /pricing_rules pricing_rules getPricingRules
+// Returns pricing rules. Supports warehouse_id, page and limit query params
+//
+// responses:
+//   200: PricingRulesResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /pricing_rules pricing_rules createPricingRule
+// Create pricing rule: tier (product_id, min_count, unit_price), n_for_m (product_id, buy, pay)
+// or bundle (product_ids, percent). Rule without warehouse_id works at every warehouse.
+// Rules are applied by priority, each cart line gets discount from one rule at most
+//
+// responses:
+//   201: PricingRuleResponse
+//   400: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /pricing_rules/{id} pricing_rules getPricingRule
+// Get pricing rule
+//
+// responses:
+//   200: PricingRuleResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route PUT /pricing_rules/{id} pricing_rules updatePricingRule
+// Replace pricing rule
+//
+// responses:
+//   200: PricingRuleResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route DELETE /pricing_rules/{id} pricing_rules deletePricingRule
+// Delete pricing rule. Orders keep the applied rule discount
+//
+// responses:
+//   204: none
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /orders orders getOrders
+// Returns orders. Supports warehouse_id, from, to, page and limit query params
+//
+// responses:
+//   200: OrdersResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /orders/{id} orders getOrder
+// Get order with its lines
+//
+// responses:
+//   200: OrderResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /orders/{id}/status orders updateOrderStatus
+// Move order to another status: created -> paid -> shipped. Use cancel request to cancel order.
+// Order with backordered products cannot be shipped until they are in stock
+//
+// responses:
+//   200: OrderResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /orders/{id}/cancel orders cancelOrder
+// Cancel created or paid order and put its products back to warehouse or to quarantine.
+// Open backorders of order are cancelled.
+// Writes compensating analytics entries. Supports Idempotency-Key header
+//
+// responses:
+//   200: OrderReturnResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /orders/{id}/return orders returnOrderProducts
+// Return some products of paid or shipped order with reason. Products are put back to warehouse or to quarantine.
+// Serialized products require serial numbers of returned units.
+// Writes compensating analytics entries. Supports Idempotency-Key header
+//
+// responses:
+//   200: OrderReturnResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /stocktakes stocktakes createStocktake
+// Open stock-take for warehouse. If product_ids is empty, all products of warehouse are counted.
+// Expected counts are snapshotted at opening
+//
+// responses:
+//   201: StocktakeResponse
+//   400: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /stocktakes/{id} stocktakes getStocktake
+// Get stock-take with its lines
+//
+// responses:
+//   200: StocktakeResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /stocktakes/{id}/counts stocktakes submitStocktakeCounts
+// Submit counted quantities of products. Repeated submit overwrites previous count
+//
+// responses:
+//   200: StocktakeResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /stocktakes/{id}/variance stocktakes getStocktakeVariance
+// Returns variance report: counted quantities versus current inventory count, in units and in money
+//
+// responses:
+//   200: StocktakeVarianceResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /stocktakes/{id}/post stocktakes postStocktake
+// Post variances of approved products (all counted products if product_ids is empty) in one transaction
+// and close stock-take. Corrections are written to movement ledger with correction reason and stocktake_id.
+// Variances of serialized products cannot be posted.
+// Supports Idempotency-Key header: retries with the same key replay the first response
+//
+// responses:
+//   200: StocktakeResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /stocktakes/{id}/cancel stocktakes cancelStocktake
+// Cancel open stock-take without changing stock
+//
+// responses:
+//   200: StocktakeResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /suppliers suppliers getSuppliers
+// Returns suppliers sorted by name
+//
+// responses:
+//   200: SuppliersResponse
+//   500: ErrorResponse
+
+// swagger:route POST /suppliers suppliers createSupplier
+// Create supplier with optional email and phone
+//
+// responses:
+//   201: SupplierResponse
+//   400: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /purchase_orders purchaseOrders getPurchaseOrders
+// Returns purchase orders, newest first. Supports supplier_id, warehouse_id, status, page and limit query params
+//
+// responses:
+//   200: PurchaseOrdersResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /purchase_orders purchaseOrders createPurchaseOrder
+// Create purchase order from supplier for warehouse. Every product must already be stocked at warehouse
+//
+// responses:
+//   201: PurchaseOrderResponse
+//   400: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /purchase_orders/{id} purchaseOrders getPurchaseOrder
+// Get purchase order with ordered and received counts of lines
+//
+// responses:
+//   200: PurchaseOrderResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /purchase_orders/{id}/receive purchaseOrders receivePurchaseOrder
+// Receive products of purchase order at its warehouse. Partial receipts are allowed until all ordered products arrive.
+// Lines with lot_number are received as batches. Serials are required for serialized products.
+// Receipts are written to movement ledger with purchase_order_id.
+// Supports Idempotency-Key header: retries with the same key replay the first response
+//
+// responses:
+//   200: PurchaseOrderResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route POST /purchase_orders/{id}/cancel purchaseOrders cancelPurchaseOrder
+// Cancel open purchase order. Already received products stay at warehouse
+//
+// responses:
+//   200: PurchaseOrderResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   409: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /serials/{serial} serials getSerial
+// Find unit by serial number: warehouse where it is, transfer it travels by, or order it was sold in
+//
+// responses:
+//   200: SerialLookupResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
 //   500: ErrorResponse
 
 // swagger:route GET /analytics/{id} analytics getWarehouseAnalytics
-// Get analytics for warehouse
+// Get analytics for warehouse with revenue, cost of goods sold, gross margin and margin percent per product.
+// Supports from and to query params
 //
 // responses:
 //   200: WarehouseAnalyticsResponse
+//   400: ErrorResponse
 //   500: ErrorResponse
 
 // swagger:route GET /analytics/top_warehouses analytics getTopWarehouses
-// Get top warehouses
+// Get top warehouses. Supports limit, from and to query params
 //
 // responses:
 //   200: WarehouseAnalyticsAtListResponse
+//   400: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /analytics/top_products analytics getTopProducts
+// Get top selling products by revenue or units. Supports by, warehouse_id, limit, from and to query params
+//
+// responses:
+//   200: TopProductsResponse
+//   400: ErrorResponse
+//   422: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /analytics/product/{id} analytics getProductAnalytics
+// Get sales of product at each warehouse. Supports from and to query params
+//
+// responses:
+//   200: ProductAnalyticsResponse
+//   400: ErrorResponse
+//   404: ErrorResponse
+//   500: ErrorResponse
+
+// swagger:route GET /analytics/timeseries analytics getSalesTimeSeries
+// Get revenue and units per day, week or month for warehouse or product.
+// Supports warehouse_id, product_id, bucket, from and to query params
+//
+// responses:
+//   200: SalesTimeSeriesResponse
+//   400: ErrorResponse
 //   500: ErrorResponse
@@ -0,0 +1,10 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// SerialLookupResponse swagger response
+// swagger:response SerialLookupResponse
+type SerialLookupResponseWrapper struct {
+	// in: body
+	Body dto.SerialLookupResponse
+}
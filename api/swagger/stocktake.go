@@ -0,0 +1,17 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// StocktakeResponse swagger response
+// swagger:response StocktakeResponse
+type StocktakeResponseWrapper struct {
+	// in: body
+	Body dto.StocktakeResponse
+}
+
+// StocktakeVarianceResponse swagger response
+// swagger:response StocktakeVarianceResponse
+type StocktakeVarianceResponseWrapper struct {
+	// in: body
+	Body dto.StocktakeVarianceResponse
+}
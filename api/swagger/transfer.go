@@ -0,0 +1,10 @@
+package swagger
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+
+// TransferResponse swagger response
+// swagger:response TransferResponse
+type TransferResponseWrapper struct {
+	// in: body
+	Body dto.TransferResponse
+}
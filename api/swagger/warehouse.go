@@ -8,3 +8,17 @@ type WarehousesResponse struct {
 	// in: body
 	Body []dto.WarehouseAtListResponse
 }
+
+// LowStockResponse swagger response
+// swagger:response LowStockResponse
+type LowStockResponseWrapper struct {
+	// in: body
+	Body dto.LowStockResponse
+}
+
+// StockValuationResponse swagger response
+// swagger:response StockValuationResponse
+type StockValuationResponseWrapper struct {
+	// in: body
+	Body dto.StockValuationResponse
+}
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS trg_stock_movement_append_only ON stock_movement;
+
+DROP FUNCTION IF EXISTS forbid_stock_movement_change;
+
+DROP INDEX IF EXISTS idx_stock_movement_warehouse;
+
+DROP TABLE IF EXISTS stock_movement;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS stock_movement(
+    movement_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    warehouse_id UUID REFERENCES warehouse(warehouse_id),
+    product_id UUID REFERENCES product(product_id),
+    movement_delta INT NOT NULL,
+    movement_reason VARCHAR NOT NULL CONSTRAINT valid_reason CHECK (
+        movement_reason IN ('receipt', 'adjustment', 'sale', 'transfer', 'correction')
+    ),
+    request_id VARCHAR,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_stock_movement_warehouse ON stock_movement(warehouse_id, product_id, created_at);
+
+-- журнал движения товаров только дополняется: изменять и удалять записи нельзя.
+CREATE OR REPLACE FUNCTION forbid_stock_movement_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'stock_movement is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_stock_movement_append_only
+    BEFORE UPDATE OR DELETE ON stock_movement
+    FOR EACH ROW EXECUTE FUNCTION forbid_stock_movement_change();
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS transfer_product;
+
+DROP TABLE IF EXISTS transfer;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS transfer(
+    transfer_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_warehouse_id UUID REFERENCES warehouse(warehouse_id),
+    destination_warehouse_id UUID REFERENCES warehouse(warehouse_id),
+    transfer_status VARCHAR NOT NULL CONSTRAINT valid_status CHECK (transfer_status IN ('in_transit', 'received')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    received_at TIMESTAMPTZ,
+    CONSTRAINT different_warehouses CHECK (source_warehouse_id <> destination_warehouse_id)
+);
+
+CREATE TABLE IF NOT EXISTS transfer_product(
+    transfer_id UUID REFERENCES transfer(transfer_id) ON DELETE CASCADE,
+    product_id UUID REFERENCES product(product_id),
+    product_count INT CONSTRAINT positive_count CHECK (product_count > 0),
+    PRIMARY KEY (transfer_id, product_id)
+);
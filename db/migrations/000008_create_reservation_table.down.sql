@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS reservation_product;
+
+DROP INDEX IF EXISTS idx_reservation_active;
+
+DROP TABLE IF EXISTS reservation;
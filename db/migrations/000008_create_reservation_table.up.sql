@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS reservation(
+    reservation_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    warehouse_id UUID REFERENCES warehouse(warehouse_id),
+    reservation_status VARCHAR NOT NULL CONSTRAINT valid_status CHECK (
+        reservation_status IN ('active', 'consumed', 'released')
+    ),
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_reservation_active ON reservation(warehouse_id, expires_at) WHERE reservation_status = 'active';
+
+CREATE TABLE IF NOT EXISTS reservation_product(
+    reservation_id UUID REFERENCES reservation(reservation_id) ON DELETE CASCADE,
+    product_id UUID REFERENCES product(product_id),
+    product_count INT CONSTRAINT positive_count CHECK (product_count > 0),
+    PRIMARY KEY (reservation_id, product_id)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS idempotency_key(
+    idempotency_key VARCHAR PRIMARY KEY,
+    request_hash VARCHAR NOT NULL,
+    response_status INT,
+    response_body BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- устаревшие ключи идемпотентности периодически удаляются по времени захвата.
+CREATE INDEX IF NOT EXISTS idx_idempotency_key_created_at ON idempotency_key(created_at);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS order_line;
+
+DROP INDEX IF EXISTS idx_orders_warehouse;
+
+DROP TABLE IF EXISTS orders;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS orders(
+    order_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    warehouse_id UUID REFERENCES warehouse(warehouse_id),
+    order_status VARCHAR NOT NULL CONSTRAINT valid_status CHECK (
+        order_status IN ('created', 'paid', 'shipped', 'cancelled')
+    ),
+    request_id VARCHAR,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_orders_warehouse ON orders(warehouse_id, created_at);
+
+CREATE TABLE IF NOT EXISTS order_line(
+    order_id UUID REFERENCES orders(order_id) ON DELETE CASCADE,
+    product_id UUID REFERENCES product(product_id),
+    product_count INT CONSTRAINT positive_count CHECK (product_count > 0),
+    product_price NUMERIC(10, 2) CONSTRAINT positive_price CHECK (product_price >= 0),
+    product_sale INT CONSTRAINT positive_sale CHECK (product_sale >= 0),
+    PRIMARY KEY (order_id, product_id)
+);
@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS order_return_line;
+
+DROP INDEX IF EXISTS idx_order_return_order;
+
+DROP TABLE IF EXISTS order_return;
+
+ALTER TABLE stock_movement DROP CONSTRAINT IF EXISTS valid_reason;
+ALTER TABLE stock_movement ADD CONSTRAINT valid_reason CHECK (
+    movement_reason IN ('receipt', 'adjustment', 'sale', 'transfer', 'correction')
+) NOT VALID;
+
+ALTER TABLE analytics ADD CONSTRAINT positive_count CHECK (product_count >= 0) NOT VALID;
+
+ALTER TABLE order_line
+    DROP CONSTRAINT IF EXISTS valid_returned_count,
+    DROP COLUMN IF EXISTS returned_count;
+
+ALTER TABLE inventory DROP COLUMN IF EXISTS quarantine_count;
@@ -0,0 +1,32 @@
+ALTER TABLE inventory
+    ADD COLUMN quarantine_count INT NOT NULL DEFAULT 0 CONSTRAINT positive_quarantine_count CHECK (quarantine_count >= 0);
+
+ALTER TABLE order_line
+    ADD COLUMN returned_count INT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT valid_returned_count CHECK (returned_count >= 0 AND returned_count <= product_count);
+
+-- возвраты записываются в аналитику компенсирующими строками с отрицательным количеством.
+ALTER TABLE analytics DROP CONSTRAINT IF EXISTS positive_count;
+
+ALTER TABLE stock_movement DROP CONSTRAINT IF EXISTS valid_reason;
+ALTER TABLE stock_movement ADD CONSTRAINT valid_reason CHECK (
+    movement_reason IN ('receipt', 'adjustment', 'sale', 'transfer', 'correction', 'return')
+);
+
+CREATE TABLE IF NOT EXISTS order_return(
+    return_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES orders(order_id),
+    return_reason TEXT NOT NULL,
+    quarantine BOOLEAN NOT NULL DEFAULT FALSE,
+    request_id VARCHAR,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_order_return_order ON order_return(order_id);
+
+CREATE TABLE IF NOT EXISTS order_return_line(
+    return_id UUID REFERENCES order_return(return_id) ON DELETE CASCADE,
+    product_id UUID REFERENCES product(product_id),
+    product_count INT CONSTRAINT positive_count CHECK (product_count > 0),
+    PRIMARY KEY (return_id, product_id)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_analytics_outbox_available;
+
+DROP TABLE IF EXISTS analytics_outbox;
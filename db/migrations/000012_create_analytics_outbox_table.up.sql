@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS analytics_outbox(
+    event_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_type VARCHAR NOT NULL CONSTRAINT valid_type CHECK (event_type IN ('sale', 'return')),
+    event_payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    available_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_analytics_outbox_available ON analytics_outbox(available_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_analytics_product_sold_at;
+
+DROP INDEX IF EXISTS idx_analytics_warehouse_sold_at;
+
+ALTER TABLE analytics DROP COLUMN IF EXISTS sold_at;
@@ -0,0 +1,6 @@
+-- для уже записанных продаж время неизвестно, поэтому они считаются совершенными в момент миграции.
+ALTER TABLE analytics ADD COLUMN sold_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX idx_analytics_warehouse_sold_at ON analytics(warehouse_id, sold_at);
+
+CREATE INDEX idx_analytics_product_sold_at ON analytics(product_id, sold_at);
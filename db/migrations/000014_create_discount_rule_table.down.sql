@@ -0,0 +1,3 @@
+ALTER TABLE order_line DROP COLUMN IF EXISTS discount_price;
+
+DROP TABLE IF EXISTS discount_rule;
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS discount_rule(
+    discount_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL,
+    warehouse_id UUID NOT NULL,
+    discount_type VARCHAR NOT NULL CONSTRAINT valid_type CHECK (discount_type IN ('percent', 'fixed')),
+    discount_percent INT CONSTRAINT valid_percent CHECK (discount_percent > 0 AND discount_percent <= 100),
+    discount_amount NUMERIC(10, 2) CONSTRAINT positive_amount CHECK (discount_amount > 0),
+    priority INT NOT NULL DEFAULT 0,
+    stackable BOOLEAN NOT NULL DEFAULT FALSE,
+    valid_from TIMESTAMPTZ,
+    valid_to TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (product_id, warehouse_id) REFERENCES inventory(product_id, warehouse_id) ON DELETE CASCADE,
+    CONSTRAINT valid_value CHECK (
+        (discount_type = 'percent' AND discount_percent IS NOT NULL AND discount_amount IS NULL)
+        OR (discount_type = 'fixed' AND discount_amount IS NOT NULL AND discount_percent IS NULL)
+    ),
+    CONSTRAINT valid_period CHECK (valid_from IS NULL OR valid_to IS NULL OR valid_from < valid_to)
+);
+
+CREATE INDEX idx_discount_rule_inventory ON discount_rule(warehouse_id, product_id);
+
+-- цена единицы товара со всеми скидками, действовавшими в момент покупки.
+ALTER TABLE order_line ADD COLUMN discount_price NUMERIC(10, 2) CONSTRAINT positive_discount_price CHECK (discount_price >= 0);
+
+UPDATE order_line SET discount_price = ROUND(product_price * (100 - COALESCE(product_sale, 0)) / 100, 2);
+
+ALTER TABLE order_line ALTER COLUMN discount_price SET NOT NULL;
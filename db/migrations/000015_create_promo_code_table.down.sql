@@ -0,0 +1,5 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS promo_discount,
+    DROP COLUMN IF EXISTS promo_code;
+
+DROP TABLE IF EXISTS promo_code;
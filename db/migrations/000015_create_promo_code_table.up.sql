@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS promo_code(
+    promo_code_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    promo_code VARCHAR NOT NULL,
+    discount_type VARCHAR NOT NULL CONSTRAINT valid_type CHECK (discount_type IN ('percent', 'fixed')),
+    discount_percent INT CONSTRAINT valid_percent CHECK (discount_percent > 0 AND discount_percent <= 100),
+    discount_amount NUMERIC(10, 2) CONSTRAINT positive_amount CHECK (discount_amount > 0),
+    min_total NUMERIC(10, 2) NOT NULL DEFAULT 0 CONSTRAINT positive_min_total CHECK (min_total >= 0),
+    -- NULL означает, что промокод действует на всех складах.
+    warehouse_id UUID REFERENCES warehouse(warehouse_id) ON DELETE CASCADE,
+    usage_limit INT CONSTRAINT positive_usage_limit CHECK (usage_limit > 0),
+    used_count INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT valid_value CHECK (
+        (discount_type = 'percent' AND discount_percent IS NOT NULL AND discount_amount IS NULL)
+        OR (discount_type = 'fixed' AND discount_amount IS NOT NULL AND discount_percent IS NULL)
+    ),
+    CONSTRAINT valid_used_count CHECK (used_count >= 0 AND (usage_limit IS NULL OR used_count <= usage_limit))
+);
+
+-- промокоды сравниваются без учета регистра.
+CREATE UNIQUE INDEX idx_promo_code ON promo_code(upper(promo_code));
+
+ALTER TABLE orders
+    ADD COLUMN promo_code VARCHAR,
+    ADD COLUMN promo_discount NUMERIC(10, 2) NOT NULL DEFAULT 0 CONSTRAINT positive_promo_discount CHECK (promo_discount >= 0);
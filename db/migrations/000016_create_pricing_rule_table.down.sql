@@ -0,0 +1,3 @@
+ALTER TABLE order_line DROP COLUMN IF EXISTS rule_discount;
+
+DROP TABLE IF EXISTS pricing_rule;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS pricing_rule(
+    rule_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    -- NULL означает, что правило действует на всех складах.
+    warehouse_id UUID REFERENCES warehouse(warehouse_id) ON DELETE CASCADE,
+    rule_name VARCHAR NOT NULL,
+    rule_type VARCHAR NOT NULL,
+    -- условия правила зависят от его вида, поэтому хранятся в JSON.
+    rule_params JSONB NOT NULL,
+    priority INT NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_pricing_rule_warehouse ON pricing_rule(warehouse_id) WHERE active;
+
+ALTER TABLE order_line
+    ADD COLUMN rule_discount NUMERIC(10, 2) NOT NULL DEFAULT 0 CONSTRAINT positive_rule_discount CHECK (rule_discount >= 0);
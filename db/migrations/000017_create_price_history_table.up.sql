@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS price_history(
+    history_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL,
+    warehouse_id UUID NOT NULL,
+    old_price NUMERIC(10, 2) NOT NULL,
+    new_price NUMERIC(10, 2) NOT NULL,
+    old_sale INT NOT NULL DEFAULT 0,
+    new_sale INT NOT NULL DEFAULT 0,
+    changed_by VARCHAR,
+    request_id VARCHAR,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (product_id, warehouse_id) REFERENCES inventory(product_id, warehouse_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_price_history_inventory ON price_history(warehouse_id, product_id, changed_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_inventory_low_stock;
+
+ALTER TABLE inventory
+    DROP COLUMN IF EXISTS min_quantity,
+    DROP COLUMN IF EXISTS reorder_quantity;
@@ -0,0 +1,6 @@
+ALTER TABLE inventory
+    ADD COLUMN min_quantity INT NOT NULL DEFAULT 0 CONSTRAINT positive_min_quantity CHECK (min_quantity >= 0),
+    ADD COLUMN reorder_quantity INT NOT NULL DEFAULT 0 CONSTRAINT positive_reorder_quantity CHECK (reorder_quantity >= 0);
+
+-- отчет о низких остатках выбирает только строки с заданным порогом.
+CREATE INDEX idx_inventory_low_stock ON inventory(warehouse_id) WHERE min_quantity > 0 AND product_count <= min_quantity;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS inventory_batch(
+    batch_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL,
+    warehouse_id UUID NOT NULL,
+    lot_number VARCHAR NOT NULL,
+    batch_count INT NOT NULL CONSTRAINT positive_batch_count CHECK (batch_count >= 0),
+    -- NULL означает, что у товара нет срока годности.
+    expires_at TIMESTAMPTZ,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (product_id, warehouse_id) REFERENCES inventory(product_id, warehouse_id) ON DELETE CASCADE,
+    CONSTRAINT unique_lot UNIQUE (product_id, warehouse_id, lot_number)
+);
+
+-- партии списываются в порядке истечения срока годности.
+CREATE INDEX idx_inventory_batch_fefo ON inventory_batch(warehouse_id, product_id, expires_at) WHERE batch_count > 0;
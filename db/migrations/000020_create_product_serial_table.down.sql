@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS product_serial;
+
+ALTER TABLE product
+    DROP COLUMN IF EXISTS product_serialized;
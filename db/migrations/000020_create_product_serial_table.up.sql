@@ -0,0 +1,22 @@
+ALTER TABLE product
+    ADD COLUMN product_serialized BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS product_serial(
+    serial_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES product(product_id) ON DELETE CASCADE,
+    serial_number VARCHAR NOT NULL,
+    -- склад, на котором находится единица товара. Для проданной единицы - склад продажи,
+    -- для единицы в пути - склад-получатель.
+    warehouse_id UUID NOT NULL REFERENCES warehouse(warehouse_id) ON DELETE CASCADE,
+    serial_status VARCHAR NOT NULL DEFAULT 'in_stock',
+    order_id UUID REFERENCES orders(order_id) ON DELETE SET NULL,
+    transfer_id UUID REFERENCES transfer(transfer_id) ON DELETE SET NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT unique_product_serial UNIQUE (product_id, serial_number)
+);
+
+CREATE INDEX idx_product_serial_number ON product_serial(serial_number);
+
+-- единицы товара выдаются со склада в порядке поступления.
+CREATE INDEX idx_product_serial_stock ON product_serial(warehouse_id, product_id, received_at) WHERE serial_status = 'in_stock';
@@ -0,0 +1,5 @@
+ALTER TABLE stock_movement
+    DROP COLUMN IF EXISTS stocktake_id;
+
+DROP TABLE IF EXISTS stocktake_line;
+DROP TABLE IF EXISTS stocktake;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS stocktake(
+    stocktake_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    warehouse_id UUID NOT NULL REFERENCES warehouse(warehouse_id),
+    stocktake_status VARCHAR NOT NULL DEFAULT 'open' CONSTRAINT valid_stocktake_status CHECK (
+        stocktake_status IN ('open', 'posted', 'cancelled')
+    ),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    closed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_stocktake_warehouse ON stocktake(warehouse_id, created_at);
+
+CREATE TABLE IF NOT EXISTS stocktake_line(
+    stocktake_id UUID REFERENCES stocktake(stocktake_id) ON DELETE CASCADE,
+    product_id UUID REFERENCES product(product_id),
+    -- остаток на момент открытия пересчета.
+    expected_count INT NOT NULL,
+    counted_count INT CONSTRAINT positive_counted_count CHECK (counted_count >= 0),
+    counted_at TIMESTAMPTZ,
+    -- исправление, проведенное по строке. NULL, если строка не проводилась.
+    posted_delta INT,
+    PRIMARY KEY (stocktake_id, product_id)
+);
+
+-- исправления по результатам пересчета ссылаются на пересчет.
+ALTER TABLE stock_movement
+    ADD COLUMN stocktake_id UUID REFERENCES stocktake(stocktake_id);
@@ -0,0 +1,8 @@
+ALTER TABLE stock_movement
+    DROP COLUMN IF EXISTS purchase_order_id;
+
+DROP TABLE IF EXISTS purchase_order_line;
+
+DROP TABLE IF EXISTS purchase_order;
+
+DROP TABLE IF EXISTS supplier;
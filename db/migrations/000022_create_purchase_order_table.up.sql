@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS supplier(
+    supplier_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    supplier_name TEXT NOT NULL UNIQUE,
+    supplier_email TEXT,
+    supplier_phone TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS purchase_order(
+    purchase_order_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    supplier_id UUID NOT NULL REFERENCES supplier(supplier_id),
+    warehouse_id UUID NOT NULL REFERENCES warehouse(warehouse_id),
+    purchase_order_status VARCHAR NOT NULL DEFAULT 'ordered' CONSTRAINT valid_purchase_order_status CHECK (
+        purchase_order_status IN ('ordered', 'partially_received', 'received', 'cancelled')
+    ),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_purchase_order_supplier ON purchase_order(supplier_id, created_at);
+CREATE INDEX idx_purchase_order_warehouse ON purchase_order(warehouse_id, created_at);
+
+CREATE TABLE IF NOT EXISTS purchase_order_line(
+    purchase_order_id UUID REFERENCES purchase_order(purchase_order_id) ON DELETE CASCADE,
+    product_id UUID REFERENCES product(product_id),
+    ordered_count INT NOT NULL CONSTRAINT positive_ordered_count CHECK (ordered_count > 0),
+    received_count INT NOT NULL DEFAULT 0 CONSTRAINT valid_received_count CHECK (
+        received_count >= 0 AND received_count <= ordered_count
+    ),
+    unit_cost NUMERIC(10, 2) NOT NULL CONSTRAINT positive_unit_cost CHECK (unit_cost >= 0),
+    expected_at TIMESTAMPTZ,
+    PRIMARY KEY (purchase_order_id, product_id)
+);
+
+-- поступления по заказу поставщику ссылаются на заказ.
+ALTER TABLE stock_movement
+    ADD COLUMN purchase_order_id UUID REFERENCES purchase_order(purchase_order_id);
@@ -0,0 +1,10 @@
+ALTER TABLE analytics
+    DROP COLUMN IF EXISTS total_cost;
+
+ALTER TABLE transfer_product
+    DROP COLUMN IF EXISTS unit_cost;
+
+ALTER TABLE order_line
+    DROP COLUMN IF EXISTS line_cost;
+
+DROP TABLE IF EXISTS inventory_cost_layer;
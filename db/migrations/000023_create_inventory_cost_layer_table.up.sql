@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS inventory_cost_layer(
+    layer_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL,
+    warehouse_id UUID NOT NULL,
+    unit_cost NUMERIC(10, 2) NOT NULL CONSTRAINT positive_layer_unit_cost CHECK (unit_cost >= 0),
+    layer_count INT NOT NULL CONSTRAINT positive_layer_count CHECK (layer_count >= 0),
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (product_id, warehouse_id) REFERENCES inventory(product_id, warehouse_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_inventory_cost_layer_fifo ON inventory_cost_layer(warehouse_id, product_id, received_at) WHERE layer_count > 0;
+
+-- себестоимость всего товара строки на момент покупки.
+ALTER TABLE order_line
+    ADD COLUMN line_cost NUMERIC(12, 2) NOT NULL DEFAULT 0;
+
+-- себестоимость единицы товара, списанного со склада-отправителя.
+ALTER TABLE transfer_product
+    ADD COLUMN unit_cost NUMERIC(10, 2) NOT NULL DEFAULT 0;
+
+-- себестоимость всего товара строки аналитики. Для возвратов отрицательна.
+ALTER TABLE analytics
+    ADD COLUMN total_cost NUMERIC(12, 2) NOT NULL DEFAULT 0;
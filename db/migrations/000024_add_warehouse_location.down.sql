@@ -0,0 +1,4 @@
+ALTER TABLE warehouse
+    DROP CONSTRAINT IF EXISTS warehouse_location_pair,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude;
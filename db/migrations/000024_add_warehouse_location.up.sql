@@ -0,0 +1,5 @@
+-- координаты склада для выбора ближайшего склада при сборке заказа.
+ALTER TABLE warehouse
+    ADD COLUMN latitude DOUBLE PRECISION CONSTRAINT valid_warehouse_latitude CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN longitude DOUBLE PRECISION CONSTRAINT valid_warehouse_longitude CHECK (longitude BETWEEN -180 AND 180),
+    ADD CONSTRAINT warehouse_location_pair CHECK ((latitude IS NULL) = (longitude IS NULL));
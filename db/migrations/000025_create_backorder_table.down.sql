@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS backorder;
+
+ALTER TABLE inventory
+    DROP COLUMN IF EXISTS backorder_limit;
@@ -0,0 +1,21 @@
+-- сколько товара можно продать сверх остатка. 0 - предзаказ запрещен.
+ALTER TABLE inventory
+    ADD COLUMN backorder_limit INT NOT NULL DEFAULT 0 CONSTRAINT positive_backorder_limit CHECK (backorder_limit >= 0);
+
+CREATE TABLE IF NOT EXISTS backorder(
+    backorder_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES orders(order_id),
+    product_id UUID NOT NULL,
+    warehouse_id UUID NOT NULL,
+    backorder_count INT NOT NULL CONSTRAINT positive_count CHECK (backorder_count > 0),
+    allocated_count INT NOT NULL DEFAULT 0 CONSTRAINT valid_allocated_count CHECK (allocated_count >= 0 AND allocated_count <= backorder_count),
+    backorder_status VARCHAR NOT NULL DEFAULT 'open' CONSTRAINT valid_status CHECK (
+        backorder_status IN ('open', 'fulfilled', 'cancelled')
+    ),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    fulfilled_at TIMESTAMPTZ,
+    FOREIGN KEY (product_id, warehouse_id) REFERENCES inventory(product_id, warehouse_id)
+);
+
+CREATE INDEX idx_backorder_open ON backorder(warehouse_id, product_id, created_at) WHERE backorder_status = 'open';
+CREATE INDEX idx_backorder_order ON backorder(order_id);
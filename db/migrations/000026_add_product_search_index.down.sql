@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_product_weight;
+
+DROP INDEX IF EXISTS idx_product_search;
@@ -0,0 +1,5 @@
+-- полнотекстовый поиск по каталогу. Выражение должно совпадать с условием в запросе GetProducts.
+CREATE INDEX idx_product_search ON product
+    USING GIN (to_tsvector('simple', COALESCE(product_name, '') || ' ' || COALESCE(product_description, '')));
+
+CREATE INDEX idx_product_weight ON product(product_weight);
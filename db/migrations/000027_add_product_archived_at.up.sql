@@ -0,0 +1,3 @@
+-- момент архивации продукта. Архивный продукт скрыт из каталога и корзин, но остается в истории.
+ALTER TABLE product
+    ADD COLUMN archived_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+ALTER TABLE analytics
+    DROP COLUMN IF EXISTS total_price;
@@ -0,0 +1,9 @@
+-- выручка всей строки аналитики со всеми скидками, включая скидки правил ценообразования и промокода.
+-- Для возвратов отрицательна. Для уже записанных продаж считается по цене единицы товара.
+ALTER TABLE analytics
+    ADD COLUMN total_price NUMERIC(12, 2);
+
+UPDATE analytics SET total_price = product_count * product_price;
+
+ALTER TABLE analytics
+    ALTER COLUMN total_price SET NOT NULL;
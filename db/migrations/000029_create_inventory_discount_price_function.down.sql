@@ -0,0 +1,2 @@
+DROP FUNCTION IF EXISTS inventory_discount_price;
+DROP FUNCTION IF EXISTS apply_discount_rule;
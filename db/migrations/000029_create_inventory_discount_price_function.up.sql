@@ -0,0 +1,60 @@
+-- цена после одного правила скидки, как domain.DiscountRule.Apply.
+CREATE OR REPLACE FUNCTION apply_discount_rule(
+    in_price NUMERIC,
+    in_type VARCHAR,
+    in_percent INT,
+    in_amount NUMERIC
+) RETURNS NUMERIC AS $$
+BEGIN
+    IF in_type = 'percent' THEN
+        RETURN ROUND(in_price * (100 - in_percent) / 100, 2);
+    ELSIF in_type = 'fixed' THEN
+        RETURN GREATEST(in_price - in_amount, 0);
+    END IF;
+
+    RETURN in_price;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- цена единицы товара со скидками, действующими в момент in_at.
+-- повторяет domain.ApplyDiscountRules: правила рассматриваются по убыванию приоритета,
+-- первое несуммируемое правило применяется одно, иначе применяются все суммируемые.
+-- если действующих правил нет, то применяется скидка товара in_sale.
+CREATE OR REPLACE FUNCTION inventory_discount_price(
+    in_product_id UUID,
+    in_warehouse_id UUID,
+    in_price NUMERIC,
+    in_sale INT,
+    in_at TIMESTAMPTZ
+) RETURNS NUMERIC AS $$
+DECLARE
+    rule RECORD;
+    price NUMERIC := in_price;
+    found_rule BOOLEAN := FALSE;
+BEGIN
+    FOR rule IN
+        SELECT discount_type, discount_percent, discount_amount, stackable
+        FROM discount_rule
+        WHERE product_id = in_product_id AND warehouse_id = in_warehouse_id
+            AND (valid_from IS NULL OR valid_from <= in_at)
+            AND (valid_to IS NULL OR valid_to > in_at)
+        ORDER BY priority DESC, created_at
+    LOOP
+        IF NOT found_rule AND NOT rule.stackable THEN
+            RETURN apply_discount_rule(price, rule.discount_type, rule.discount_percent, rule.discount_amount);
+        END IF;
+
+        found_rule := TRUE;
+
+        IF rule.stackable THEN
+            price := apply_discount_rule(price, rule.discount_type, rule.discount_percent, rule.discount_amount);
+        END IF;
+    END LOOP;
+
+    IF NOT found_rule THEN
+        RETURN ROUND(in_price * (100 - COALESCE(in_sale, 0)) / 100, 2);
+    END IF;
+
+    RETURN price;
+END;
+$$ LANGUAGE plpgsql STABLE;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_stock_alert_outbox_available;
+
+DROP TABLE IF EXISTS stock_alert_outbox;
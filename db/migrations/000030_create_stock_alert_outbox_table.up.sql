@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS stock_alert_outbox(
+    alert_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    alert_payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    available_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_stock_alert_outbox_available ON stock_alert_outbox(available_at);
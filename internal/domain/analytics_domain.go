@@ -1,9 +1,28 @@
 package domain
 
+import "time"
+
 // Analytics представляет аналитику по складу и продуктам.
 type Analytics struct {
 	Warehouse    *Warehouse
 	Product      *Product
 	ProductCount int
-	ProductPrice float64
+	ProductPrice Money
+	Revenue      Money // Выручка со всеми скидками. Для возвратов отрицательна.
+	Cost         Money // Себестоимость проданного товара. Для возвратов отрицательна.
+}
+
+// SalesPoint представляет продажи за один период временного ряда.
+type SalesPoint struct {
+	PeriodStart  time.Time
+	ProductCount int
+	Revenue      Money
+}
+
+// SalesTotal представляет итог продаж продукта: в целом или на одном складе.
+type SalesTotal struct {
+	Warehouse    *Warehouse
+	Product      *Product
+	ProductCount int
+	Revenue      Money
 }
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BackorderStatus - статус предзаказа.
+type BackorderStatus string
+
+const (
+	BackorderOpen      BackorderStatus = "open"      // товар ожидает поступления на склад.
+	BackorderFulfilled BackorderStatus = "fulfilled" // весь товар предзаказа обеспечен.
+	BackorderCancelled BackorderStatus = "cancelled" // заказ отменен до поступления товара.
+)
+
+// Backorder представляет товар заказа, проданный сверх остатка склада.
+//
+// Товар списывается со склада, когда поступает: предзаказы обеспечиваются
+// в порядке создания.
+type Backorder struct {
+	ID          uuid.UUID
+	OrderID     uuid.UUID
+	Warehouse   *Warehouse
+	Product     *Product
+	Count       int // Количество товара, проданного сверх остатка.
+	Allocated   int // Количество товара, уже обеспеченного поступлениями.
+	Status      BackorderStatus
+	CreatedAt   time.Time
+	FulfilledAt *time.Time
+}
+
+// Remaining возвращает количество товара, которое еще ожидает поступления.
+func (b *Backorder) Remaining() int {
+	return b.Count - b.Allocated
+}
+
+// FulfilledAlert возвращает оповещение о том, что предзаказ полностью обеспечен.
+func (b *Backorder) FulfilledAlert(reason MovementReason) *StockAlert {
+	return &StockAlert{
+		Kind:      StockAlertBackorderFulfilled,
+		Warehouse: b.Warehouse,
+		Product:   b.Product,
+		Reason:    reason,
+		Backorder: b,
+		CreatedAt: time.Now(),
+	}
+}
+
+// FromStock возвращает количество товара строки корзины, которое списывается с остатка склада.
+func (inv *Inventory) FromStock() int {
+	return inv.ProductCount - inv.Backordered
+}
+
+// PlaceBackorder проверяет, можно ли продать inv.ProductCount единиц товара, если свободно
+// только available, и записывает недостающее количество в Backordered.
+//
+// open - количество товара в открытых предзаказах. Вместе с новым предзаказом оно не
+// должно превышать BackorderLimit. Если товара хватает, то предзаказ не создается.
+func (inv *Inventory) PlaceBackorder(available, open int) bool {
+	shortfall := inv.ProductCount - max(available, 0)
+	if shortfall <= 0 {
+		inv.Backordered = 0
+		return true
+	}
+
+	if open+shortfall > inv.BackorderLimit {
+		return false
+	}
+
+	inv.Backordered = shortfall
+	return true
+}
+
+// AllocateBackorders распределяет stock единиц поступившего товара по открытым предзаказам
+// в порядке backorders и возвращает распределенное количество для каждого предзаказа.
+//
+// Предзаказы обеспечиваются строго по очереди: следующий получает товар, только когда
+// предыдущий обеспечен полностью. Обеспеченные предзаказы переводятся в BackorderFulfilled.
+func AllocateBackorders(backorders []*Backorder, stock int, now time.Time) map[*Backorder]int {
+	allocated := make(map[*Backorder]int)
+
+	for _, b := range backorders {
+		if stock <= 0 {
+			break
+		}
+
+		if b.Status != BackorderOpen {
+			continue
+		}
+
+		take := min(b.Remaining(), stock)
+		b.Allocated += take
+		stock -= take
+		allocated[b] = take
+
+		if b.Remaining() == 0 {
+			b.Status = BackorderFulfilled
+			b.FulfilledAt = &now
+		}
+	}
+
+	return allocated
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryPlaceBackorder(t *testing.T) {
+	tests := []struct {
+		name            string
+		count           int
+		limit           int
+		available       int
+		open            int
+		wantOK          bool
+		wantBackordered int
+	}{
+		{name: "enough stock", count: 3, limit: 0, available: 5, wantOK: true, wantBackordered: 0},
+		{name: "backorders disabled", count: 3, limit: 0, available: 1, wantOK: false},
+		{name: "shortfall within limit", count: 3, limit: 5, available: 1, open: 2, wantOK: true, wantBackordered: 2},
+		{name: "shortfall over limit", count: 3, limit: 5, available: 1, open: 4, wantOK: false},
+		{name: "no stock at all", count: 3, limit: 3, available: -2, wantOK: true, wantBackordered: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := &Inventory{ProductCount: tt.count, BackorderLimit: tt.limit}
+
+			require.Equal(t, tt.wantOK, inv.PlaceBackorder(tt.available, tt.open))
+			if tt.wantOK {
+				require.Equal(t, tt.wantBackordered, inv.Backordered)
+				require.Equal(t, tt.count-tt.wantBackordered, inv.FromStock())
+			}
+		})
+	}
+}
+
+func TestAllocateBackorders(t *testing.T) {
+	now := time.Now()
+
+	newBackorders := func() []*Backorder {
+		return []*Backorder{
+			{Count: 3, Allocated: 1, Status: BackorderOpen},
+			{Count: 2, Status: BackorderOpen},
+			{Count: 4, Status: BackorderOpen},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		stock         int
+		wantAllocated []int
+		wantStatuses  []BackorderStatus
+	}{
+		{
+			name:          "no stock",
+			stock:         0,
+			wantAllocated: []int{0, 0, 0},
+			wantStatuses:  []BackorderStatus{BackorderOpen, BackorderOpen, BackorderOpen},
+		},
+		{
+			name:          "first partially",
+			stock:         1,
+			wantAllocated: []int{1, 0, 0},
+			wantStatuses:  []BackorderStatus{BackorderOpen, BackorderOpen, BackorderOpen},
+		},
+		{
+			name:          "fifo order",
+			stock:         5,
+			wantAllocated: []int{2, 2, 1},
+			wantStatuses:  []BackorderStatus{BackorderFulfilled, BackorderFulfilled, BackorderOpen},
+		},
+		{
+			name:          "more than needed",
+			stock:         20,
+			wantAllocated: []int{2, 2, 4},
+			wantStatuses:  []BackorderStatus{BackorderFulfilled, BackorderFulfilled, BackorderFulfilled},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backorders := newBackorders()
+			allocated := AllocateBackorders(backorders, tt.stock, now)
+
+			for i, b := range backorders {
+				require.Equal(t, tt.wantAllocated[i], allocated[b])
+				require.Equal(t, tt.wantStatuses[i], b.Status)
+				if b.Status == BackorderFulfilled {
+					require.Equal(t, &now, b.FulfilledAt)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Batch представляет партию товара на складе.
+//
+// Партии учитываются внутри строки инвентаря: сумма их количества не превышает
+// ProductCount, остаток считается товаром без партии.
+type Batch struct {
+	ID         uuid.UUID
+	Warehouse  *Warehouse
+	Product    *Product
+	LotNumber  string
+	Quantity   int
+	ExpiresAt  *time.Time // Срок годности. nil, если товар не портится.
+	ReceivedAt time.Time
+	Serials    []string // Серийные номера единиц серийного товара в партии.
+	UnitCost   *Money   // Себестоимость единицы товара партии. nil, если она неизвестна.
+}
+
+// Expired сообщает, истек ли срок годности партии к моменту t.
+func (b *Batch) Expired(t time.Time) bool {
+	return b.ExpiresAt != nil && !b.ExpiresAt.After(t)
+}
+
+// BatchPick - количество товара, которое списывается с партии.
+type BatchPick struct {
+	Batch *Batch
+	Count int
+}
+
+// SortFEFO сортирует партии в порядке списания: первой идет партия, срок годности
+// которой истекает раньше. Партии без срока годности идут последними, при равном сроке
+// первой идет поступившая раньше.
+func SortFEFO(batches []*Batch) {
+	sort.SliceStable(batches, func(i, j int) bool {
+		a, b := batches[i], batches[j]
+		switch {
+		case a.ExpiresAt == nil && b.ExpiresAt != nil:
+			return false
+		case a.ExpiresAt != nil && b.ExpiresAt == nil:
+			return true
+		case a.ExpiresAt != nil && !a.ExpiresAt.Equal(*b.ExpiresAt):
+			return a.ExpiresAt.Before(*b.ExpiresAt)
+		}
+		return a.ReceivedAt.Before(b.ReceivedAt)
+	})
+}
+
+// PickFEFO распределяет списание count единиц товара по партиям в порядке SortFEFO.
+//
+// Если в партиях меньше count единиц, то недостающее количество списывается
+// с товара без партии и в результат не попадает.
+func PickFEFO(batches []*Batch, count int) []*BatchPick {
+	sorted := make([]*Batch, len(batches))
+	copy(sorted, batches)
+	SortFEFO(sorted)
+
+	var picks []*BatchPick
+	for _, batch := range sorted {
+		if count == 0 {
+			break
+		}
+
+		take := min(batch.Quantity, count)
+		if take <= 0 {
+			continue
+		}
+
+		picks = append(picks, &BatchPick{Batch: batch, Count: take})
+		count -= take
+	}
+
+	return picks
+}
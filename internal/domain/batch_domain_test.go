@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickFEFO(t *testing.T) {
+	now := time.Now()
+	soon := now.Add(24 * time.Hour)
+	later := now.Add(72 * time.Hour)
+
+	noExpiry := &Batch{LotNumber: "C", Quantity: 10, ReceivedAt: now.Add(-72 * time.Hour)}
+	late := &Batch{LotNumber: "B", Quantity: 5, ExpiresAt: &later, ReceivedAt: now.Add(-48 * time.Hour)}
+	early := &Batch{LotNumber: "A", Quantity: 3, ExpiresAt: &soon, ReceivedAt: now}
+	empty := &Batch{LotNumber: "D", Quantity: 0, ExpiresAt: &soon, ReceivedAt: now.Add(-time.Hour)}
+
+	batches := []*Batch{noExpiry, late, early, empty}
+
+	tests := []struct {
+		name     string
+		count    int
+		expected []*BatchPick
+	}{
+		{
+			name:     "first expired batch only",
+			count:    2,
+			expected: []*BatchPick{{Batch: early, Count: 2}},
+		},
+		{
+			name:     "spans batches by expiry",
+			count:    9,
+			expected: []*BatchPick{{Batch: early, Count: 3}, {Batch: late, Count: 5}, {Batch: noExpiry, Count: 1}},
+		},
+		{
+			name:     "more than batches hold",
+			count:    25,
+			expected: []*BatchPick{{Batch: early, Count: 3}, {Batch: late, Count: 5}, {Batch: noExpiry, Count: 10}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, PickFEFO(batches, tt.count))
+		})
+	}
+
+	require.Equal(t, "C", batches[0].LotNumber, "PickFEFO must not reorder the passed slice")
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Cart представляет корзину покупателя на складе.
+type Cart struct {
+	Warehouse     *Warehouse
+	Items         []*Inventory
+	ReservationID uuid.UUID  // Резерв, который используется при покупке. uuid.Nil, если резерва нет.
+	OrderID       uuid.UUID  // Заказ, созданный при покупке.
+	PromoCode     *PromoCode // Промокод, указанный покупателем. nil, если промокода нет.
+	PromoDiscount Money      // Скидка, полученная по промокоду.
+}
+
+// TotalWithDiscount возвращает стоимость корзины со скидками на товары и скидками
+// правил ценообразования, но без промокода.
+func (c *Cart) TotalWithDiscount() Money {
+	var total Money
+	for _, inv := range c.Items {
+		total += inv.Total()
+	}
+
+	return total
+}
+
+// PromoShare возвращает часть скидки по промокоду, которая приходится на товары стоимостью amount.
+//
+// Скидка промокода распределяется между строками корзины пропорционально их стоимости
+// так же, как в Order.PromoShare, поэтому при возврате строки вычитается та же часть скидки.
+func (c *Cart) PromoShare(amount Money) Money {
+	total := c.TotalWithDiscount()
+	if c.PromoDiscount == 0 || total == 0 {
+		return 0
+	}
+
+	return Money(roundDiv(int64(c.PromoDiscount)*int64(amount), int64(total)))
+}
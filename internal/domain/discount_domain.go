@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DiscountType - способ расчета скидки.
+type DiscountType string
+
+const (
+	DiscountPercent DiscountType = "percent" // скидка в процентах от цены.
+	DiscountFixed   DiscountType = "fixed"   // скидка фиксированной суммой с единицы товара.
+)
+
+// DiscountRule представляет правило скидки на товар на складе.
+//
+// Правило действует в промежутке [ValidFrom, ValidTo). Если граница не задана,
+// то промежуток с этой стороны не ограничен.
+type DiscountRule struct {
+	ID        uuid.UUID
+	Warehouse *Warehouse
+	Product   *Product
+	Type      DiscountType
+	Percent   int   // Процент скидки для DiscountPercent.
+	Amount    Money // Сумма скидки с единицы товара для DiscountFixed.
+	Priority  int
+	Stackable bool
+	ValidFrom *time.Time
+	ValidTo   *time.Time
+	CreatedAt time.Time
+}
+
+// ActiveAt сообщает, действует ли правило в момент t.
+func (r *DiscountRule) ActiveAt(t time.Time) bool {
+	if r.ValidFrom != nil && t.Before(*r.ValidFrom) {
+		return false
+	}
+
+	return r.ValidTo == nil || t.Before(*r.ValidTo)
+}
+
+// Apply возвращает цену price с учетом скидки правила. Цена не становится меньше нуля.
+func (r *DiscountRule) Apply(price Money) Money {
+	switch r.Type {
+	case DiscountPercent:
+		return price.WithDiscount(r.Percent)
+	case DiscountFixed:
+		return max(price-r.Amount, 0)
+	default:
+		return price
+	}
+}
+
+// ApplyDiscountRules применяет к цене price правила скидок и возвращает итоговую цену
+// вместе с примененными правилами.
+//
+// Правила рассматриваются по убыванию приоритета, при равном приоритете первым идет
+// созданное раньше. Первое правило применяется всегда. Если оно не суммируется
+// с другими, то оно единственное; иначе к цене последовательно применяются
+// остальные суммируемые правила, а несуммируемые пропускаются.
+func ApplyDiscountRules(price Money, rules []*DiscountRule) (Money, []*DiscountRule) {
+	if len(rules) == 0 {
+		return price, nil
+	}
+
+	sorted := make([]*DiscountRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority > sorted[j].Priority
+		}
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	first := sorted[0]
+	if !first.Stackable {
+		return first.Apply(price), []*DiscountRule{first}
+	}
+
+	applied := make([]*DiscountRule, 0, len(sorted))
+	for _, rule := range sorted {
+		if !rule.Stackable {
+			continue
+		}
+
+		price = rule.Apply(price)
+		applied = append(applied, rule)
+	}
+
+	return price, applied
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDiscountRules(t *testing.T) {
+	created := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+	percent := &DiscountRule{Type: DiscountPercent, Percent: 10, Priority: 1, Stackable: true, CreatedAt: created}
+	fixed := &DiscountRule{Type: DiscountFixed, Amount: 500, Priority: 0, Stackable: true, CreatedAt: created}
+	exclusive := &DiscountRule{Type: DiscountPercent, Percent: 50, Priority: 5, CreatedAt: created}
+	lowExclusive := &DiscountRule{Type: DiscountPercent, Percent: 50, Priority: -1, CreatedAt: created}
+	huge := &DiscountRule{Type: DiscountFixed, Amount: 100000, CreatedAt: created}
+
+	tests := []struct {
+		name        string
+		rules       []*DiscountRule
+		want        Money
+		wantApplied []*DiscountRule
+	}{
+		{name: "no rules", rules: nil, want: 10000},
+		{name: "stackable rules by priority", rules: []*DiscountRule{fixed, percent}, want: 8500, wantApplied: []*DiscountRule{percent, fixed}},
+		{name: "top exclusive rule wins", rules: []*DiscountRule{percent, exclusive, fixed}, want: 5000, wantApplied: []*DiscountRule{exclusive}},
+		{name: "lower exclusive rule skipped", rules: []*DiscountRule{lowExclusive, percent}, want: 9000, wantApplied: []*DiscountRule{percent}},
+		{name: "price not below zero", rules: []*DiscountRule{huge}, want: 0, wantApplied: []*DiscountRule{huge}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, applied := ApplyDiscountRules(10000, tt.rules)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantApplied, applied)
+		})
+	}
+}
+
+func TestDiscountRuleActiveAt(t *testing.T) {
+	from := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	rule := &DiscountRule{ValidFrom: &from, ValidTo: &to}
+
+	require.False(t, rule.ActiveAt(from.Add(-time.Second)))
+	require.True(t, rule.ActiveAt(from))
+	require.True(t, rule.ActiveAt(to.Add(-time.Second)))
+	require.False(t, rule.ActiveAt(to))
+	require.True(t, (&DiscountRule{}).ActiveAt(from))
+}
+
+func TestInventoryPriceWithDiscount(t *testing.T) {
+	rule := &DiscountRule{Type: DiscountFixed, Amount: 1500}
+
+	tests := []struct {
+		name        string
+		inv         *Inventory
+		want        Money
+		wantApplied []*DiscountRule
+	}{
+		{name: "product sale without rules", inv: &Inventory{ProductPrice: 10000, ProductSale: 10}, want: 9000},
+		{name: "rules replace product sale", inv: &Inventory{ProductPrice: 10000, ProductSale: 10, Discounts: []*DiscountRule{rule}}, want: 8500, wantApplied: []*DiscountRule{rule}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.inv.PriceWithDiscount())
+			require.Equal(t, tt.wantApplied, tt.inv.AppliedDiscounts())
+		})
+	}
+}
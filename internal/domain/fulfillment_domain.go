@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// FulfillmentStrategy - стратегия распределения заказа по складам.
+type FulfillmentStrategy string
+
+const (
+	FulfillmentFewestShipments FulfillmentStrategy = "fewest_shipments" // заказ собирается с как можно меньшего числа складов.
+	FulfillmentCheapest        FulfillmentStrategy = "cheapest"         // каждый товар берется со склада, где он дешевле.
+	FulfillmentNearest         FulfillmentStrategy = "nearest"          // каждый товар берется с ближайшего к адресу доставки склада.
+)
+
+// ParseFulfillmentStrategy проверяет название стратегии распределения заказа.
+func ParseFulfillmentStrategy(s string) (FulfillmentStrategy, error) {
+	switch strategy := FulfillmentStrategy(s); strategy {
+	case FulfillmentFewestShipments, FulfillmentCheapest, FulfillmentNearest:
+		return strategy, nil
+	}
+
+	return "", fmt.Errorf("unknown fulfillment strategy %q", s)
+}
+
+// Fulfillment представляет заказ покупателя, который собирается с нескольких складов.
+type Fulfillment struct {
+	Items       []*Inventory // Заказанные товары. Склад у них не задан.
+	Strategy    FulfillmentStrategy
+	Preferred   *Warehouse // Склад, с которого товар берется в первую очередь. nil, если он не задан.
+	Destination *Location  // Адрес доставки. nil, если он не задан.
+	Shipments   []*Cart    // План сборки: корзина для каждого склада, с которого берется товар.
+}
+
+// FulfillmentStock представляет товары склада, доступные для заказа.
+//
+// Items содержит инвентарь по идентификатору продукта: ProductCount - свободное
+// количество товара, цена и скидки заполнены на момент расчета.
+type FulfillmentStock struct {
+	Warehouse *Warehouse
+	Items     map[uuid.UUID]*Inventory
+}
+
+// Plan распределяет товары заказа по складам stock и записывает план в Shipments.
+//
+// Товар сначала берется с предпочтительного склада, остальное распределяется стратегией:
+//   - FulfillmentFewestShipments каждый раз выбирает склад, который покрывает больше всего
+//     оставшегося товара;
+//   - FulfillmentCheapest берет каждый товар со складов в порядке роста цены со скидкой;
+//   - FulfillmentNearest берет каждый товар со складов в порядке удаления от адреса доставки.
+//
+// При равенстве первым идет склад ближе к адресу доставки, склады без координат идут последними.
+//
+// Возвращает false, если товара на всех складах не хватает. План в этом случае не записывается.
+func (f *Fulfillment) Plan(stock []*FulfillmentStock) bool {
+	ranked := f.rankStock(stock)
+
+	remaining := make(map[uuid.UUID]int, len(f.Items))
+	products := make([]uuid.UUID, 0, len(f.Items))
+	for _, item := range f.Items {
+		if _, ok := remaining[item.Product.ID]; !ok {
+			products = append(products, item.Product.ID)
+		}
+		remaining[item.Product.ID] += item.ProductCount
+	}
+
+	plan := newFulfillmentPlan(remaining)
+
+	if f.Preferred != nil {
+		for _, s := range ranked {
+			if s.Warehouse.ID == f.Preferred.ID {
+				plan.takeAll(s, products)
+			}
+		}
+	}
+
+	switch f.Strategy {
+	case FulfillmentFewestShipments:
+		for {
+			var (
+				best        *FulfillmentStock
+				bestCovered int
+			)
+
+			for _, s := range ranked {
+				if covered := plan.covered(s, products); covered > bestCovered {
+					best, bestCovered = s, covered
+				}
+			}
+
+			if best == nil {
+				break
+			}
+			plan.takeAll(best, products)
+		}
+	case FulfillmentCheapest:
+		for _, productID := range products {
+			candidates := make([]*FulfillmentStock, len(ranked))
+			copy(candidates, ranked)
+			sort.SliceStable(candidates, func(i, j int) bool {
+				return unitPrice(candidates[i], productID) < unitPrice(candidates[j], productID)
+			})
+
+			for _, s := range candidates {
+				plan.take(s, productID)
+			}
+		}
+	default:
+		for _, productID := range products {
+			for _, s := range ranked {
+				plan.take(s, productID)
+			}
+		}
+	}
+
+	for _, count := range plan.remaining {
+		if count > 0 {
+			return false
+		}
+	}
+
+	f.Shipments = plan.carts
+	return true
+}
+
+// rankStock упорядочивает склады по удалению от адреса доставки. Склады без координат
+// или все склады, если адрес доставки не задан, идут в исходном порядке.
+func (f *Fulfillment) rankStock(stock []*FulfillmentStock) []*FulfillmentStock {
+	ranked := make([]*FulfillmentStock, len(stock))
+	copy(ranked, stock)
+
+	if f.Destination == nil {
+		return ranked
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i].Warehouse.Location, ranked[j].Warehouse.Location
+		switch {
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		}
+		return f.Destination.DistanceTo(a) < f.Destination.DistanceTo(b)
+	})
+
+	return ranked
+}
+
+// unitPrice возвращает цену единицы товара на складе со скидкой.
+// Если товара на складе нет, то он считается самым дорогим.
+func unitPrice(s *FulfillmentStock, productID uuid.UUID) Money {
+	inv, ok := s.Items[productID]
+	if !ok || inv.ProductCount <= 0 {
+		return Money(math.MaxInt64)
+	}
+
+	return inv.PriceWithDiscount()
+}
+
+// fulfillmentPlan - промежуточное состояние распределения заказа.
+type fulfillmentPlan struct {
+	remaining map[uuid.UUID]int               // Сколько товара еще нужно распределить.
+	used      map[uuid.UUID]map[uuid.UUID]int // Сколько товара уже взято с каждого склада.
+	carts     []*Cart
+	cartMap   map[uuid.UUID]*Cart
+}
+
+// newFulfillmentPlan создает пустой план для распределения товаров remaining.
+func newFulfillmentPlan(remaining map[uuid.UUID]int) *fulfillmentPlan {
+	return &fulfillmentPlan{
+		remaining: remaining,
+		used:      make(map[uuid.UUID]map[uuid.UUID]int),
+		cartMap:   make(map[uuid.UUID]*Cart),
+	}
+}
+
+// available возвращает количество товара, которое еще можно взять со склада.
+func (p *fulfillmentPlan) available(s *FulfillmentStock, productID uuid.UUID) int {
+	inv, ok := s.Items[productID]
+	if !ok {
+		return 0
+	}
+
+	return min(inv.ProductCount-p.used[s.Warehouse.ID][productID], p.remaining[productID])
+}
+
+// covered возвращает количество оставшегося товара, которое можно взять со склада.
+func (p *fulfillmentPlan) covered(s *FulfillmentStock, products []uuid.UUID) int {
+	covered := 0
+	for _, productID := range products {
+		covered += max(p.available(s, productID), 0)
+	}
+
+	return covered
+}
+
+// takeAll берет со склада весь оставшийся товар, который на нем есть.
+func (p *fulfillmentPlan) takeAll(s *FulfillmentStock, products []uuid.UUID) {
+	for _, productID := range products {
+		p.take(s, productID)
+	}
+}
+
+// take берет со склада столько товара productID, сколько возможно, и добавляет его в корзину склада.
+func (p *fulfillmentPlan) take(s *FulfillmentStock, productID uuid.UUID) {
+	count := p.available(s, productID)
+	if count <= 0 {
+		return
+	}
+
+	if p.used[s.Warehouse.ID] == nil {
+		p.used[s.Warehouse.ID] = make(map[uuid.UUID]int)
+	}
+	p.used[s.Warehouse.ID][productID] += count
+	p.remaining[productID] -= count
+
+	cart, ok := p.cartMap[s.Warehouse.ID]
+	if !ok {
+		cart = &Cart{Warehouse: s.Warehouse}
+		p.cartMap[s.Warehouse.ID] = cart
+		p.carts = append(p.carts, cart)
+	}
+
+	for _, item := range cart.Items {
+		if item.Product.ID == productID {
+			item.ProductCount += count
+			return
+		}
+	}
+
+	inv := s.Items[productID]
+	cart.Items = append(cart.Items, &Inventory{
+		Product:      inv.Product,
+		Warehouse:    s.Warehouse,
+		ProductCount: count,
+		ProductPrice: inv.ProductPrice,
+		ProductSale:  inv.ProductSale,
+		Discounts:    inv.Discounts,
+	})
+}
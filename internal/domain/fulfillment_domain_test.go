@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFulfillmentPlan(t *testing.T) {
+	apple := &Product{ID: uuid.New()}
+	pear := &Product{ID: uuid.New()}
+
+	moscow := &Warehouse{ID: uuid.New(), Location: &Location{Latitude: 55.75, Longitude: 37.62}}
+	kazan := &Warehouse{ID: uuid.New(), Location: &Location{Latitude: 55.79, Longitude: 49.12}}
+	tula := &Warehouse{ID: uuid.New(), Location: &Location{Latitude: 54.19, Longitude: 37.62}}
+
+	stockItem := func(product *Product, count int, price Money) *Inventory {
+		return &Inventory{Product: product, ProductCount: count, ProductPrice: price}
+	}
+
+	stock := []*FulfillmentStock{
+		{Warehouse: moscow, Items: map[uuid.UUID]*Inventory{
+			apple.ID: stockItem(apple, 2, 1000),
+		}},
+		{Warehouse: kazan, Items: map[uuid.UUID]*Inventory{
+			apple.ID: stockItem(apple, 5, 900),
+			pear.ID:  stockItem(pear, 5, 500),
+		}},
+		{Warehouse: tula, Items: map[uuid.UUID]*Inventory{
+			apple.ID: stockItem(apple, 5, 800),
+			pear.ID:  stockItem(pear, 1, 700),
+		}},
+	}
+
+	type shipment struct {
+		warehouse *Warehouse
+		counts    map[uuid.UUID]int
+	}
+
+	tests := []struct {
+		name        string
+		strategy    FulfillmentStrategy
+		preferred   *Warehouse
+		destination *Location
+		apples      int
+		pears       int
+		want        []shipment
+		wantOK      bool
+	}{
+		{
+			name:     "fewest shipments picks one warehouse",
+			strategy: FulfillmentFewestShipments,
+			apples:   3, pears: 2,
+			want:   []shipment{{kazan, map[uuid.UUID]int{apple.ID: 3, pear.ID: 2}}},
+			wantOK: true,
+		},
+		{
+			name:     "cheapest splits by price",
+			strategy: FulfillmentCheapest,
+			apples:   6, pears: 2,
+			want: []shipment{
+				{tula, map[uuid.UUID]int{apple.ID: 5}},
+				{kazan, map[uuid.UUID]int{apple.ID: 1, pear.ID: 2}},
+			},
+			wantOK: true,
+		},
+		{
+			name:        "nearest splits by distance",
+			strategy:    FulfillmentNearest,
+			destination: &Location{Latitude: 55.70, Longitude: 37.60},
+			apples:      3, pears: 2,
+			want: []shipment{
+				{moscow, map[uuid.UUID]int{apple.ID: 2}},
+				{tula, map[uuid.UUID]int{apple.ID: 1, pear.ID: 1}},
+				{kazan, map[uuid.UUID]int{pear.ID: 1}},
+			},
+			wantOK: true,
+		},
+		{
+			name:      "preferred warehouse goes first",
+			strategy:  FulfillmentFewestShipments,
+			preferred: moscow,
+			apples:    3, pears: 1,
+			want: []shipment{
+				{moscow, map[uuid.UUID]int{apple.ID: 2}},
+				{kazan, map[uuid.UUID]int{apple.ID: 1, pear.ID: 1}},
+			},
+			wantOK: true,
+		},
+		{
+			name:     "not enough stock",
+			strategy: FulfillmentCheapest,
+			apples:   13, pears: 1,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &Fulfillment{
+				Items: []*Inventory{
+					{Product: apple, ProductCount: tt.apples},
+					{Product: pear, ProductCount: tt.pears},
+				},
+				Strategy:    tt.strategy,
+				Preferred:   tt.preferred,
+				Destination: tt.destination,
+			}
+
+			require.Equal(t, tt.wantOK, f.Plan(stock))
+			if !tt.wantOK {
+				require.Empty(t, f.Shipments)
+				return
+			}
+
+			got := make([]shipment, 0, len(f.Shipments))
+			for _, cart := range f.Shipments {
+				counts := make(map[uuid.UUID]int)
+				for _, item := range cart.Items {
+					require.Equal(t, cart.Warehouse, item.Warehouse)
+					counts[item.Product.ID] += item.ProductCount
+				}
+				got = append(got, shipment{cart.Warehouse, counts})
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// IdempotencyRecord представляет сохраненный результат запроса с ключом идемпотентности.
+type IdempotencyRecord struct {
+	Key            string
+	RequestHash    string // Хеш метода, пути и тела запроса.
+	ResponseStatus int    // 0, пока запрос обрабатывается.
+	ResponseBody   []byte
+	CreatedAt      time.Time // Момент последнего захвата ключа.
+}
+
+// Completed сообщает, сохранен ли уже ответ на запрос.
+func (r *IdempotencyRecord) Completed() bool {
+	return r.ResponseStatus != 0
+}
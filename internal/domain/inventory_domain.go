@@ -2,9 +2,58 @@ package domain
 
 // Inventory представляет информацию о продукте на складе.
 type Inventory struct {
-	Product      *Product
-	Warehouse    *Warehouse
-	ProductCount int
-	ProductPrice float64
-	ProductSale  int
+	Product         *Product
+	Warehouse       *Warehouse
+	ProductCount    int
+	ProductPrice    Money
+	ProductSale     int
+	MinQuantity     int                  // Остаток, при котором товар нужно дозаказать. 0, если порог не задан.
+	ReorderQuantity int                  // Рекомендуемое количество для дозаказа.
+	Discounts       []*DiscountRule      // Правила скидок, действующие в момент расчета цены.
+	Adjustments     []*PricingAdjustment // Скидки на строку корзины от правил ценообразования.
+	Serials         []string             // Серийные номера принятых или проданных единиц серийного товара.
+	UnitCost        *Money               // Себестоимость единицы поступающего товара. nil, если она неизвестна.
+	Cost            Money                // Себестоимость списанного или проданного товара.
+	Revenue         Money                // Выручка за проданный товар строки со всеми скидками и промокодом.
+	BackorderLimit  int                  // Сколько товара можно продать сверх остатка. 0, если предзаказ запрещен.
+	Backordered     int                  // Часть ProductCount строки корзины, проданная сверх остатка.
+}
+
+// RuleDiscount возвращает скидку на всю строку корзины от правил ценообразования.
+func (inv *Inventory) RuleDiscount() Money {
+	var discount Money
+	for _, adj := range inv.Adjustments {
+		discount += adj.Discount
+	}
+
+	return discount
+}
+
+// Total возвращает стоимость строки корзины со скидками на товар и скидкой правил ценообразования.
+func (inv *Inventory) Total() Money {
+	return inv.PriceWithDiscount().Mul(inv.ProductCount) - inv.RuleDiscount()
+}
+
+// PriceWithDiscount возвращает цену единицы товара со скидкой.
+//
+// Если на товар действуют правила скидок, то цена считается по ним,
+// иначе применяется скидка ProductSale.
+func (inv *Inventory) PriceWithDiscount() Money {
+	price, _ := inv.applyDiscounts()
+	return price
+}
+
+// AppliedDiscounts возвращает правила скидок, которые применяются к цене товара.
+func (inv *Inventory) AppliedDiscounts() []*DiscountRule {
+	_, applied := inv.applyDiscounts()
+	return applied
+}
+
+// applyDiscounts считает цену со скидкой и возвращает примененные правила.
+func (inv *Inventory) applyDiscounts() (Money, []*DiscountRule) {
+	if len(inv.Discounts) == 0 {
+		return inv.ProductPrice.WithDiscount(inv.ProductSale), nil
+	}
+
+	return ApplyDiscountRules(inv.ProductPrice, inv.Discounts)
 }
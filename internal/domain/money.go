@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Money - денежная сумма в копейках.
+//
+// Сумма хранится целым числом копеек, поэтому сложение и умножение на количество
+// выполняются точно. Округление до копейки происходит только при разборе суммы
+// с большим числом знаков после запятой и при применении скидки: половина копейки
+// округляется от нуля.
+//
+// swagger:type number
+type Money int64
+
+// centsInUnit - количество копеек в рубле.
+const centsInUnit = 100
+
+// moneyPattern - допустимая запись суммы: десятичное число с необязательным
+// показателем степени не длиннее трех цифр. Показатель ограничен, чтобы
+// разбор записи вида "1e1000000000" не требовал огромных вычислений.
+var moneyPattern = regexp.MustCompile(`^[-+]?(\d+\.?\d*|\.\d+)([eE][-+]?\d{1,3})?$`)
+
+// ParseMoney разбирает десятичную запись суммы, например "199.99".
+// Если знаков после запятой больше двух, то сумма округляется до копейки.
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if !moneyPattern.MatchString(s) {
+		return 0, fmt.Errorf("invalid money amount %q", s)
+	}
+
+	amount, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, fmt.Errorf("invalid money amount %q", s)
+	}
+	amount.Mul(amount, big.NewRat(centsInUnit, 1))
+
+	cents, rem := new(big.Int).QuoRem(amount.Num(), amount.Denom(), new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(amount.Denom()) >= 0 {
+		cents.Add(cents, big.NewInt(int64(amount.Sign())))
+	}
+
+	if !cents.IsInt64() {
+		return 0, fmt.Errorf("money amount %q is out of range", s)
+	}
+
+	return Money(cents.Int64()), nil
+}
+
+// Mul возвращает стоимость count единиц по цене m.
+func (m Money) Mul(count int) Money {
+	return m * Money(count)
+}
+
+// Div возвращает цену одной из count единиц товара общей стоимостью m.
+// Результат округляется до копейки. Если count не больше нуля, то возвращает 0.
+func (m Money) Div(count int) Money {
+	if count <= 0 {
+		return 0
+	}
+
+	return Money(roundDiv(int64(m), int64(count)))
+}
+
+// WithDiscount возвращает цену с учетом скидки в percent процентов.
+//
+// Это единственное место, где считается цена со скидкой: результат округляется
+// до копейки, половина копейки округляется от нуля.
+func (m Money) WithDiscount(percent int) Money {
+	if percent == 0 {
+		return m
+	}
+
+	return Money(roundDiv(int64(m)*int64(100-percent), 100))
+}
+
+// roundDiv делит a на b, округляя половину от нуля. b должен быть положительным.
+func roundDiv(a, b int64) int64 {
+	q, r := a/b, a%b
+	if r < 0 {
+		r = -r
+	}
+
+	if 2*r >= b {
+		if a < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+
+	return q
+}
+
+// String возвращает сумму с двумя знаками после запятой, например "199.90".
+func (m Money) String() string {
+	sign := ""
+	abs := uint64(m)
+	if m < 0 {
+		sign = "-"
+		abs = uint64(-m)
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, abs/centsInUnit, abs%centsInUnit)
+}
+
+// MarshalJSON записывает сумму числом с двумя знаками после запятой.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON читает сумму из числа или строки.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	money, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = money
+
+	return nil
+}
+
+// Scan читает сумму из значения NUMERIC базы данных. NULL читается как ноль.
+func (m *Money) Scan(src any) error {
+	var (
+		money Money
+		err   error
+	)
+
+	switch v := src.(type) {
+	case nil:
+		money = 0
+	case string:
+		money, err = ParseMoney(v)
+	case []byte:
+		money, err = ParseMoney(string(v))
+	case int64:
+		money = Money(v * centsInUnit)
+	case float64:
+		money, err = ParseMoney(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		err = fmt.Errorf("cannot scan %T into Money", src)
+	}
+	if err != nil {
+		return err
+	}
+	*m = money
+
+	return nil
+}
+
+// Value записывает сумму в базу данных в десятичном виде.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Money
+		wantErr bool
+	}{
+		{name: "integer", input: "100", want: 10000},
+		{name: "two decimals", input: "84.99", want: 8499},
+		{name: "one decimal", input: "0.5", want: 50},
+		{name: "half cent rounds up", input: "1.005", want: 101},
+		{name: "below half cent rounds down", input: "1.0049", want: 100},
+		{name: "negative half cent rounds away from zero", input: "-1.005", want: -101},
+		{name: "exponent", input: "1e2", want: 10000},
+		{name: "empty", input: "", wantErr: true},
+		{name: "fraction", input: "1/3", wantErr: true},
+		{name: "not a number", input: "abc", wantErr: true},
+		{name: "hex", input: "0x10", wantErr: true},
+		{name: "binary", input: "0b11", wantErr: true},
+		{name: "digit separators", input: "1_000", wantErr: true},
+		{name: "long exponent", input: "1e1000000000", wantErr: true},
+		{name: "exponent out of range", input: "1e999", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoneyWithDiscount(t *testing.T) {
+	tests := []struct {
+		name    string
+		price   Money
+		percent int
+		want    Money
+	}{
+		{name: "no discount", price: 10000, percent: 0, want: 10000},
+		{name: "exact", price: 10000, percent: 15, want: 8500},
+		{name: "rounds half up", price: 999, percent: 50, want: 500},
+		{name: "rounds down", price: 1999, percent: 33, want: 1339},
+		{name: "full discount", price: 1999, percent: 100, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.price.WithDiscount(tt.percent))
+		})
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Price Money `json:"price"`
+		Debt  Money `json:"debt"`
+	}{Price: 8500, Debt: -5})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"price": 85.00, "debt": -0.05}`, string(data))
+	require.Contains(t, string(data), `85.00`)
+
+	var decoded struct {
+		Price Money `json:"price"`
+		Quote Money `json:"quote"`
+	}
+	err = json.Unmarshal([]byte(`{"price": 84.99, "quote": "0.10"}`), &decoded)
+	require.NoError(t, err)
+	require.Equal(t, Money(8499), decoded.Price)
+	require.Equal(t, Money(10), decoded.Quote)
+}
+
+func TestMoneyScan(t *testing.T) {
+	var m Money
+
+	require.NoError(t, m.Scan("199.90"))
+	require.Equal(t, Money(19990), m)
+
+	require.NoError(t, m.Scan(nil))
+	require.Equal(t, Money(0), m)
+
+	require.Error(t, m.Scan(true))
+}
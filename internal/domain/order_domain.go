@@ -0,0 +1,264 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrderStatus - состояние заказа.
+type OrderStatus string
+
+const (
+	OrderCreated   OrderStatus = "created"   // заказ оформлен, товары списаны со склада.
+	OrderPaid      OrderStatus = "paid"      // заказ оплачен.
+	OrderShipped   OrderStatus = "shipped"   // заказ отгружен покупателю.
+	OrderCancelled OrderStatus = "cancelled" // заказ отменен.
+)
+
+// orderTransitions описывает допустимые переходы между состояниями заказа.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderCreated: {OrderPaid, OrderCancelled},
+	OrderPaid:    {OrderShipped, OrderCancelled},
+}
+
+// CanTransitionTo сообщает, может ли заказ перейти из состояния s в состояние next.
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, status := range orderTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Order представляет заказ, оформленный при покупке товаров на складе.
+type Order struct {
+	ID            uuid.UUID
+	Warehouse     *Warehouse
+	Status        OrderStatus
+	Lines         []*OrderLine
+	PromoCode     string // Промокод, примененный при покупке. Пустая строка, если промокода не было.
+	PromoDiscount Money  // Скидка, полученная по промокоду.
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// LinesTotal возвращает стоимость всех строк заказа со скидками на товары.
+func (o *Order) LinesTotal() Money {
+	var total Money
+	for _, line := range o.Lines {
+		total += line.Total()
+	}
+
+	return total
+}
+
+// HasBackorders сообщает, ожидает ли часть товара заказа поступления по предзаказу.
+func (o *Order) HasBackorders() bool {
+	for _, line := range o.Lines {
+		if line.Backordered > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PromoShare возвращает часть скидки по промокоду, которая приходится на товары стоимостью amount.
+//
+// Скидка промокода распределяется между строками заказа пропорционально их стоимости.
+func (o *Order) PromoShare(amount Money) Money {
+	total := o.LinesTotal()
+	if o.PromoDiscount == 0 || total == 0 {
+		return 0
+	}
+
+	return Money(roundDiv(int64(o.PromoDiscount)*int64(amount), int64(total)))
+}
+
+// Returnable сообщает, можно ли вернуть товары заказа в состоянии s.
+func (s OrderStatus) Returnable() bool {
+	return s == OrderPaid || s == OrderShipped
+}
+
+// OrderLine представляет строку заказа с ценой товара на момент покупки.
+type OrderLine struct {
+	Product       *Product
+	ProductCount  int
+	ProductPrice  Money
+	ProductSale   int
+	DiscountPrice Money    // Цена единицы товара со всеми скидками на момент покупки.
+	RuleDiscount  Money    // Скидка на всю строку от правил ценообразования.
+	Cost          Money    // Себестоимость всего товара строки на момент покупки.
+	ReturnedCount int      // Количество уже возвращенных единиц товара.
+	Backordered   int      // Количество товара, которое еще ожидает поступления на склад.
+	Serials       []string // Серийные номера возвращаемых единиц серийного товара.
+}
+
+// Total возвращает стоимость строки со всеми скидками.
+func (l *OrderLine) Total() Money {
+	return l.DiscountPrice.Mul(l.ProductCount) - l.RuleDiscount
+}
+
+// RuleDiscountFor возвращает часть скидки правил ценообразования, которая приходится
+// на count единиц товара строки.
+func (l *OrderLine) RuleDiscountFor(count int) Money {
+	if l.ProductCount == 0 {
+		return 0
+	}
+
+	return Money(roundDiv(int64(l.RuleDiscount)*int64(count), int64(l.ProductCount)))
+}
+
+// CostFor возвращает часть себестоимости строки, которая приходится на count единиц товара.
+//
+// Себестоимость строки учитывает только товар, уже списанный со склада, поэтому
+// товар, ожидающий поступления по предзаказу, в расчете не участвует.
+func (l *OrderLine) CostFor(count int) Money {
+	delivered := l.ProductCount - l.Backordered
+	if delivered <= 0 {
+		return 0
+	}
+
+	return Money(roundDiv(int64(l.Cost)*int64(count), int64(delivered)))
+}
+
+// Remaining возвращает количество единиц товара, которые еще можно вернуть.
+func (l *OrderLine) Remaining() int {
+	return l.ProductCount - l.ReturnedCount
+}
+
+// Returnable возвращает количество единиц товара, которые покупатель может вернуть.
+// Товар, который еще ожидает поступления по предзаказу, вернуть нельзя.
+func (l *OrderLine) Returnable() int {
+	return max(l.Remaining()-l.Backordered, 0)
+}
+
+// CanReturn сообщает, можно ли вернуть count единиц товара строки.
+func (l *OrderLine) CanReturn(count int) bool {
+	return count > 0 && count <= l.Returnable()
+}
+
+// PriceReturn заполняет строку возврата ret ценой, скидками и себестоимостью
+// товара строки на момент покупки.
+func (l *OrderLine) PriceReturn(ret *OrderLine) {
+	ret.ProductPrice = l.ProductPrice
+	ret.ProductSale = l.ProductSale
+	ret.DiscountPrice = l.DiscountPrice
+	ret.RuleDiscount = l.RuleDiscountFor(ret.ProductCount)
+	ret.Cost = l.CostFor(ret.ProductCount)
+}
+
+// Line возвращает строку заказа с продуктом productID. Если продукта нет в заказе, то возвращает nil.
+func (o *Order) Line(productID uuid.UUID) *OrderLine {
+	for _, line := range o.Lines {
+		if line.Product.ID == productID {
+			return line
+		}
+	}
+
+	return nil
+}
+
+// CancelLines возвращает строки возврата всего еще не возвращенного товара при отмене заказа.
+//
+// cancelled - количество товара отмененных предзаказов по продуктам. Оно записывается
+// в Backordered строк заказа и строк возврата: этот товар на склад не поступал,
+// поэтому себестоимость в строке возврата учитывает только товар, списанный со склада.
+func (o *Order) CancelLines(cancelled map[uuid.UUID]int) []*OrderLine {
+	lines := make([]*OrderLine, 0, len(o.Lines))
+	for _, line := range o.Lines {
+		line.Backordered = cancelled[line.Product.ID]
+		if line.Remaining() == 0 {
+			continue
+		}
+
+		lines = append(lines, &OrderLine{
+			Product:       line.Product,
+			ProductCount:  line.Remaining(),
+			ProductPrice:  line.ProductPrice,
+			ProductSale:   line.ProductSale,
+			DiscountPrice: line.DiscountPrice,
+			RuleDiscount:  line.RuleDiscountFor(line.Remaining()),
+			Cost:          line.CostFor(line.Returnable()),
+			Backordered:   line.Backordered,
+		})
+	}
+
+	return lines
+}
+
+// ApplyReturn учитывает строки возврата lines в строках заказа и возвращает
+// возвращенный товар в виде инвентаря склада заказа.
+//
+// Ценой инвентаря становится цена со скидкой, по которой товар был продан,
+// выручкой - стоимость строки возврата со всеми скидками за вычетом ее части скидки по промокоду,
+// а себестоимостью - себестоимость, по которой товар был списан при продаже.
+func (o *Order) ApplyReturn(lines []*OrderLine) []*Inventory {
+	invs := make([]*Inventory, 0, len(lines))
+	for _, line := range lines {
+		if orderLine := o.Line(line.Product.ID); orderLine != nil {
+			orderLine.ReturnedCount += line.ProductCount
+		}
+
+		unitCost := line.Cost.Div(line.ProductCount - line.Backordered)
+		invs = append(invs, &Inventory{
+			Product:      line.Product,
+			Warehouse:    o.Warehouse,
+			ProductCount: line.ProductCount,
+			ProductPrice: line.DiscountPrice,
+			UnitCost:     &unitCost,
+			Cost:         line.Cost,
+			Revenue:      line.Total() - o.PromoShare(line.Total()),
+		})
+	}
+
+	return invs
+}
+
+// OrderReturn представляет возврат товаров заказа или отмену заказа целиком.
+//
+// Если Quarantine равен true, то возвращенные товары не поступают в продажу,
+// а попадают в карантин склада.
+type OrderReturn struct {
+	ID         uuid.UUID
+	Order      *Order
+	Reason     string
+	Quarantine bool
+	Lines      []*OrderLine
+	CreatedAt  time.Time
+}
+
+// Restocked возвращает инвентарь, который поступает обратно на склад, из инвентаря invs,
+// полученного от ApplyReturn для строк возврата r.Lines.
+//
+// Товар отмененных предзаказов на склад не поступал, поэтому на склад не возвращается.
+func (r *OrderReturn) Restocked(invs []*Inventory) []*Inventory {
+	restocked := make([]*Inventory, 0, len(invs))
+	for i, inv := range invs {
+		if count := inv.ProductCount - r.Lines[i].Backordered; count > 0 {
+			restock := *inv
+			restock.ProductCount = count
+			restocked = append(restocked, &restock)
+		}
+	}
+
+	return restocked
+}
+
+// SaleCompensation возвращает строки продаж с отрицательными количеством, выручкой и себестоимостью,
+// которые компенсируют в аналитике продажу возвращенного товара invs.
+func SaleCompensation(invs []*Inventory) []*Inventory {
+	compensation := make([]*Inventory, 0, len(invs))
+	for _, inv := range invs {
+		c := *inv
+		c.ProductCount = -inv.ProductCount
+		c.Cost = -inv.Cost
+		c.Revenue = -inv.Revenue
+		compensation = append(compensation, &c)
+	}
+
+	return compensation
+}
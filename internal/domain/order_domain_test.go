@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderStatusCanTransitionTo(t *testing.T) {
+	allowed := map[OrderStatus]map[OrderStatus]bool{
+		OrderCreated: {OrderPaid: true, OrderCancelled: true},
+		OrderPaid:    {OrderShipped: true, OrderCancelled: true},
+	}
+
+	statuses := []OrderStatus{OrderCreated, OrderPaid, OrderShipped, OrderCancelled}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			t.Run(string(from)+" to "+string(to), func(t *testing.T) {
+				require.Equal(t, allowed[from][to], from.CanTransitionTo(to))
+			})
+		}
+	}
+}
+
+func TestOrderStatusReturnable(t *testing.T) {
+	tests := []struct {
+		status   OrderStatus
+		expected bool
+	}{
+		{status: OrderCreated},
+		{status: OrderPaid, expected: true},
+		{status: OrderShipped, expected: true},
+		{status: OrderCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.status.Returnable())
+		})
+	}
+}
+
+func TestOrderLineCanReturn(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     *OrderLine
+		count    int
+		expected bool
+	}{
+		{name: "whole line", line: &OrderLine{ProductCount: 5}, count: 5, expected: true},
+		{name: "more than bought", line: &OrderLine{ProductCount: 5}, count: 6},
+		{name: "zero count", line: &OrderLine{ProductCount: 5}, count: 0},
+		{name: "rest after previous return", line: &OrderLine{ProductCount: 5, ReturnedCount: 3}, count: 2, expected: true},
+		{name: "over return after previous return", line: &OrderLine{ProductCount: 5, ReturnedCount: 3}, count: 3},
+		{name: "backordered units", line: &OrderLine{ProductCount: 5, Backordered: 2}, count: 3, expected: true},
+		{name: "backordered units cannot be returned", line: &OrderLine{ProductCount: 5, Backordered: 2}, count: 4},
+		{name: "fully returned", line: &OrderLine{ProductCount: 5, ReturnedCount: 5}, count: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.line.CanReturn(tt.count))
+		})
+	}
+}
+
+func TestOrderLinePriceReturn(t *testing.T) {
+	line := &OrderLine{
+		ProductCount:  4,
+		ProductPrice:  1000,
+		ProductSale:   10,
+		DiscountPrice: 900,
+		RuleDiscount:  400,
+		Cost:          2000,
+		Backordered:   0,
+	}
+
+	ret := &OrderLine{ProductCount: 1}
+	line.PriceReturn(ret)
+
+	require.Equal(t, Money(1000), ret.ProductPrice)
+	require.Equal(t, 10, ret.ProductSale)
+	require.Equal(t, Money(900), ret.DiscountPrice)
+	require.Equal(t, Money(100), ret.RuleDiscount)
+	require.Equal(t, Money(500), ret.Cost)
+}
+
+func TestOrderCancelLines(t *testing.T) {
+	returned := &Product{ID: uuid.New()}
+	partial := &Product{ID: uuid.New()}
+	backordered := &Product{ID: uuid.New()}
+
+	order := &Order{Lines: []*OrderLine{
+		{Product: returned, ProductCount: 2, ReturnedCount: 2, DiscountPrice: 100, Cost: 100},
+		{Product: partial, ProductCount: 3, ReturnedCount: 1, DiscountPrice: 100, RuleDiscount: 30, Cost: 150},
+		{Product: backordered, ProductCount: 4, DiscountPrice: 100, Cost: 100},
+	}}
+
+	lines := order.CancelLines(map[uuid.UUID]int{backordered.ID: 3})
+
+	require.Len(t, lines, 2)
+
+	require.Equal(t, partial, lines[0].Product)
+	require.Equal(t, 2, lines[0].ProductCount)
+	require.Equal(t, Money(20), lines[0].RuleDiscount)
+	require.Equal(t, Money(100), lines[0].Cost)
+	require.Equal(t, 0, lines[0].Backordered)
+
+	require.Equal(t, backordered, lines[1].Product)
+	require.Equal(t, 4, lines[1].ProductCount)
+	require.Equal(t, 3, lines[1].Backordered)
+	require.Equal(t, Money(100), lines[1].Cost)
+	require.Equal(t, 3, order.Lines[2].Backordered)
+}
+
+func TestOrderApplyReturn(t *testing.T) {
+	product := &Product{ID: uuid.New()}
+	warehouse := &Warehouse{ID: uuid.New()}
+	order := &Order{
+		Warehouse:     warehouse,
+		Lines:         []*OrderLine{{Product: product, ProductCount: 5, DiscountPrice: 900, RuleDiscount: 500, ReturnedCount: 1}},
+		PromoDiscount: 800,
+	}
+
+	ret := &OrderReturn{Lines: []*OrderLine{
+		{Product: product, ProductCount: 4, DiscountPrice: 900, RuleDiscount: 100, Cost: 600, Backordered: 1},
+	}}
+
+	invs := order.ApplyReturn(ret.Lines)
+
+	require.Equal(t, 5, order.Lines[0].ReturnedCount)
+	require.Len(t, invs, 1)
+	require.Equal(t, warehouse, invs[0].Warehouse)
+	require.Equal(t, 4, invs[0].ProductCount)
+	require.Equal(t, Money(900), invs[0].ProductPrice)
+	require.Equal(t, Money(200), *invs[0].UnitCost)
+	require.Equal(t, Money(600), invs[0].Cost)
+	require.Equal(t, Money(2800), invs[0].Revenue)
+
+	restocked := ret.Restocked(invs)
+	require.Len(t, restocked, 1)
+	require.Equal(t, 3, restocked[0].ProductCount)
+	require.Equal(t, 4, invs[0].ProductCount)
+
+	compensation := SaleCompensation(invs)
+	require.Len(t, compensation, 1)
+	require.Equal(t, -4, compensation[0].ProductCount)
+	require.Equal(t, Money(-600), compensation[0].Cost)
+	require.Equal(t, Money(-2800), compensation[0].Revenue)
+	require.Equal(t, 4, invs[0].ProductCount)
+}
+
+func TestOrderReturnRestockedSkipsBackorders(t *testing.T) {
+	ret := &OrderReturn{Lines: []*OrderLine{{ProductCount: 2, Backordered: 2}}}
+
+	require.Empty(t, ret.Restocked([]*Inventory{{ProductCount: 2}}))
+}
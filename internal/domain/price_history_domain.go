@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PriceChange представляет запись в истории изменения цены и скидки товара на складе.
+type PriceChange struct {
+	ID        uuid.UUID
+	Warehouse *Warehouse
+	Product   *Product
+	OldPrice  Money
+	NewPrice  Money
+	OldSale   int
+	NewSale   int
+	ChangedBy string // Кто изменил цену. Пустая строка, если не указано.
+	RequestID string
+	ChangedAt time.Time
+}
@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PricingRuleType - вид правила ценообразования корзины.
+type PricingRuleType string
+
+const (
+	PricingTier   PricingRuleType = "tier"    // при покупке от MinCount единиц каждая стоит UnitPrice.
+	PricingNForM  PricingRuleType = "n_for_m" // из каждых Buy единиц оплачиваются только Pay.
+	PricingBundle PricingRuleType = "bundle"  // товары ProductIDs, купленные вместе, дешевле на Percent процентов.
+)
+
+// PricingRule представляет правило ценообразования, которое учитывает состав корзины.
+//
+// Правило применяется к ценам товаров после скидок. Если склад не задан,
+// то правило действует на всех складах.
+type PricingRule struct {
+	ID         uuid.UUID
+	Warehouse  *Warehouse
+	Name       string
+	Type       PricingRuleType
+	Priority   int
+	Active     bool
+	ProductID  uuid.UUID   // Товар правил PricingTier и PricingNForM.
+	ProductIDs []uuid.UUID // Товары набора PricingBundle.
+	MinCount   int         // Минимальное количество для PricingTier.
+	UnitPrice  Money       // Цена единицы для PricingTier.
+	Buy        int         // Размер группы для PricingNForM.
+	Pay        int         // Количество оплачиваемых единиц группы для PricingNForM.
+	Percent    int         // Процент скидки для PricingBundle.
+	CreatedAt  time.Time
+}
+
+// PricingAdjustment - скидка на строку корзины, которую дало правило ценообразования.
+type PricingAdjustment struct {
+	Rule     *PricingRule
+	Discount Money
+}
+
+// PricingEvaluator вычисляет скидки правила для строк корзины.
+//
+// items содержит строки корзины, к которым еще не применялись другие правила,
+// по идентификатору товара. Возвращает скидку на всю строку по идентификатору товара.
+type PricingEvaluator func(rule *PricingRule, items map[uuid.UUID]*Inventory) map[uuid.UUID]Money
+
+// pricingEvaluators содержит вычислители для каждого вида правил.
+var pricingEvaluators = map[PricingRuleType]PricingEvaluator{
+	PricingTier:   evaluateTier,
+	PricingNForM:  evaluateNForM,
+	PricingBundle: evaluateBundle,
+}
+
+// RegisterPricingEvaluator добавляет вычислитель для нового вида правил или заменяет существующий.
+// Должна вызываться при инициализации приложения.
+func RegisterPricingEvaluator(ruleType PricingRuleType, evaluator PricingEvaluator) {
+	pricingEvaluators[ruleType] = evaluator
+}
+
+// KnownPricingRuleType сообщает, есть ли вычислитель для вида правил ruleType.
+func KnownPricingRuleType(ruleType PricingRuleType) bool {
+	_, ok := pricingEvaluators[ruleType]
+	return ok
+}
+
+// ApplyPricingRules применяет правила ценообразования к товарам корзины.
+//
+// Правила рассматриваются по убыванию приоритета, при равном приоритете первым идет
+// созданное раньше. Строка корзины получает скидку не больше чем от одного правила:
+// строки, к которым уже применено правило, следующим правилам не передаются.
+func (c *Cart) ApplyPricingRules(rules []*PricingRule) {
+	sorted := make([]*PricingRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Active && (rule.Warehouse == nil || rule.Warehouse.ID == c.Warehouse.ID) {
+			sorted = append(sorted, rule)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority > sorted[j].Priority
+		}
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	free := make(map[uuid.UUID]*Inventory, len(c.Items))
+	for _, inv := range c.Items {
+		inv.Adjustments = nil
+		free[inv.Product.ID] = inv
+	}
+
+	for _, rule := range sorted {
+		evaluate, ok := pricingEvaluators[rule.Type]
+		if !ok {
+			continue
+		}
+
+		for productID, discount := range evaluate(rule, free) {
+			inv, ok := free[productID]
+			if !ok || discount <= 0 {
+				continue
+			}
+
+			inv.Adjustments = append(inv.Adjustments, &PricingAdjustment{
+				Rule:     rule,
+				Discount: min(discount, inv.PriceWithDiscount().Mul(inv.ProductCount)),
+			})
+			delete(free, productID)
+		}
+	}
+}
+
+// evaluateTier вычисляет скидку правила PricingTier.
+func evaluateTier(rule *PricingRule, items map[uuid.UUID]*Inventory) map[uuid.UUID]Money {
+	inv, ok := items[rule.ProductID]
+	if !ok || inv.ProductCount < rule.MinCount {
+		return nil
+	}
+
+	price := inv.PriceWithDiscount()
+	if price <= rule.UnitPrice {
+		return nil
+	}
+
+	return map[uuid.UUID]Money{
+		rule.ProductID: (price - rule.UnitPrice).Mul(inv.ProductCount),
+	}
+}
+
+// evaluateNForM вычисляет скидку правила PricingNForM.
+func evaluateNForM(rule *PricingRule, items map[uuid.UUID]*Inventory) map[uuid.UUID]Money {
+	inv, ok := items[rule.ProductID]
+	if !ok || rule.Buy <= 0 {
+		return nil
+	}
+
+	free := inv.ProductCount / rule.Buy * (rule.Buy - rule.Pay)
+
+	return map[uuid.UUID]Money{
+		rule.ProductID: inv.PriceWithDiscount().Mul(free),
+	}
+}
+
+// evaluateBundle вычисляет скидку правила PricingBundle.
+//
+// Скидка дается на полные наборы: их количество равно наименьшему количеству
+// товара набора в корзине.
+func evaluateBundle(rule *PricingRule, items map[uuid.UUID]*Inventory) map[uuid.UUID]Money {
+	if len(rule.ProductIDs) == 0 {
+		return nil
+	}
+
+	sets := -1
+	for _, productID := range rule.ProductIDs {
+		inv, ok := items[productID]
+		if !ok {
+			return nil
+		}
+		if sets < 0 || inv.ProductCount < sets {
+			sets = inv.ProductCount
+		}
+	}
+
+	discounts := make(map[uuid.UUID]Money, len(rule.ProductIDs))
+	for _, productID := range rule.ProductIDs {
+		price := items[productID].PriceWithDiscount().Mul(sets)
+		discounts[productID] = price - price.WithDiscount(rule.Percent)
+	}
+
+	return discounts
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCartApplyPricingRules(t *testing.T) {
+	warehouse := &Warehouse{ID: uuid.New()}
+	productA, productB := uuid.New(), uuid.New()
+
+	newCart := func(countA, countB int) *Cart {
+		return &Cart{
+			Warehouse: warehouse,
+			Items: []*Inventory{
+				{Product: &Product{ID: productA}, ProductCount: countA, ProductPrice: 1000},
+				{Product: &Product{ID: productB}, ProductCount: countB, ProductPrice: 2000},
+			},
+		}
+	}
+
+	tier := &PricingRule{Type: PricingTier, Active: true, ProductID: productA, MinCount: 10, UnitPrice: 800}
+	threeForTwo := &PricingRule{Type: PricingNForM, Active: true, ProductID: productA, Buy: 3, Pay: 2}
+	bundle := &PricingRule{Type: PricingBundle, Active: true, ProductIDs: []uuid.UUID{productA, productB}, Percent: 15, Priority: 1}
+	otherWarehouse := &PricingRule{Warehouse: &Warehouse{ID: uuid.New()}, Type: PricingNForM, Active: true, ProductID: productB, Buy: 2, Pay: 1}
+
+	tests := []struct {
+		name      string
+		cart      *Cart
+		rules     []*PricingRule
+		wantA     Money
+		wantB     Money
+		wantRuleA *PricingRule
+	}{
+		{name: "tier below min count", cart: newCart(9, 0), rules: []*PricingRule{tier}},
+		{name: "tier", cart: newCart(10, 0), rules: []*PricingRule{tier}, wantA: 2000, wantRuleA: tier},
+		{name: "3 for 2", cart: newCart(7, 0), rules: []*PricingRule{threeForTwo}, wantA: 2000, wantRuleA: threeForTwo},
+		{name: "bundle by full sets", cart: newCart(2, 1), rules: []*PricingRule{bundle}, wantA: 150, wantB: 300, wantRuleA: bundle},
+		{name: "line gets one rule by priority", cart: newCart(3, 1), rules: []*PricingRule{threeForTwo, bundle}, wantA: 150, wantB: 300, wantRuleA: bundle},
+		{name: "rule of other warehouse skipped", cart: newCart(0, 2), rules: []*PricingRule{otherWarehouse}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cart.ApplyPricingRules(tt.rules)
+
+			itemA, itemB := tt.cart.Items[0], tt.cart.Items[1]
+			require.Equal(t, tt.wantA, itemA.RuleDiscount())
+			require.Equal(t, tt.wantB, itemB.RuleDiscount())
+			if tt.wantRuleA != nil {
+				require.Len(t, itemA.Adjustments, 1)
+				require.Same(t, tt.wantRuleA, itemA.Adjustments[0].Rule)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // Product представляет продукт с его деталями.
 type Product struct {
@@ -10,4 +14,6 @@ type Product struct {
 	Description string
 	Barcode     string // Штрихкод. Здесь хранится только название файла. Сам файл хранится на диске сервера. sdasad
 	Params      map[string]any
+	Serialized  bool       // Каждая единица товара учитывается по серийному номеру.
+	ArchivedAt  *time.Time // Момент архивации. nil, если продукт не в архиве.
 }
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PromoCode представляет промокод, который покупатель указывает при оформлении корзины.
+//
+// Скидка промокода применяется к стоимости корзины после скидок на товары.
+type PromoCode struct {
+	ID         uuid.UUID
+	Code       string
+	Type       DiscountType
+	Percent    int        // Процент скидки для DiscountPercent.
+	Amount     Money      // Сумма скидки с корзины для DiscountFixed.
+	MinTotal   Money      // Минимальная стоимость корзины, с которой действует промокод.
+	Warehouse  *Warehouse // Склад, на котором действует промокод. nil, если промокод действует везде.
+	UsageLimit *int       // Сколько раз можно использовать промокод. nil, если без ограничений.
+	UsedCount  int
+	ExpiresAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// Expired сообщает, истек ли срок действия промокода в момент t.
+func (p *PromoCode) Expired(t time.Time) bool {
+	return p.ExpiresAt != nil && !t.Before(*p.ExpiresAt)
+}
+
+// Exhausted сообщает, исчерпан ли лимит использований промокода.
+func (p *PromoCode) Exhausted() bool {
+	return p.UsageLimit != nil && p.UsedCount >= *p.UsageLimit
+}
+
+// AppliesTo сообщает, действует ли промокод на складе warehouseID.
+func (p *PromoCode) AppliesTo(warehouseID uuid.UUID) bool {
+	return p.Warehouse == nil || p.Warehouse.ID == warehouseID
+}
+
+// Discount возвращает скидку промокода для корзины стоимостью total.
+// Скидка не превышает стоимость корзины.
+func (p *PromoCode) Discount(total Money) Money {
+	switch p.Type {
+	case DiscountPercent:
+		return total - total.WithDiscount(p.Percent)
+	case DiscountFixed:
+		return min(p.Amount, total)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromoCodeDiscount(t *testing.T) {
+	percent := &PromoCode{Type: DiscountPercent, Percent: 15}
+	fixed := &PromoCode{Type: DiscountFixed, Amount: 50000}
+
+	require.Equal(t, Money(1500), percent.Discount(10000))
+	require.Equal(t, Money(10000), fixed.Discount(10000))
+	require.Equal(t, Money(50000), fixed.Discount(80000))
+}
+
+func TestOrderPromoShare(t *testing.T) {
+	order := &Order{
+		PromoDiscount: 1000,
+		Lines: []*OrderLine{
+			{ProductCount: 2, DiscountPrice: 3000},
+			{ProductCount: 1, DiscountPrice: 4000},
+		},
+	}
+
+	require.Equal(t, Money(600), order.PromoShare(6000))
+	require.Equal(t, Money(1000), order.PromoShare(order.LinesTotal()))
+	require.Equal(t, Money(0), (&Order{}).PromoShare(6000))
+}
+
+func TestCartPromoShare(t *testing.T) {
+	cart := &Cart{
+		PromoDiscount: 1000,
+		Items: []*Inventory{
+			{ProductCount: 2, ProductPrice: 3000},
+			{ProductCount: 1, ProductPrice: 5000, ProductSale: 20},
+		},
+	}
+
+	require.Equal(t, Money(600), cart.PromoShare(cart.Items[0].Total()))
+	require.Equal(t, Money(400), cart.PromoShare(cart.Items[1].Total()))
+	require.Equal(t, Money(0), (&Cart{}).PromoShare(6000))
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PurchaseOrderStatus - состояние заказа поставщику.
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderOrdered           PurchaseOrderStatus = "ordered"            // заказ отправлен поставщику, товар еще не поступал.
+	PurchaseOrderPartiallyReceived PurchaseOrderStatus = "partially_received" // часть товара принята на склад.
+	PurchaseOrderReceived          PurchaseOrderStatus = "received"           // весь заказанный товар принят на склад.
+	PurchaseOrderCancelled         PurchaseOrderStatus = "cancelled"          // заказ отменен, оставшийся товар не ожидается.
+)
+
+// Open сообщает, ожидается ли еще поступление товара по заказу в состоянии s.
+func (s PurchaseOrderStatus) Open() bool {
+	return s == PurchaseOrderOrdered || s == PurchaseOrderPartiallyReceived
+}
+
+// PurchaseOrder представляет заказ товаров у поставщика для склада.
+type PurchaseOrder struct {
+	ID        uuid.UUID
+	Supplier  *Supplier
+	Warehouse *Warehouse
+	Status    PurchaseOrderStatus
+	Lines     []*PurchaseOrderLine
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ReceivedStatus возвращает состояние заказа по количеству принятого товара в строках.
+func (o *PurchaseOrder) ReceivedStatus() PurchaseOrderStatus {
+	var received, remaining int
+	for _, line := range o.Lines {
+		received += line.ReceivedCount
+		remaining += line.Remaining()
+	}
+
+	switch {
+	case remaining == 0:
+		return PurchaseOrderReceived
+	case received > 0:
+		return PurchaseOrderPartiallyReceived
+	default:
+		return PurchaseOrderOrdered
+	}
+}
+
+// Total возвращает стоимость всего заказанного товара.
+func (o *PurchaseOrder) Total() Money {
+	var total Money
+	for _, line := range o.Lines {
+		total += line.UnitCost.Mul(line.OrderedCount)
+	}
+
+	return total
+}
+
+// PurchaseOrderLine представляет строку заказа поставщику.
+type PurchaseOrderLine struct {
+	Product       *Product
+	OrderedCount  int
+	ReceivedCount int        // Количество уже принятых единиц товара.
+	UnitCost      Money      // Закупочная цена единицы товара.
+	ExpectedAt    *time.Time // Ожидаемая дата поступления. nil, если не указана.
+}
+
+// Remaining возвращает количество единиц товара, которые еще ожидаются.
+func (l *PurchaseOrderLine) Remaining() int {
+	return l.OrderedCount - l.ReceivedCount
+}
+
+// PurchaseOrderReceipt представляет приемку товаров по заказу поставщику.
+type PurchaseOrderReceipt struct {
+	Order *PurchaseOrder
+	Lines []*PurchaseOrderReceiptLine
+}
+
+// PurchaseOrderReceiptLine представляет принимаемый товар.
+//
+// Если указан номер партии, то товар принимается партией со сроком годности ExpiresAt.
+type PurchaseOrderReceiptLine struct {
+	Product   *Product
+	Count     int
+	LotNumber string
+	ExpiresAt *time.Time
+	Serials   []string // Серийные номера принимаемых единиц серийного товара.
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurchaseOrderReceivedStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		lines    []*PurchaseOrderLine
+		expected PurchaseOrderStatus
+	}{
+		{
+			name:     "nothing received",
+			lines:    []*PurchaseOrderLine{{OrderedCount: 5}, {OrderedCount: 3}},
+			expected: PurchaseOrderOrdered,
+		},
+		{
+			name:     "one line partially received",
+			lines:    []*PurchaseOrderLine{{OrderedCount: 5, ReceivedCount: 2}, {OrderedCount: 3}},
+			expected: PurchaseOrderPartiallyReceived,
+		},
+		{
+			name:     "one line fully received",
+			lines:    []*PurchaseOrderLine{{OrderedCount: 5, ReceivedCount: 5}, {OrderedCount: 3}},
+			expected: PurchaseOrderPartiallyReceived,
+		},
+		{
+			name:     "all lines received",
+			lines:    []*PurchaseOrderLine{{OrderedCount: 5, ReceivedCount: 5}, {OrderedCount: 3, ReceivedCount: 3}},
+			expected: PurchaseOrderReceived,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := &PurchaseOrder{Lines: tt.lines}
+
+			require.Equal(t, tt.expected, order.ReceivedStatus())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReservationStatus - состояние резерва товаров.
+type ReservationStatus string
+
+const (
+	ReservationActive   ReservationStatus = "active"   // товары удерживаются до истечения срока.
+	ReservationConsumed ReservationStatus = "consumed" // резерв использован при покупке.
+	ReservationReleased ReservationStatus = "released" // резерв освобожден по истечении срока.
+)
+
+// Reservation представляет временное удержание товаров на складе между расчетом корзины и покупкой.
+type Reservation struct {
+	ID        uuid.UUID
+	Warehouse *Warehouse
+	Status    ReservationStatus
+	Items     []*Inventory
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// Covers сообщает, покрывает ли резерв товары items: каждый товар должен быть
+// зарезервирован в количестве не меньше покупаемого.
+//
+// Зарезервированный товар, который не покупается, освобождается вместе с резервом.
+func (r *Reservation) Covers(items []*Inventory) bool {
+	reserved := make(map[uuid.UUID]int, len(r.Items))
+	for _, inv := range r.Items {
+		reserved[inv.Product.ID] += inv.ProductCount
+	}
+
+	for _, inv := range items {
+		reserved[inv.Product.ID] -= inv.ProductCount
+		if reserved[inv.Product.ID] < 0 {
+			return false
+		}
+	}
+
+	return true
+}
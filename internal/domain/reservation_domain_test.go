@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservationCovers(t *testing.T) {
+	apple := &Product{ID: uuid.New()}
+	banana := &Product{ID: uuid.New()}
+
+	item := func(product *Product, count int) *Inventory {
+		return &Inventory{Product: product, ProductCount: count}
+	}
+
+	reservation := &Reservation{Items: []*Inventory{item(apple, 3), item(banana, 2)}}
+
+	tests := []struct {
+		name     string
+		items    []*Inventory
+		expected bool
+	}{
+		{
+			name:     "exact match",
+			items:    []*Inventory{item(apple, 3), item(banana, 2)},
+			expected: true,
+		},
+		{
+			name:     "fewer units and products",
+			items:    []*Inventory{item(apple, 1)},
+			expected: true,
+		},
+		{
+			name:  "more units than reserved",
+			items: []*Inventory{item(apple, 4), item(banana, 2)},
+		},
+		{
+			name:  "product not reserved",
+			items: []*Inventory{item(apple, 1), item(&Product{ID: uuid.New()}, 1)},
+		},
+		{
+			name:  "repeated product over reserved count",
+			items: []*Inventory{item(banana, 1), item(banana, 2)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, reservation.Covers(tt.items))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SerialStatus - состояние единицы серийного товара.
+type SerialStatus string
+
+const (
+	SerialInStock    SerialStatus = "in_stock"   // единица находится на складе и доступна для продажи.
+	SerialInTransit  SerialStatus = "in_transit" // единица перемещается на другой склад.
+	SerialSold       SerialStatus = "sold"       // единица продана.
+	SerialQuarantine SerialStatus = "quarantine" // единица возвращена в карантин склада.
+)
+
+// ProductSerial представляет единицу серийного товара.
+type ProductSerial struct {
+	ID           uuid.UUID
+	SerialNumber string
+	Product      *Product
+	Warehouse    *Warehouse // Склад, на котором находится единица. Для проданной - склад продажи, для единицы в пути - склад-получатель.
+	Status       SerialStatus
+	OrderID      uuid.UUID // Заказ, в котором продана единица. uuid.Nil, если единица не продана.
+	TransferID   uuid.UUID // Последнее перемещение единицы. uuid.Nil, если единица не перемещалась.
+	ReceivedAt   time.Time
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,61 @@
+package domain
+
+import "time"
+
+// StockAlertKind - вид оповещения об остатке товара.
+type StockAlertKind string
+
+const (
+	StockAlertLow       StockAlertKind = "low_stock" // остаток опустился до минимального количества.
+	StockAlertRestocked StockAlertKind = "restocked" // остаток снова превысил минимальное количество.
+
+	StockAlertBackorderFulfilled StockAlertKind = "backorder_fulfilled" // предзаказ полностью обеспечен поступившим товаром.
+)
+
+// StockAlert представляет оповещение о том, что остаток товара на складе пересек
+// минимальное количество.
+type StockAlert struct {
+	Kind            StockAlertKind
+	Warehouse       *Warehouse
+	Product         *Product
+	ProductCount    int
+	MinQuantity     int
+	ReorderQuantity int
+	Reason          MovementReason // Движение товара, из-за которого изменился остаток.
+	Backorder       *Backorder     // Выполненный предзаказ. Задан только для StockAlertBackorderFulfilled.
+	CreatedAt       time.Time
+}
+
+// LowStock сообщает, что остаток товара не больше минимального количества.
+// Если минимальное количество не задано, то остаток не считается низким.
+func (inv *Inventory) LowStock() bool {
+	return inv.MinQuantity > 0 && inv.ProductCount <= inv.MinQuantity
+}
+
+// StockAlert возвращает оповещение, если при изменении остатка с before до ProductCount
+// остаток пересек минимальное количество. Иначе возвращает nil.
+func (inv *Inventory) StockAlert(before int, reason MovementReason) *StockAlert {
+	prev := *inv
+	prev.ProductCount = before
+
+	var kind StockAlertKind
+	switch {
+	case inv.LowStock() && !prev.LowStock():
+		kind = StockAlertLow
+	case !inv.LowStock() && prev.LowStock():
+		kind = StockAlertRestocked
+	default:
+		return nil
+	}
+
+	return &StockAlert{
+		Kind:            kind,
+		Warehouse:       inv.Warehouse,
+		Product:         inv.Product,
+		ProductCount:    inv.ProductCount,
+		MinQuantity:     inv.MinQuantity,
+		ReorderQuantity: inv.ReorderQuantity,
+		Reason:          reason,
+		CreatedAt:       time.Now(),
+	}
+}
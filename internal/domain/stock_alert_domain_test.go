@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryStockAlert(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   int
+		after    int
+		minQty   int
+		expected StockAlertKind
+	}{
+		{name: "falls to threshold", before: 12, after: 10, minQty: 10, expected: StockAlertLow},
+		{name: "falls below threshold", before: 11, after: 0, minQty: 10, expected: StockAlertLow},
+		{name: "stays above threshold", before: 20, after: 11, minQty: 10},
+		{name: "stays low", before: 8, after: 5, minQty: 10},
+		{name: "restocked", before: 5, after: 30, minQty: 10, expected: StockAlertRestocked},
+		{name: "threshold disabled", before: 5, after: 0, minQty: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := &Inventory{ProductCount: tt.after, MinQuantity: tt.minQty, ReorderQuantity: 50}
+
+			alert := inv.StockAlert(tt.before, MovementSale)
+			if tt.expected == "" {
+				require.Nil(t, alert)
+				return
+			}
+
+			require.NotNil(t, alert)
+			require.Equal(t, tt.expected, alert.Kind)
+			require.Equal(t, tt.after, alert.ProductCount)
+			require.Equal(t, 50, alert.ReorderQuantity)
+		})
+	}
+}
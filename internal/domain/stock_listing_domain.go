@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// StockSort - поле сортировки товаров склада.
+type StockSort string
+
+const (
+	StockSortName          StockSort = "name"           // по названию продукта.
+	StockSortPrice         StockSort = "price"          // по цене без скидок.
+	StockSortDiscountPrice StockSort = "discount_price" // по цене со скидкой.
+	StockSortCount         StockSort = "count"          // по количеству товара на складе.
+)
+
+// ParseStockSort проверяет название поля сортировки товаров склада.
+func ParseStockSort(s string) (StockSort, error) {
+	switch stockSort := StockSort(s); stockSort {
+	case StockSortName, StockSortPrice, StockSortDiscountPrice, StockSortCount:
+		return stockSort, nil
+	}
+
+	return "", fmt.Errorf("unknown stock sort %q", s)
+}
+
+// StockCursor указывает на последний товар страницы. Следующая страница начинается
+// с товара, который идет сразу после него в порядке сортировки.
+//
+// Курсор хранит значение поля сортировки, а не позицию товара, поэтому страницы
+// не сдвигаются, если товары добавляются или удаляются между запросами.
+type StockCursor struct {
+	Sort      StockSort `json:"s"`
+	Desc      bool      `json:"d,omitempty"`
+	Name      string    `json:"n,omitempty"`
+	Price     Money     `json:"p,omitempty"`
+	Count     int       `json:"c,omitempty"`
+	ProductID uuid.UUID `json:"id"`
+}
+
+// Encode возвращает курсор в виде строки, которую можно передать в параметрах запроса.
+func (c *StockCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseStockCursor разбирает курсор, полученный от Encode.
+func ParseStockCursor(s string) (*StockCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid stock cursor")
+	}
+
+	cursor := &StockCursor{}
+	if err = json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("invalid stock cursor")
+	}
+
+	if _, err = ParseStockSort(string(cursor.Sort)); err != nil {
+		return nil, fmt.Errorf("invalid stock cursor")
+	}
+
+	return cursor, nil
+}
+
+// StockListing описывает, какие товары склада и в каком порядке попадают на страницу.
+//
+// Цены фильтров сравниваются с ценой со скидкой. Товар считается товаром со скидкой,
+// если цена со скидкой меньше цены без скидок. Товары с одинаковым значением поля
+// сортировки упорядочиваются по идентификатору продукта, поэтому порядок всегда однозначен.
+type StockListing struct {
+	Sort       StockSort
+	Desc       bool
+	InStock    bool // Только товары, которые есть на складе.
+	Discounted bool // Только товары со скидкой.
+	MinPrice   *Money
+	MaxPrice   *Money
+	After      *StockCursor // Курсор предыдущей страницы. Если он задан, то Offset не учитывается.
+	Offset     int
+	Limit      int
+}
+
+// StockPage представляет страницу товаров склада.
+type StockPage struct {
+	Items []*Inventory
+	Total int          // Количество всех товаров, подходящих под фильтры.
+	Next  *StockCursor // Курсор следующей страницы. nil, если страница последняя.
+}
+
+// Cursor возвращает курсор, указывающий на товар inv с ценой со скидкой discountPrice.
+func (l *StockListing) Cursor(inv *Inventory, discountPrice Money) *StockCursor {
+	cursor := &StockCursor{
+		Sort:      l.Sort,
+		Desc:      l.Desc,
+		ProductID: inv.Product.ID,
+	}
+
+	switch l.Sort {
+	case StockSortPrice:
+		cursor.Price = inv.ProductPrice
+	case StockSortDiscountPrice:
+		cursor.Price = discountPrice
+	case StockSortCount:
+		cursor.Count = inv.ProductCount
+	default:
+		cursor.Name = inv.Product.Name
+	}
+
+	return cursor
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStockListingCursor(t *testing.T) {
+	inv := &Inventory{
+		Product:      &Product{ID: uuid.New(), Name: "apple"},
+		ProductCount: 5,
+		ProductPrice: 1000,
+	}
+
+	tests := []struct {
+		name    string
+		listing StockListing
+		want    *StockCursor
+	}{
+		{
+			name:    "name",
+			listing: StockListing{Sort: StockSortName},
+			want:    &StockCursor{Sort: StockSortName, Name: "apple", ProductID: inv.Product.ID},
+		},
+		{
+			name:    "price descending",
+			listing: StockListing{Sort: StockSortPrice, Desc: true},
+			want:    &StockCursor{Sort: StockSortPrice, Desc: true, Price: 1000, ProductID: inv.Product.ID},
+		},
+		{
+			name:    "discount price",
+			listing: StockListing{Sort: StockSortDiscountPrice},
+			want:    &StockCursor{Sort: StockSortDiscountPrice, Price: 800, ProductID: inv.Product.ID},
+		},
+		{
+			name:    "count",
+			listing: StockListing{Sort: StockSortCount},
+			want:    &StockCursor{Sort: StockSortCount, Count: 5, ProductID: inv.Product.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.listing.Cursor(inv, 800))
+		})
+	}
+}
+
+func TestParseStockCursor(t *testing.T) {
+	cursor := &StockCursor{Sort: StockSortDiscountPrice, Desc: true, Price: 1999, ProductID: uuid.New()}
+
+	parsed, err := ParseStockCursor(cursor.Encode())
+	require.NoError(t, err)
+	require.Equal(t, cursor, parsed)
+
+	invalid := []string{
+		"not base64!",
+		base64.RawURLEncoding.EncodeToString([]byte("not json")),
+		(&StockCursor{Sort: "weight", ProductID: uuid.New()}).Encode(),
+	}
+	for _, s := range invalid {
+		_, err = ParseStockCursor(s)
+		require.EqualError(t, err, "invalid stock cursor", s)
+	}
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MovementReason - причина изменения количества товара на складе.
+type MovementReason string
+
+const (
+	MovementReceipt    MovementReason = "receipt"    // поступление товара на склад.
+	MovementAdjustment MovementReason = "adjustment" // ручное изменение количества.
+	MovementSale       MovementReason = "sale"       // продажа товара.
+	MovementTransfer   MovementReason = "transfer"   // перемещение между складами.
+	MovementCorrection MovementReason = "correction" // исправление по результатам пересчета.
+	MovementReturn     MovementReason = "return"     // возврат товара покупателем или отмена заказа.
+)
+
+// StockMovement представляет запись в журнале движения товара на складе.
+type StockMovement struct {
+	ID              uuid.UUID
+	Warehouse       *Warehouse
+	Product         *Product
+	Delta           int
+	Reason          MovementReason
+	RequestID       string
+	StocktakeID     uuid.UUID // Пересчет, по результатам которого сделано исправление. uuid.Nil для остальных движений.
+	PurchaseOrderID uuid.UUID // Заказ поставщику, по которому принят товар. uuid.Nil для остальных движений.
+	CreatedAt       time.Time
+}
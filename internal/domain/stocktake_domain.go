@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StocktakeStatus - состояние пересчета товаров на складе.
+type StocktakeStatus string
+
+const (
+	StocktakeOpen      StocktakeStatus = "open"      // пересчет идет, можно вносить посчитанное количество.
+	StocktakePosted    StocktakeStatus = "posted"    // расхождения проведены как исправления остатков.
+	StocktakeCancelled StocktakeStatus = "cancelled" // пересчет отменен без изменения остатков.
+)
+
+// Stocktake представляет пересчет товаров на складе.
+type Stocktake struct {
+	ID        uuid.UUID
+	Warehouse *Warehouse
+	Status    StocktakeStatus
+	Lines     []*StocktakeLine
+	CreatedAt time.Time
+	ClosedAt  *time.Time // Время проведения или отмены пересчета.
+}
+
+// StocktakeLine представляет товар в пересчете.
+type StocktakeLine struct {
+	Product       *Product
+	ExpectedCount int   // Остаток на момент открытия пересчета.
+	SystemCount   int   // Текущий остаток товара на складе.
+	ProductPrice  Money // Текущая цена товара на складе.
+	CountedCount  *int  // Посчитанное количество. nil, если товар еще не посчитан.
+	CountedAt     *time.Time
+	PostedDelta   *int // Проведенное исправление. nil, если строка не проводилась.
+}
+
+// Variance возвращает расхождение посчитанного количества с текущим остатком:
+// положительное при излишке, отрицательное при недостаче.
+// Для непосчитанного товара расхождение равно 0.
+func (l *StocktakeLine) Variance() int {
+	if l.CountedCount == nil {
+		return 0
+	}
+
+	return *l.CountedCount - l.SystemCount
+}
+
+// VarianceValue возвращает стоимость расхождения по текущей цене товара.
+func (l *StocktakeLine) VarianceValue() Money {
+	return l.ProductPrice.Mul(l.Variance())
+}
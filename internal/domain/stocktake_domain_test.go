@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStocktakeLineVariance(t *testing.T) {
+	count := func(n int) *int { return &n }
+
+	tests := []struct {
+		name          string
+		systemCount   int
+		countedCount  *int
+		variance      int
+		varianceValue Money
+	}{
+		{name: "not counted", systemCount: 10},
+		{name: "matches", systemCount: 10, countedCount: count(10)},
+		{name: "surplus", systemCount: 10, countedCount: count(12), variance: 2, varianceValue: 300},
+		{name: "shortage", systemCount: 10, countedCount: count(7), variance: -3, varianceValue: -450},
+		{name: "counted empty", systemCount: 4, countedCount: count(0), variance: -4, varianceValue: -600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := &StocktakeLine{SystemCount: tt.systemCount, CountedCount: tt.countedCount, ProductPrice: 150}
+
+			require.Equal(t, tt.variance, line.Variance())
+			require.Equal(t, tt.varianceValue, line.VarianceValue())
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Supplier представляет поставщика товаров.
+type Supplier struct {
+	ID        uuid.UUID
+	Name      string
+	Email     string
+	Phone     string
+	CreatedAt time.Time
+}
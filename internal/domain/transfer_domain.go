@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransferStatus - состояние перемещения товаров между складами.
+type TransferStatus string
+
+const (
+	TransferInTransit TransferStatus = "in_transit" // товар списан со склада-отправителя и находится в пути.
+	TransferReceived  TransferStatus = "received"   // товар оприходован на складе-получателе.
+)
+
+// transferTransitions описывает допустимые переходы между состояниями перемещения.
+var transferTransitions = map[TransferStatus][]TransferStatus{
+	TransferInTransit: {TransferReceived},
+}
+
+// CanTransitionTo сообщает, может ли перемещение перейти из состояния s в состояние next.
+func (s TransferStatus) CanTransitionTo(next TransferStatus) bool {
+	for _, status := range transferTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Transfer представляет перемещение товаров между складами.
+type Transfer struct {
+	ID          uuid.UUID
+	Source      *Warehouse
+	Destination *Warehouse
+	Status      TransferStatus
+	Products    []*TransferProduct
+	CreatedAt   time.Time
+	ReceivedAt  *time.Time
+}
+
+// TransferProduct представляет продукт в перемещении с его количеством.
+type TransferProduct struct {
+	Product      *Product
+	ProductCount int
+	UnitCost     Money // Себестоимость единицы товара, списанного со склада-отправителя.
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferStatusCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     TransferStatus
+		to       TransferStatus
+		expected bool
+	}{
+		{name: "in transit to received", from: TransferInTransit, to: TransferReceived, expected: true},
+		{name: "in transit to in transit", from: TransferInTransit, to: TransferInTransit},
+		{name: "received to received", from: TransferReceived, to: TransferReceived},
+		{name: "received to in transit", from: TransferReceived, to: TransferInTransit},
+		{name: "unknown status", from: TransferStatus("lost"), to: TransferReceived},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ValuationMethod - метод оценки себестоимости списываемого товара.
+type ValuationMethod string
+
+const (
+	ValuationFIFO    ValuationMethod = "fifo"    // товар списывается по цене самых ранних поступлений.
+	ValuationAverage ValuationMethod = "average" // товар списывается по средневзвешенной себестоимости.
+)
+
+// ParseValuationMethod проверяет название метода оценки себестоимости.
+func ParseValuationMethod(s string) (ValuationMethod, error) {
+	switch method := ValuationMethod(s); method {
+	case ValuationFIFO, ValuationAverage:
+		return method, nil
+	}
+
+	return "", fmt.Errorf("unknown valuation method %q", s)
+}
+
+// CostLayer представляет поступление товара на склад с известной себестоимостью единицы.
+//
+// Слои учитываются внутри строки инвентаря: сумма их количества не превышает
+// ProductCount, остаток считается товаром без известной себестоимости.
+type CostLayer struct {
+	ID         uuid.UUID
+	UnitCost   Money
+	Count      int // Количество единиц поступления, которые еще остались на складе.
+	ReceivedAt time.Time
+}
+
+// LayersValue возвращает себестоимость всех единиц товара в слоях.
+func LayersValue(layers []*CostLayer) (Money, int) {
+	var (
+		value Money
+		count int
+	)
+
+	for _, layer := range layers {
+		value += layer.UnitCost.Mul(layer.Count)
+		count += layer.Count
+	}
+
+	return value, count
+}
+
+// AverageCost возвращает средневзвешенную себестоимость единицы товара в слоях.
+// Если в слоях нет товара, то возвращает 0.
+func AverageCost(layers []*CostLayer) Money {
+	value, count := LayersValue(layers)
+	return value.Div(count)
+}
+
+// Consume списывает count единиц товара со слоев и возвращает их себестоимость
+// и слои после списания.
+//
+// Первыми списываются uncosted единиц товара без известной себестоимости: они поступили
+// раньше всех слоев и списываются по нулевой цене. Остаток списывается со слоев layers,
+// отсортированных от ранних поступлений к поздним. Количество и цена слоев изменяются на месте.
+//
+// При средневзвешенной оценке себестоимость списания считается как доля общей
+// себестоимости слоев, а не через округленную среднюю цену, после чего оставшиеся слои
+// переоцениваются по средней цене так, чтобы их себестоимость была равна оставшейся
+// точно. Поэтому списание всех слоев за несколько продаж стоит ровно столько,
+// сколько стоили поступления.
+func (m ValuationMethod) Consume(layers []*CostLayer, uncosted, count int) (Money, []*CostLayer) {
+	count -= min(max(uncosted, 0), count)
+
+	value, total := LayersValue(layers)
+
+	var (
+		cost  Money
+		taken int
+	)
+	for _, layer := range layers {
+		if count == 0 {
+			break
+		}
+
+		take := min(layer.Count, count)
+		if take <= 0 {
+			continue
+		}
+
+		layer.Count -= take
+		cost += layer.UnitCost.Mul(take)
+		taken += take
+		count -= take
+	}
+
+	if m == ValuationAverage && taken > 0 {
+		cost = Money(roundDiv(int64(value)*int64(taken), int64(total)))
+		return cost, spreadValue(layers, value-cost)
+	}
+
+	return cost, layers
+}
+
+// spreadValue переоценивает слои layers так, чтобы все единицы товара в них стоили
+// одинаково, а общая себестоимость слоев была равна value.
+//
+// Если value не делится на количество товара нацело, то остаток от деления записывается
+// в цену одной единицы: она выделяется из последнего слоя в новый слой с uuid.Nil
+// вместо ID, который нужно сохранить.
+func spreadValue(layers []*CostLayer, value Money) []*CostLayer {
+	_, count := LayersValue(layers)
+	if count == 0 {
+		return layers
+	}
+
+	average := value / Money(count)
+	remainder := value - average.Mul(count)
+
+	var last *CostLayer
+	for _, layer := range layers {
+		layer.UnitCost = average
+		if layer.Count > 0 {
+			last = layer
+		}
+	}
+
+	if remainder == 0 {
+		return layers
+	}
+
+	if last.Count == 1 {
+		last.UnitCost += remainder
+		return layers
+	}
+
+	last.Count--
+
+	return append(layers, &CostLayer{UnitCost: average + remainder, Count: 1, ReceivedAt: last.ReceivedAt})
+}
+
+// MarginPercent возвращает валовую маржу в процентах от выручки, округленную до сотых.
+// Если выручки нет, то возвращает 0.
+func MarginPercent(revenue, cost Money) float64 {
+	if revenue == 0 {
+		return 0
+	}
+
+	percent := float64(revenue-cost) * 100 / float64(revenue)
+	return math.Round(percent*100) / 100
+}
+
+// ProductValuation представляет оценку остатка товара на складе.
+type ProductValuation struct {
+	Product      *Product
+	ProductCount int   // Количество товара в продаже.
+	CostedCount  int   // Количество товара с известной себестоимостью.
+	Value        Money // Себестоимость товара с известной себестоимостью.
+}
+
+// AverageCost возвращает среднюю себестоимость единицы товара с известной себестоимостью.
+func (v *ProductValuation) AverageCost() Money {
+	return v.Value.Div(v.CostedCount)
+}
+
+// StockValuation представляет оценку остатков товаров на складе.
+type StockValuation struct {
+	Warehouse  *Warehouse
+	Method     ValuationMethod
+	TotalValue Money // Себестоимость всех остатков склада, а не только страницы Products.
+	Products   []*ProductValuation
+}
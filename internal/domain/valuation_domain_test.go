@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValuationMethodConsume(t *testing.T) {
+	newLayers := func() []*CostLayer {
+		return []*CostLayer{
+			{UnitCost: 1000, Count: 2},
+			{UnitCost: 1600, Count: 4},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		method     ValuationMethod
+		uncosted   int
+		count      int
+		wantCost   Money
+		wantCounts []int
+	}{
+		{name: "fifo first layer", method: ValuationFIFO, count: 2, wantCost: 2000, wantCounts: []int{0, 4}},
+		{name: "fifo spans layers", method: ValuationFIFO, count: 3, wantCost: 3600, wantCounts: []int{0, 3}},
+		{name: "fifo uncosted first", method: ValuationFIFO, uncosted: 2, count: 3, wantCost: 1000, wantCounts: []int{1, 4}},
+		{name: "fifo more than layers hold", method: ValuationFIFO, count: 10, wantCost: 8400, wantCounts: []int{0, 0}},
+		{name: "average", method: ValuationAverage, count: 3, wantCost: 4200, wantCounts: []int{0, 3}},
+		{name: "average uncosted first", method: ValuationAverage, uncosted: 1, count: 2, wantCost: 1400, wantCounts: []int{1, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, layers := tt.method.Consume(newLayers(), tt.uncosted, tt.count)
+			require.Equal(t, tt.wantCost, cost)
+
+			counts := make([]int, 0, len(layers))
+			for _, layer := range layers {
+				counts = append(counts, layer.Count)
+			}
+			require.Equal(t, tt.wantCounts, counts)
+		})
+	}
+}
+
+func TestValuationMethodConsumeAverageRounding(t *testing.T) {
+	newLayers := func() []*CostLayer {
+		return []*CostLayer{
+			{UnitCost: 1000, Count: 1},
+			{UnitCost: 1001, Count: 2},
+		}
+	}
+
+	cost, _ := ValuationAverage.Consume(newLayers(), 0, 3)
+	require.Equal(t, Money(3002), cost)
+
+	cost, _ = ValuationAverage.Consume(newLayers(), 0, 2)
+	require.Equal(t, Money(2001), cost)
+}
+
+func TestValuationMethodConsumeAverageSeveralSales(t *testing.T) {
+	layers := []*CostLayer{
+		{ID: uuid.New(), UnitCost: 1000, Count: 1},
+		{ID: uuid.New(), UnitCost: 1001, Count: 2},
+	}
+
+	first, layers := ValuationAverage.Consume(layers, 0, 1)
+	require.Equal(t, Money(1001), first)
+
+	value, count := LayersValue(layers)
+	require.Equal(t, Money(2001), value)
+	require.Equal(t, 2, count)
+
+	// остаток от округления средней цены хранится в новом слое из одной единицы.
+	require.Len(t, layers, 3)
+	require.Equal(t, uuid.Nil, layers[2].ID)
+	require.Equal(t, 1, layers[2].Count)
+
+	second, layers := ValuationAverage.Consume(layers, 0, 2)
+	require.Equal(t, Money(3002), first+second)
+
+	value, count = LayersValue(layers)
+	require.Equal(t, Money(0), value)
+	require.Equal(t, 0, count)
+}
+
+func TestMarginPercent(t *testing.T) {
+	tests := []struct {
+		name    string
+		revenue Money
+		cost    Money
+		want    float64
+	}{
+		{name: "no revenue", revenue: 0, cost: 500, want: 0},
+		{name: "positive margin", revenue: 3000, cost: 2000, want: 33.33},
+		{name: "negative margin", revenue: 1000, cost: 1500, want: -50},
+		{name: "no cost", revenue: 1000, cost: 0, want: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, MarginPercent(tt.revenue, tt.cost))
+		})
+	}
+}
@@ -1,9 +1,34 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"math"
+
+	"github.com/google/uuid"
+)
 
 // Warehouse представляет склад с его деталями.
 type Warehouse struct {
-	ID      uuid.UUID
-	Address string
+	ID       uuid.UUID
+	Address  string
+	Location *Location // Координаты склада. nil, если они не заданы.
+}
+
+// earthRadiusKm - средний радиус Земли в километрах.
+const earthRadiusKm = 6371.0
+
+// Location - географические координаты в градусах.
+type Location struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// DistanceTo возвращает расстояние по поверхности Земли до точки to в километрах.
+func (l *Location) DistanceTo(to *Location) float64 {
+	lat1, lat2 := l.Latitude*math.Pi/180, to.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (to.Longitude - l.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
 }
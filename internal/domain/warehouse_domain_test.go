@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationDistanceTo(t *testing.T) {
+	moscow := &Location{Latitude: 55.7558, Longitude: 37.6173}
+	petersburg := &Location{Latitude: 59.9343, Longitude: 30.3351}
+
+	require.Zero(t, moscow.DistanceTo(moscow))
+	require.InDelta(t, 634, moscow.DistanceTo(petersburg), 5)
+	require.InDelta(t, moscow.DistanceTo(petersburg), petersburg.DistanceTo(moscow), 1e-9)
+
+	north := &Location{Latitude: 90}
+	south := &Location{Latitude: -90}
+	require.InDelta(t, 20015, north.DistanceTo(south), 1)
+}
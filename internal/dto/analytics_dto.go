@@ -1,23 +1,100 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
 // WarehouseAnalyticsResponse представляет ответ с аналитикой по складу.
 type WarehouseAnalyticsResponse struct {
-	WarehouseID string             `json:"warehouse_id"`
-	Products    []*ProductAnalytic `json:"products"`
-	TotalSum    float64            `json:"total_sum"`
+	WarehouseID        string             `json:"warehouse_id"`
+	Products           []*ProductAnalytic `json:"products"`
+	TotalSum           domain.Money       `json:"total_sum"`
+	TotalCOGS          domain.Money       `json:"total_cogs"`
+	TotalGrossMargin   domain.Money       `json:"total_gross_margin"`
+	TotalMarginPercent float64            `json:"total_margin_percent"`
 }
 
 // ProductAnalytic представляет аналитику по продукту на складе.
+//
+// COGS - себестоимость проданного товара, GrossMargin - выручка за вычетом себестоимости.
 type ProductAnalytic struct {
-	ProductID    string  `json:"product_id"`
-	ProductName  string  `json:"product_name"`
-	ProductCount int     `json:"total_product_count"`
-	ProductPrice float64 `json:"total_product_price"`
+	ProductID     string       `json:"product_id"`
+	ProductName   string       `json:"product_name"`
+	ProductCount  int          `json:"total_product_count"`
+	ProductPrice  domain.Money `json:"total_product_price"`
+	COGS          domain.Money `json:"cogs"`
+	GrossMargin   domain.Money `json:"gross_margin"`
+	MarginPercent float64      `json:"margin_percent"`
 }
 
 // WarehouseAnalyticsAtListResponse представляет ответ с аналитикой по складам в списке.
 type WarehouseAnalyticsAtListResponse struct {
-	WarehouseID       string  `json:"warehouse_id"`
-	WarehouseAddress  string  `json:"warehouse_address"`
-	WarehouseTotalSum float64 `json:"warehouse_total_sum"`
+	WarehouseID       string       `json:"warehouse_id"`
+	WarehouseAddress  string       `json:"warehouse_address"`
+	WarehouseTotalSum domain.Money `json:"warehouse_total_sum"`
+}
+
+// AnalyticsPeriod представляет период, за который считается аналитика.
+// Если граница не задана, то период ею не ограничивается.
+type AnalyticsPeriod struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// SalesTimeSeriesFilter представляет параметры временного ряда продаж.
+type SalesTimeSeriesFilter struct {
+	WarehouseID string
+	ProductID   string
+	Bucket      string
+	AnalyticsPeriod
+}
+
+// SalesTimeSeriesResponse представляет временной ряд продаж.
+type SalesTimeSeriesResponse struct {
+	WarehouseID string                `json:"warehouse_id,omitempty"`
+	ProductID   string                `json:"product_id,omitempty"`
+	Bucket      string                `json:"bucket"`
+	Points      []*SalesPointResponse `json:"points"`
+}
+
+// SalesPointResponse представляет продажи за один период.
+type SalesPointResponse struct {
+	PeriodStart time.Time    `json:"period_start"`
+	Units       int          `json:"units"`
+	Revenue     domain.Money `json:"revenue"`
+}
+
+// TopProductsFilter представляет параметры выборки самых продаваемых продуктов.
+type TopProductsFilter struct {
+	WarehouseID string
+	OrderBy     string
+	Limit       int
+	AnalyticsPeriod
+}
+
+// TopProductResponse представляет продукт в списке самых продаваемых.
+type TopProductResponse struct {
+	ProductID   string       `json:"product_id"`
+	ProductName string       `json:"product_name"`
+	Units       int          `json:"units"`
+	Revenue     domain.Money `json:"revenue"`
+}
+
+// ProductAnalyticsResponse представляет продажи продукта на всех складах.
+type ProductAnalyticsResponse struct {
+	ProductID    string                        `json:"product_id"`
+	ProductName  string                        `json:"product_name"`
+	TotalUnits   int                           `json:"total_units"`
+	TotalRevenue domain.Money                  `json:"total_revenue"`
+	Warehouses   []*ProductAtWarehouseAnalytic `json:"warehouses"`
+}
+
+// ProductAtWarehouseAnalytic представляет продажи продукта на одном складе.
+type ProductAtWarehouseAnalytic struct {
+	WarehouseID      string       `json:"warehouse_id"`
+	WarehouseAddress string       `json:"warehouse_address"`
+	Units            int          `json:"units"`
+	Revenue          domain.Money `json:"revenue"`
 }
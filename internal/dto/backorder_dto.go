@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+// BackorderLimitRequest представляет запрос на задание лимита предзаказа товара на складе.
+//
+// BackorderLimit - сколько товара можно продать сверх остатка склада.
+// Значение 0 запрещает предзаказ товара.
+type BackorderLimitRequest struct {
+	WarehouseID    string `json:"warehouse_id"`
+	ProductID      string `json:"product_id"`
+	BackorderLimit *int   `json:"backorder_limit"`
+}
+
+// BackordersResponse представляет открытые предзаказы склада в порядке очереди на обеспечение.
+type BackordersResponse struct {
+	Page       int                  `json:"page"`
+	Limit      int                  `json:"limit"`
+	Backorders []*BackorderResponse `json:"backorders"`
+}
+
+// BackorderResponse представляет предзаказ товара.
+type BackorderResponse struct {
+	BackorderID    string    `json:"backorder_id"`
+	OrderID        string    `json:"order_id"`
+	ProductID      string    `json:"product_id"`
+	ProductName    string    `json:"product_name"`
+	BackorderCount int       `json:"backorder_count"`
+	AllocatedCount int       `json:"allocated_count"`
+	RemainingCount int       `json:"remaining_count"`
+	CreatedAt      time.Time `json:"created_at"`
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// BatchRequest представляет запрос на приемку партии товара на склад.
+//
+// Если дата поступления не указана, то используется текущее время.
+// Если срок годности не указан, то товар в партии считается непортящимся.
+type BatchRequest struct {
+	WarehouseID string        `json:"warehouse_id"`
+	ProductID   string        `json:"product_id"`
+	LotNumber   string        `json:"lot_number"`
+	Quantity    *int          `json:"quantity"`
+	ExpiresAt   *time.Time    `json:"expires_at,omitempty"`
+	ReceivedAt  *time.Time    `json:"received_at,omitempty"`
+	Serials     []string      `json:"serials,omitempty"`   // Обязательны для серийного товара, по одному на каждую единицу.
+	UnitCost    *domain.Money `json:"unit_cost,omitempty"` // Себестоимость единицы товара. Если не задана, то берется средняя себестоимость остатка.
+}
+
+// BatchFilter представляет параметры выборки партий товаров на складе.
+type BatchFilter struct {
+	WarehouseID string
+	ProductID   string
+	Pagination  *Pagination
+}
+
+// BatchesResponse представляет ответ со списком партий товаров на складе.
+type BatchesResponse struct {
+	Page    int              `json:"page"`
+	Limit   int              `json:"limit"`
+	Batches []*BatchResponse `json:"batches"`
+}
+
+// ExpiringBatchesResponse представляет отчет о партиях, срок годности которых скоро истекает.
+type ExpiringBatchesResponse struct {
+	Page    int              `json:"page"`
+	Limit   int              `json:"limit"`
+	Days    int              `json:"days"`
+	Batches []*BatchResponse `json:"batches"`
+}
+
+// BatchResponse представляет партию товара на складе.
+type BatchResponse struct {
+	BatchID     string     `json:"batch_id"`
+	WarehouseID string     `json:"warehouse_id"`
+	ProductID   string     `json:"product_id"`
+	LotNumber   string     `json:"lot_number"`
+	Quantity    int        `json:"quantity"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	ReceivedAt  time.Time  `json:"received_at"`
+	Expired     bool       `json:"expired"`
+}
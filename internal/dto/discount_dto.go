@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// DiscountRuleRequest представляет запрос на создание или изменение правила скидки.
+type DiscountRuleRequest struct {
+	WarehouseID string        `json:"warehouse_id"`
+	ProductID   string        `json:"product_id"`
+	Type        string        `json:"discount_type"`
+	Percent     *int          `json:"discount_percent,omitempty"`
+	Amount      *domain.Money `json:"discount_amount,omitempty"`
+	Priority    int           `json:"priority"`
+	Stackable   bool          `json:"stackable"`
+	ValidFrom   *time.Time    `json:"valid_from,omitempty"`
+	ValidTo     *time.Time    `json:"valid_to,omitempty"`
+}
+
+// DiscountRuleFilter представляет параметры выборки правил скидок.
+//
+// Если задан ActiveAt, то выбираются только правила, действующие в этот момент.
+type DiscountRuleFilter struct {
+	WarehouseID string
+	ProductID   string
+	ActiveAt    *time.Time
+	Pagination  *Pagination
+}
+
+// DiscountRulesResponse представляет ответ со списком правил скидок.
+type DiscountRulesResponse struct {
+	Page  int                     `json:"page"`
+	Limit int                     `json:"limit"`
+	Rules []*DiscountRuleResponse `json:"discounts"`
+}
+
+// DiscountRuleResponse представляет правило скидки.
+type DiscountRuleResponse struct {
+	DiscountID  string        `json:"discount_id"`
+	WarehouseID string        `json:"warehouse_id"`
+	ProductID   string        `json:"product_id"`
+	Type        string        `json:"discount_type"`
+	Percent     *int          `json:"discount_percent,omitempty"`
+	Amount      *domain.Money `json:"discount_amount,omitempty"`
+	Priority    int           `json:"priority"`
+	Stackable   bool          `json:"stackable"`
+	ValidFrom   *time.Time    `json:"valid_from,omitempty"`
+	ValidTo     *time.Time    `json:"valid_to,omitempty"`
+	Active      bool          `json:"active"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+
+// FulfillmentRequest представляет запрос на сборку заказа с нескольких складов.
+//
+// Склад заказа не указывается: товары распределяются по складам стратегией Strategy.
+// Координаты адреса доставки обязательны для стратегии nearest.
+type FulfillmentRequest struct {
+	PreferredWarehouseID string                  `json:"preferred_warehouse_id,omitempty"` // Склад, с которого товар берется в первую очередь.
+	Strategy             string                  `json:"strategy,omitempty"`               // fewest_shipments, cheapest или nearest.
+	Latitude             *float64                `json:"latitude,omitempty"`               // Широта адреса доставки.
+	Longitude            *float64                `json:"longitude,omitempty"`              // Долгота адреса доставки.
+	Products             []*ProductInCartRequest `json:"products"`
+}
+
+// FulfillmentResponse представляет план сборки заказа или результат покупки по нему.
+type FulfillmentResponse struct {
+	Strategy   string              `json:"strategy"`
+	Shipments  []*ShipmentResponse `json:"shipments"`
+	TotalToPay domain.Money        `json:"total_to_pay"`
+}
+
+// ShipmentResponse представляет часть заказа, собираемую с одного склада.
+//
+// DistanceKM - расстояние от склада до адреса доставки, если известны обе точки.
+type ShipmentResponse struct {
+	WarehouseID      string   `json:"warehouse_id"`
+	WarehouseAddress string   `json:"warehouse_address"`
+	DistanceKM       *float64 `json:"distance_km,omitempty"`
+	*CartResponse
+}
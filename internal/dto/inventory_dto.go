@@ -1,18 +1,39 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
 // InventoryCreateRequest представляет запрос на создание инвентаризации.
 type InventoryCreateRequest struct {
-	WarehouseID string   `json:"warehouse_id"`
-	ProductID   string   `json:"product_id"`
-	Count       *int     `json:"product_count"`
-	Price       *float64 `json:"product_price"`
+	WarehouseID string        `json:"warehouse_id"`
+	ProductID   string        `json:"product_id"`
+	Count       *int          `json:"product_count"`
+	Price       *domain.Money `json:"product_price"`
+	Serials     []string      `json:"serials,omitempty"`   // Обязательны для серийного товара, по одному на каждую единицу.
+	UnitCost    *domain.Money `json:"unit_cost,omitempty"` // Себестоимость единицы товара. Если не задана, то неизвестна.
 }
 
 // ChangeProductCountRequest представляет запрос на изменение количества продукта на складе.
 type ChangeProductCountRequest struct {
-	WarehouseID string `json:"warehouse_id"`
-	ProductID   string `json:"product_id"`
-	Count       *int   `json:"product_count"`
+	WarehouseID string        `json:"warehouse_id"`
+	ProductID   string        `json:"product_id"`
+	Count       *int          `json:"product_count"`
+	Serials     []string      `json:"serials,omitempty"`   // Обязательны для серийного товара, по одному на каждую единицу.
+	UnitCost    *domain.Money `json:"unit_cost,omitempty"` // Себестоимость единицы товара. Если не задана, то берется средняя себестоимость остатка.
+}
+
+// StockThresholdsRequest представляет запрос на задание порогов остатка товара на складе.
+//
+// Если остаток опускается до MinQuantity, то товар попадает в отчет о низких остатках
+// и отправляется оповещение. MinQuantity, равный 0, отключает оповещения.
+type StockThresholdsRequest struct {
+	WarehouseID     string `json:"warehouse_id"`
+	ProductID       string `json:"product_id"`
+	MinQuantity     *int   `json:"min_quantity"`
+	ReorderQuantity *int   `json:"reorder_quantity"`
 }
 
 // DiscountToProductRequest представляет запрос на применение скидок к продуктам на складе.
@@ -36,14 +57,17 @@ type ProductFromWarehouseResponse struct {
 	ProductParams        map[string]any `json:"product_params,omitempty"`
 	ProductBarcode       string         `json:"product_barcode"`
 	ProductCount         int            `json:"product_count"`
-	ProductPrice         float64        `json:"product_price"`
-	ProductPriceWithSale float64        `json:"product_sale"`
+	ProductPrice         domain.Money   `json:"product_price"`
+	ProductPriceWithSale domain.Money   `json:"product_sale"`
 }
 
 // CartRequest представляет запрос на корзину товаров.
 type CartRequest struct {
-	WarehouseID string                  `json:"warehouse_id"`
-	Products    []*ProductInCartRequest `json:"products"`
+	WarehouseID   string                  `json:"warehouse_id"`
+	Products      []*ProductInCartRequest `json:"products"`
+	Reserve       bool                    `json:"reserve,omitempty"`        // Удержать товары при расчете корзины.
+	ReservationID string                  `json:"reservation_id,omitempty"` // Резерв, используемый при покупке.
+	PromoCode     string                  `json:"promo_code,omitempty"`
 }
 
 // ProductInCartRequest представляет продукт в корзине с его количеством.
@@ -54,17 +78,31 @@ type ProductInCartRequest struct {
 
 // CartResponse представляет ответ на запрос корзины товаров.
 type CartResponse struct {
+	OrderID                       string                   `json:"order_id,omitempty"`
 	Products                      []*ProductInCartResponse `json:"products"`
-	TotalProductPrice             float64                  `json:"total_price"`
-	TotalProductPriceWithDiscount float64                  `json:"total_price_with_discount"`
+	TotalProductPrice             domain.Money             `json:"total_price"`
+	TotalProductPriceWithDiscount domain.Money             `json:"total_price_with_discount"`
+	DiscountSavings               domain.Money             `json:"discount_savings"`
+	PromoCode                     string                   `json:"promo_code,omitempty"`
+	PromoSavings                  domain.Money             `json:"promo_savings"`
+	TotalToPay                    domain.Money             `json:"total_to_pay"`
+	ReservationID                 string                   `json:"reservation_id,omitempty"`
+	ReservationExpiresAt          *time.Time               `json:"reservation_expires_at,omitempty"`
 }
 
 // ProductInCartResponse представляет продукт в корзине с его деталями.
+//
+// PriceWithDiscount учитывает и скидки на товар, и скидку правил ценообразования RuleDiscount.
 type ProductInCartResponse struct {
-	ProductID         string  `json:"product_id"`
-	Count             int     `json:"product_count"`
-	FullPrice         float64 `json:"product_price"`
-	PriceWithDiscount float64 `json:"product_price_with_discount"`
+	ProductID         string                        `json:"product_id"`
+	Count             int                           `json:"product_count"`
+	FullPrice         domain.Money                  `json:"product_price"`
+	PriceWithDiscount domain.Money                  `json:"product_price_with_discount"`
+	AppliedDiscounts  []string                      `json:"applied_discounts,omitempty"`
+	RuleDiscount      domain.Money                  `json:"rule_discount"`
+	PricingRules      []*AppliedPricingRuleResponse `json:"pricing_rules,omitempty"`
+	Serials           []string                      `json:"serials,omitempty"`           // Серийные номера проданных единиц серийного товара.
+	BackorderedCount  int                           `json:"backordered_count,omitempty"` // Количество товара, оформленного по предзаказу.
 }
 
 // Pagination представляет параметры пагинации для запросов.
@@ -74,17 +112,55 @@ type Pagination struct {
 	Limit  int
 }
 
+// StockFilter представляет параметры списка товаров на складе.
+//
+// Если задан Cursor, то страница начинается после товара, на который он указывает,
+// а номер страницы из Pagination не учитывается.
+type StockFilter struct {
+	WarehouseID string
+	Sort        domain.StockSort
+	Desc        bool
+	InStock     bool          // Только товары, которые есть на складе.
+	Discounted  bool          // Только товары со скидкой.
+	MinPrice    *domain.Money // Минимальная цена со скидкой.
+	MaxPrice    *domain.Money // Максимальная цена со скидкой.
+	Cursor      *domain.StockCursor
+	Pagination  *Pagination
+}
+
 // ProductsResponse представляет ответ на запрос списка продуктов.
+//
+// Total - количество всех товаров, подходящих под фильтры. NextCursor передается
+// в параметре cursor для получения следующей страницы и пуст на последней странице.
 type ProductsResponse struct {
-	Page     int              `json:"page"`
-	Limit    int              `json:"limit"`
-	Products []*ProductAtList `json:"products"`
+	Page       int              `json:"page"`
+	Limit      int              `json:"limit"`
+	Total      int              `json:"total"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+	Products   []*ProductAtList `json:"products"`
 }
 
 // ProductAtList представляет продукт в списке с его деталями.
 type ProductAtList struct {
-	ProductID                string  `json:"product_id"`
-	ProductName              string  `json:"product_name"`
-	ProductPrice             float64 `json:"product_price"`
-	ProductPriceWithDiscount float64 `json:"product_discount_price"`
+	ProductID                string       `json:"product_id"`
+	ProductName              string       `json:"product_name"`
+	ProductCount             int          `json:"product_count"`
+	ProductPrice             domain.Money `json:"product_price"`
+	ProductPriceWithDiscount domain.Money `json:"product_discount_price"`
+}
+
+// LowStockResponse представляет отчет о товарах склада, которые нужно дозаказать.
+type LowStockResponse struct {
+	Page     int                        `json:"page"`
+	Limit    int                        `json:"limit"`
+	Products []*LowStockProductResponse `json:"products"`
+}
+
+// LowStockProductResponse представляет товар с низким остатком.
+type LowStockProductResponse struct {
+	ProductID       string `json:"product_id"`
+	ProductName     string `json:"product_name"`
+	ProductCount    int    `json:"product_count"`
+	MinQuantity     int    `json:"min_quantity"`
+	ReorderQuantity int    `json:"reorder_quantity"`
 }
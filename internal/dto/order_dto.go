@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// OrderFilter представляет параметры выборки заказов.
+type OrderFilter struct {
+	WarehouseID string
+	From        *time.Time
+	To          *time.Time
+	Pagination  *Pagination
+}
+
+// OrderStatusRequest представляет запрос на изменение статуса заказа.
+type OrderStatusRequest struct {
+	Status string `json:"status"`
+}
+
+// OrdersResponse представляет ответ со списком заказов.
+type OrdersResponse struct {
+	Page   int              `json:"page"`
+	Limit  int              `json:"limit"`
+	Orders []*OrderResponse `json:"orders"`
+}
+
+// OrderResponse представляет заказ с его строками.
+type OrderResponse struct {
+	OrderID                string               `json:"order_id"`
+	WarehouseID            string               `json:"warehouse_id"`
+	Status                 string               `json:"status"`
+	Lines                  []*OrderLineResponse `json:"lines"`
+	TotalPrice             domain.Money         `json:"total_price"`
+	TotalPriceWithDiscount domain.Money         `json:"total_price_with_discount"`
+	PromoCode              string               `json:"promo_code,omitempty"`
+	PromoDiscount          domain.Money         `json:"promo_discount"`
+	TotalToPay             domain.Money         `json:"total_to_pay"`
+	CreatedAt              time.Time            `json:"created_at"`
+	UpdatedAt              time.Time            `json:"updated_at"`
+}
+
+// OrderLineResponse представляет строку заказа.
+type OrderLineResponse struct {
+	ProductID             string       `json:"product_id"`
+	Count                 int          `json:"product_count"`
+	UnitPrice             domain.Money `json:"unit_price"`
+	UnitPriceWithDiscount domain.Money `json:"unit_price_with_discount"`
+	Discount              int          `json:"discount"`
+	FullPrice             domain.Money `json:"product_price"`
+	RuleDiscount          domain.Money `json:"rule_discount"`
+	PriceWithDiscount     domain.Money `json:"product_price_with_discount"`
+	ReturnedCount         int          `json:"returned_count"`
+	BackorderedCount      int          `json:"backordered_count,omitempty"` // Количество товара, которое еще ожидает поступления по предзаказу.
+}
+
+// OrderCancelRequest представляет запрос на отмену заказа.
+type OrderCancelRequest struct {
+	Reason     string `json:"reason"`
+	Quarantine bool   `json:"quarantine"`
+}
+
+// OrderReturnRequest представляет запрос на возврат части товаров заказа.
+type OrderReturnRequest struct {
+	Reason     string                       `json:"reason"`
+	Quarantine bool                         `json:"quarantine"`
+	Products   []*OrderReturnProductRequest `json:"products"`
+}
+
+// OrderReturnProductRequest представляет возвращаемый товар.
+type OrderReturnProductRequest struct {
+	ProductID string   `json:"product_id"`
+	Count     *int     `json:"product_count"`
+	Serials   []string `json:"serials,omitempty"` // Обязательны для серийного товара, по одному на каждую единицу.
+}
+
+// OrderReturnResponse представляет выполненный возврат и состояние заказа после него.
+type OrderReturnResponse struct {
+	ReturnID       string                        `json:"return_id"`
+	Reason         string                        `json:"reason"`
+	Quarantine     bool                          `json:"quarantine"`
+	Products       []*OrderReturnProductResponse `json:"products"`
+	PromoDeduction domain.Money                  `json:"promo_deduction"` // Часть скидки по промокоду, которая не возвращается.
+	RefundAmount   domain.Money                  `json:"refund_amount"`
+	CreatedAt      time.Time                     `json:"created_at"`
+	Order          *OrderResponse                `json:"order"`
+}
+
+// OrderReturnProductResponse представляет возвращенный товар.
+type OrderReturnProductResponse struct {
+	ProductID string       `json:"product_id"`
+	Count     int          `json:"product_count"`
+	Refund    domain.Money `json:"refund"`
+	Serials   []string     `json:"serials,omitempty"`
+}
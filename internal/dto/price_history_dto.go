@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// ChangeProductPriceRequest представляет запрос на изменение цены товара на складе.
+//
+// Если скидка не указана, то она не меняется.
+type ChangeProductPriceRequest struct {
+	WarehouseID string        `json:"warehouse_id"`
+	ProductID   string        `json:"product_id"`
+	Price       *domain.Money `json:"product_price"`
+	Sale        *int          `json:"discount,omitempty"`
+	ChangedBy   string        `json:"changed_by,omitempty"`
+}
+
+// PriceHistoryFilter представляет параметры выборки из истории цен.
+type PriceHistoryFilter struct {
+	WarehouseID string
+	ProductID   string
+	From        *time.Time
+	To          *time.Time
+	Pagination  *Pagination
+}
+
+// PriceHistoryResponse представляет ответ со списком изменений цен на складе.
+type PriceHistoryResponse struct {
+	Page    int                    `json:"page"`
+	Limit   int                    `json:"limit"`
+	Changes []*PriceChangeResponse `json:"changes"`
+}
+
+// PriceChangeResponse представляет одно изменение цены товара.
+//
+// Если цена и скидка не изменились, то запись в историю не добавляется и HistoryID пуст.
+type PriceChangeResponse struct {
+	HistoryID string       `json:"history_id,omitempty"`
+	ProductID string       `json:"product_id"`
+	OldPrice  domain.Money `json:"old_price"`
+	NewPrice  domain.Money `json:"new_price"`
+	OldSale   int          `json:"old_discount"`
+	NewSale   int          `json:"new_discount"`
+	ChangedBy string       `json:"changed_by,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
+	ChangedAt *time.Time   `json:"changed_at,omitempty"`
+}
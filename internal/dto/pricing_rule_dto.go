@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// PricingRuleRequest представляет запрос на создание или изменение правила ценообразования.
+//
+// Если склад не указан, то правило действует на всех складах. Набор условий зависит от вида правила:
+//   - tier: product_id, min_count, unit_price;
+//   - n_for_m: product_id, buy, pay;
+//   - bundle: product_ids, percent.
+type PricingRuleRequest struct {
+	WarehouseID string        `json:"warehouse_id,omitempty"`
+	Name        string        `json:"name"`
+	Type        string        `json:"rule_type"`
+	Priority    int           `json:"priority"`
+	Active      *bool         `json:"active,omitempty"`
+	ProductID   string        `json:"product_id,omitempty"`
+	ProductIDs  []string      `json:"product_ids,omitempty"`
+	MinCount    *int          `json:"min_count,omitempty"`
+	UnitPrice   *domain.Money `json:"unit_price,omitempty"`
+	Buy         *int          `json:"buy,omitempty"`
+	Pay         *int          `json:"pay,omitempty"`
+	Percent     *int          `json:"percent,omitempty"`
+}
+
+// PricingRuleFilter представляет параметры выборки правил ценообразования.
+//
+// Если задан склад, то выбираются правила этого склада и правила, действующие везде.
+type PricingRuleFilter struct {
+	WarehouseID string
+	Pagination  *Pagination
+}
+
+// PricingRulesResponse представляет ответ со списком правил ценообразования.
+type PricingRulesResponse struct {
+	Page         int                    `json:"page"`
+	Limit        int                    `json:"limit"`
+	PricingRules []*PricingRuleResponse `json:"pricing_rules"`
+}
+
+// PricingRuleResponse представляет правило ценообразования.
+type PricingRuleResponse struct {
+	RuleID      string        `json:"rule_id"`
+	WarehouseID string        `json:"warehouse_id,omitempty"`
+	Name        string        `json:"name"`
+	Type        string        `json:"rule_type"`
+	Priority    int           `json:"priority"`
+	Active      bool          `json:"active"`
+	ProductID   string        `json:"product_id,omitempty"`
+	ProductIDs  []string      `json:"product_ids,omitempty"`
+	MinCount    *int          `json:"min_count,omitempty"`
+	UnitPrice   *domain.Money `json:"unit_price,omitempty"`
+	Buy         *int          `json:"buy,omitempty"`
+	Pay         *int          `json:"pay,omitempty"`
+	Percent     *int          `json:"percent,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+// AppliedPricingRuleResponse представляет правило ценообразования, сработавшее для строки корзины.
+type AppliedPricingRuleResponse struct {
+	RuleID   string       `json:"rule_id"`
+	Name     string       `json:"name"`
+	Type     string       `json:"rule_type"`
+	Discount domain.Money `json:"discount"`
+}
@@ -1,6 +1,30 @@
 package dto
 
-import "mime/multipart"
+import (
+	"mime/multipart"
+	"time"
+)
+
+// ProductFilter представляет параметры поиска продуктов в каталоге.
+type ProductFilter struct {
+	Name       string            // Подстрока названия продукта без учета регистра.
+	Search     string            // Полнотекстовый поиск по названию и описанию продукта.
+	Params     map[string]string // Значения параметров продукта по их ключам.
+	Archived   bool              // Вместо действующих продуктов возвращаются архивные.
+	SortBy     string            // Поле сортировки: name или weight.
+	Desc       bool              // Сортировка по убыванию.
+	Pagination *Pagination
+}
+
+// ProductListResponse представляет страницу каталога продуктов.
+//
+// Total - количество всех продуктов, подходящих под фильтры, а не только страницы Products.
+type ProductListResponse struct {
+	Page     int                      `json:"page"`
+	Limit    int                      `json:"limit"`
+	Total    int                      `json:"total"`
+	Products []*ProductAtListResponse `json:"products"`
+}
 
 // ProductAtListResponse представляет продукт в списке с его деталями.
 type ProductAtListResponse struct {
@@ -10,6 +34,8 @@ type ProductAtListResponse struct {
 	Description string         `json:"desc" example:"This is a product description."`
 	Params      map[string]any `json:"params,omitempty" example:"{\"color\": \"red\", \"size\": \"M\"}"`
 	Barcode     string         `json:"barcode_url" example:"http://localhost:8080/static/photo.png"` // Ссылка на доступ к штрихкоду.
+	Serialized  bool           `json:"serialized"`
+	ArchivedAt  *time.Time     `json:"archived_at,omitempty"`
 }
 
 // ProductRequest представляет запрос на создание или обновление продукта.
@@ -18,7 +44,8 @@ type ProductRequest struct {
 	Weight      *float64       `json:"weight"`
 	Description string         `json:"desc"`
 	Params      map[string]any `json:"params"`
-	Barcode     *Photo         `json:"barcode"` // Штрихкод в байтах
+	Barcode     *Photo         `json:"barcode"`    // Штрихкод в байтах
+	Serialized  bool           `json:"serialized"` // Учитывать каждую единицу по серийному номеру. Задается только при создании.
 }
 
 // Photo представляет файл изображения штрихкода.
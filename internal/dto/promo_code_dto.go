@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// PromoCodeRequest представляет запрос на создание промокода.
+//
+// Если склад не указан, то промокод действует на всех складах.
+type PromoCodeRequest struct {
+	Code        string        `json:"promo_code"`
+	Type        string        `json:"discount_type"`
+	Percent     *int          `json:"discount_percent,omitempty"`
+	Amount      *domain.Money `json:"discount_amount,omitempty"`
+	MinTotal    *domain.Money `json:"min_total,omitempty"`
+	WarehouseID string        `json:"warehouse_id,omitempty"`
+	UsageLimit  *int          `json:"usage_limit,omitempty"`
+	ExpiresAt   *time.Time    `json:"expires_at,omitempty"`
+}
+
+// PromoCodeFilter представляет параметры выборки промокодов.
+//
+// Если задан склад, то выбираются промокоды этого склада и промокоды, действующие везде.
+type PromoCodeFilter struct {
+	WarehouseID string
+	Pagination  *Pagination
+}
+
+// PromoCodesResponse представляет ответ со списком промокодов.
+type PromoCodesResponse struct {
+	Page       int                  `json:"page"`
+	Limit      int                  `json:"limit"`
+	PromoCodes []*PromoCodeResponse `json:"promo_codes"`
+}
+
+// PromoCodeResponse представляет промокод.
+type PromoCodeResponse struct {
+	PromoCodeID string        `json:"promo_code_id"`
+	Code        string        `json:"promo_code"`
+	Type        string        `json:"discount_type"`
+	Percent     *int          `json:"discount_percent,omitempty"`
+	Amount      *domain.Money `json:"discount_amount,omitempty"`
+	MinTotal    domain.Money  `json:"min_total"`
+	WarehouseID string        `json:"warehouse_id,omitempty"`
+	UsageLimit  *int          `json:"usage_limit,omitempty"`
+	UsedCount   int           `json:"used_count"`
+	ExpiresAt   *time.Time    `json:"expires_at,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
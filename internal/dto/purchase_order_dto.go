@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// PurchaseOrderRequest представляет запрос на создание заказа поставщику.
+type PurchaseOrderRequest struct {
+	SupplierID  string                      `json:"supplier_id"`
+	WarehouseID string                      `json:"warehouse_id"`
+	Lines       []*PurchaseOrderLineRequest `json:"lines"`
+}
+
+// PurchaseOrderLineRequest представляет строку в запросе на создание заказа поставщику.
+type PurchaseOrderLineRequest struct {
+	ProductID  string        `json:"product_id"`
+	Count      *int          `json:"product_count"`
+	UnitCost   *domain.Money `json:"unit_cost"`
+	ExpectedAt *time.Time    `json:"expected_at,omitempty"`
+}
+
+// PurchaseOrderReceiptRequest представляет запрос на приемку товаров по заказу поставщику.
+type PurchaseOrderReceiptRequest struct {
+	Lines []*PurchaseOrderReceiptLineRequest `json:"lines"`
+}
+
+// PurchaseOrderReceiptLineRequest представляет принимаемый товар.
+//
+// Если указан номер партии, то товар принимается партией. Если срок годности
+// не указан, то товар в партии считается непортящимся.
+type PurchaseOrderReceiptLineRequest struct {
+	ProductID string     `json:"product_id"`
+	Count     *int       `json:"product_count"`
+	LotNumber string     `json:"lot_number,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Serials   []string   `json:"serials,omitempty"` // Обязательны для серийного товара, по одному на каждую единицу.
+}
+
+// PurchaseOrderFilter представляет параметры выборки заказов поставщикам.
+type PurchaseOrderFilter struct {
+	SupplierID  string
+	WarehouseID string
+	Status      string
+	Pagination  *Pagination
+}
+
+// PurchaseOrdersResponse представляет ответ со списком заказов поставщикам.
+type PurchaseOrdersResponse struct {
+	Page           int                      `json:"page"`
+	Limit          int                      `json:"limit"`
+	PurchaseOrders []*PurchaseOrderResponse `json:"purchase_orders"`
+}
+
+// PurchaseOrderResponse представляет заказ поставщику с его строками.
+type PurchaseOrderResponse struct {
+	PurchaseOrderID string                       `json:"purchase_order_id"`
+	SupplierID      string                       `json:"supplier_id"`
+	WarehouseID     string                       `json:"warehouse_id"`
+	Status          string                       `json:"status"`
+	Lines           []*PurchaseOrderLineResponse `json:"lines"`
+	TotalCost       domain.Money                 `json:"total_cost"`
+	CreatedAt       time.Time                    `json:"created_at"`
+	UpdatedAt       time.Time                    `json:"updated_at"`
+}
+
+// PurchaseOrderLineResponse представляет строку заказа поставщику.
+type PurchaseOrderLineResponse struct {
+	ProductID     string       `json:"product_id"`
+	OrderedCount  int          `json:"ordered_count"`
+	ReceivedCount int          `json:"received_count"`
+	UnitCost      domain.Money `json:"unit_cost"`
+	ExpectedAt    *time.Time   `json:"expected_at,omitempty"`
+}
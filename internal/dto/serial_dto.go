@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+// SerialLookupResponse представляет результат поиска по серийному номеру.
+//
+// Номер уникален в пределах товара, поэтому единиц с одним номером может быть несколько.
+type SerialLookupResponse struct {
+	SerialNumber string            `json:"serial_number"`
+	Units        []*SerialResponse `json:"units"`
+}
+
+// SerialResponse представляет единицу серийного товара и ее текущее местоположение.
+type SerialResponse struct {
+	ProductID   string    `json:"product_id"`
+	ProductName string    `json:"product_name"`
+	Status      string    `json:"status"`
+	WarehouseID string    `json:"warehouse_id"`          // Склад, на котором находится единица. Для проданной - склад продажи, для единицы в пути - склад-получатель.
+	OrderID     string    `json:"order_id,omitempty"`    // Заказ, в котором продана единица.
+	TransferID  string    `json:"transfer_id,omitempty"` // Последнее перемещение единицы.
+	ReceivedAt  time.Time `json:"received_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
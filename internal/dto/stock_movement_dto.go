@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// StockMovementFilter представляет параметры выборки из журнала движения товаров.
+type StockMovementFilter struct {
+	WarehouseID string
+	ProductID   string
+	From        *time.Time
+	To          *time.Time
+	Pagination  *Pagination
+}
+
+// StockMovementsResponse представляет ответ со списком движений товаров на складе.
+type StockMovementsResponse struct {
+	Page      int                      `json:"page"`
+	Limit     int                      `json:"limit"`
+	Movements []*StockMovementResponse `json:"movements"`
+}
+
+// StockMovementResponse представляет одну запись журнала движения товара.
+type StockMovementResponse struct {
+	MovementID      string    `json:"movement_id"`
+	ProductID       string    `json:"product_id"`
+	Delta           int       `json:"delta"`
+	Reason          string    `json:"reason"`
+	RequestID       string    `json:"request_id,omitempty"`
+	StocktakeID     string    `json:"stocktake_id,omitempty"`      // Пересчет, по результатам которого сделано исправление.
+	PurchaseOrderID string    `json:"purchase_order_id,omitempty"` // Заказ поставщику, по которому принят товар.
+	CreatedAt       time.Time `json:"created_at"`
+}
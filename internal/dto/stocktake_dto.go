@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// StocktakeRequest представляет запрос на открытие пересчета товаров на складе.
+//
+// Если товары не указаны, то пересчитываются все товары склада.
+type StocktakeRequest struct {
+	WarehouseID string   `json:"warehouse_id"`
+	ProductIDs  []string `json:"product_ids,omitempty"`
+}
+
+// StocktakeCountsRequest представляет запрос на запись посчитанного количества товаров.
+type StocktakeCountsRequest struct {
+	Counts []*StocktakeCountRequest `json:"counts"`
+}
+
+// StocktakeCountRequest представляет посчитанное количество товара.
+type StocktakeCountRequest struct {
+	ProductID    string `json:"product_id"`
+	CountedCount *int   `json:"counted_count"`
+}
+
+// StocktakePostRequest представляет запрос на проведение расхождений пересчета.
+//
+// Если товары не указаны, то проводятся все посчитанные товары.
+type StocktakePostRequest struct {
+	ProductIDs []string `json:"product_ids,omitempty"`
+}
+
+// StocktakeResponse представляет пересчет товаров на складе.
+type StocktakeResponse struct {
+	StocktakeID string                   `json:"stocktake_id"`
+	WarehouseID string                   `json:"warehouse_id"`
+	Status      string                   `json:"status"`
+	Lines       []*StocktakeLineResponse `json:"lines"`
+	CreatedAt   time.Time                `json:"created_at"`
+	ClosedAt    *time.Time               `json:"closed_at,omitempty"`
+}
+
+// StocktakeLineResponse представляет товар в пересчете.
+type StocktakeLineResponse struct {
+	ProductID     string     `json:"product_id"`
+	ProductName   string     `json:"product_name"`
+	ExpectedCount int        `json:"expected_count"` // Остаток на момент открытия пересчета.
+	CountedCount  *int       `json:"counted_count,omitempty"`
+	CountedAt     *time.Time `json:"counted_at,omitempty"`
+	PostedDelta   *int       `json:"posted_delta,omitempty"` // Проведенное исправление остатка.
+}
+
+// StocktakeVarianceResponse представляет отчет о расхождениях пересчета с текущими остатками.
+type StocktakeVarianceResponse struct {
+	StocktakeID    string                           `json:"stocktake_id"`
+	WarehouseID    string                           `json:"warehouse_id"`
+	Status         string                           `json:"status"`
+	CountedLines   int                              `json:"counted_lines"`
+	UncountedLines int                              `json:"uncounted_lines"`
+	Surplus        int                              `json:"surplus"`        // Сумма излишков в единицах товара.
+	Shortage       int                              `json:"shortage"`       // Сумма недостач в единицах товара.
+	VarianceValue  domain.Money                     `json:"variance_value"` // Стоимость расхождений по текущим ценам.
+	Lines          []*StocktakeVarianceLineResponse `json:"lines"`
+}
+
+// StocktakeVarianceLineResponse представляет расхождение по товару.
+//
+// Для непосчитанного товара CountedCount пуст, а расхождение равно 0.
+type StocktakeVarianceLineResponse struct {
+	ProductID     string       `json:"product_id"`
+	ProductName   string       `json:"product_name"`
+	SystemCount   int          `json:"system_count"` // Текущий остаток товара на складе.
+	CountedCount  *int         `json:"counted_count,omitempty"`
+	Variance      int          `json:"variance"`
+	VarianceValue domain.Money `json:"variance_value"`
+}
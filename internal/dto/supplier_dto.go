@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+// SupplierRequest представляет запрос на создание поставщика.
+type SupplierRequest struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+// SupplierResponse представляет поставщика.
+type SupplierResponse struct {
+	SupplierID string    `json:"supplier_id"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email,omitempty"`
+	Phone      string    `json:"phone,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
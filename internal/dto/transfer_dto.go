@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+// TransferRequest представляет запрос на перемещение товаров между складами.
+type TransferRequest struct {
+	SourceWarehouseID      string                    `json:"source_warehouse_id"`
+	DestinationWarehouseID string                    `json:"destination_warehouse_id"`
+	Products               []*TransferProductRequest `json:"products"`
+	InTransit              bool                      `json:"in_transit"` // Если true, товар только отгружается и ждет приемки.
+}
+
+// TransferProductRequest представляет продукт в запросе на перемещение.
+type TransferProductRequest struct {
+	ProductID string `json:"product_id"`
+	Count     *int   `json:"product_count"`
+}
+
+// TransferResponse представляет информацию о перемещении товаров.
+type TransferResponse struct {
+	TransferID             string                     `json:"transfer_id"`
+	SourceWarehouseID      string                     `json:"source_warehouse_id"`
+	DestinationWarehouseID string                     `json:"destination_warehouse_id"`
+	Status                 string                     `json:"status"`
+	Products               []*TransferProductResponse `json:"products"`
+	CreatedAt              time.Time                  `json:"created_at"`
+	ReceivedAt             *time.Time                 `json:"received_at,omitempty"`
+}
+
+// TransferProductResponse представляет продукт в перемещении.
+type TransferProductResponse struct {
+	ProductID string `json:"product_id"`
+	Count     int    `json:"product_count"`
+}
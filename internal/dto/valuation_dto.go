@@ -0,0 +1,27 @@
+package dto
+
+import "github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+
+// StockValuationResponse представляет оценку себестоимости остатков товаров на складе.
+//
+// TotalValue считается по всем товарам склада, а не только по странице Products.
+type StockValuationResponse struct {
+	WarehouseID string                      `json:"warehouse_id"`
+	Method      string                      `json:"valuation_method"`
+	Page        int                         `json:"page"`
+	Limit       int                         `json:"limit"`
+	TotalValue  domain.Money                `json:"total_value"`
+	Products    []*ProductValuationResponse `json:"products"`
+}
+
+// ProductValuationResponse представляет оценку себестоимости остатка товара.
+//
+// UncostedCount - количество товара без известной себестоимости, в StockValue оно не входит.
+type ProductValuationResponse struct {
+	ProductID     string       `json:"product_id"`
+	ProductName   string       `json:"product_name"`
+	ProductCount  int          `json:"product_count"`
+	UncostedCount int          `json:"uncosted_count"`
+	AverageCost   domain.Money `json:"average_cost"`
+	StockValue    domain.Money `json:"stock_value"`
+}
@@ -2,11 +2,15 @@ package dto
 
 // WarehouseRequest представляет запрос на создание или обновление склада.
 type WarehouseRequest struct {
-	Address string `json:"address"`
+	Address   string   `json:"address"`
+	Latitude  *float64 `json:"latitude,omitempty"`  // Широта склада. Задается вместе с долготой.
+	Longitude *float64 `json:"longitude,omitempty"` // Долгота склада. Задается вместе с широтой.
 }
 
 // WarehouseAtListResponse представляет склад в списке с его деталями.
 type WarehouseAtListResponse struct {
-	ID      string `json:"id"`
-	Address string `json:"address"`
+	ID        string   `json:"id"`
+	Address   string   `json:"address"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
 }
@@ -0,0 +1,8 @@
+package errors
+
+import "errors"
+
+var (
+	ErrBackorderSerialized = errors.New("serialized product cannot be backordered")
+	ErrOrderBackordered    = errors.New("order has backordered products that are not in stock yet")
+)
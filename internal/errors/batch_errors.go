@@ -0,0 +1,7 @@
+package errors
+
+import "errors"
+
+var (
+	ErrBatchAlreadyExists = errors.New("batch with this lot number already exists")
+)
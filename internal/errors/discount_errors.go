@@ -0,0 +1,7 @@
+package errors
+
+import "errors"
+
+var (
+	ErrDiscountRuleNotFound = errors.New("discount not found")
+)
@@ -0,0 +1,11 @@
+package errors
+
+import "errors"
+
+var (
+	ErrOrderNotFound          = errors.New("order not found")
+	ErrInvalidOrderTransition = errors.New("order cannot be moved to this status")
+	ErrOrderNotReturnable     = errors.New("products can be returned only from paid or shipped orders")
+	ErrProductNotInOrder      = errors.New("there are not some products in order")
+	ErrReturnExceedsOrder     = errors.New("return count exceeds count of products left in order")
+)
@@ -0,0 +1,7 @@
+package errors
+
+import "errors"
+
+var (
+	ErrPricingRuleNotFound = errors.New("pricing rule not found")
+)
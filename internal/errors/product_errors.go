@@ -5,4 +5,8 @@ import "errors"
 var (
 	ErrProductAlreadyExists = errors.New("product with this name already exists")
 	ErrProductNotFound      = errors.New("product not found")
+	ErrProductArchived      = errors.New("product is archived")
+	ErrProductNotArchived   = errors.New("product is not archived")
+	ErrProductHasStock      = errors.New("product is still in stock at some warehouses")
+	ErrProductInUse         = errors.New("product is referenced by orders or history, archive it instead")
 )
@@ -0,0 +1,12 @@
+package errors
+
+import "errors"
+
+var (
+	ErrPromoCodeNotFound      = errors.New("promo code not found")
+	ErrPromoCodeAlreadyExists = errors.New("promo code already exists")
+	ErrPromoCodeExpired       = errors.New("promo code expired")
+	ErrPromoCodeExhausted     = errors.New("promo code usage limit reached")
+	ErrPromoCodeNotApplicable = errors.New("promo code cannot be used at this warehouse")
+	ErrPromoCodeMinTotal      = errors.New("cart total is less than promo code minimum")
+)
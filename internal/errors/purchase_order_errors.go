@@ -0,0 +1,11 @@
+package errors
+
+import "errors"
+
+var (
+	ErrSupplierAlreadyExists     = errors.New("supplier already exists")
+	ErrPurchaseOrderNotFound     = errors.New("purchase order not found")
+	ErrPurchaseOrderNotOpen      = errors.New("purchase order is not open")
+	ErrProductNotInPurchaseOrder = errors.New("product is not in purchase order")
+	ErrPurchaseOrderOverReceipt  = errors.New("received count exceeds remaining count of purchase order")
+)
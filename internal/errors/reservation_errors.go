@@ -0,0 +1,8 @@
+package errors
+
+import "errors"
+
+var (
+	ErrReservationNotFound = errors.New("reservation not found or expired")
+	ErrReservationMismatch = errors.New("cart is not covered by reservation")
+)
@@ -0,0 +1,12 @@
+package errors
+
+import "errors"
+
+var (
+	ErrSerialsRequired      = errors.New("serial numbers must be given for each unit of serialized product")
+	ErrProductNotSerialized = errors.New("product is not serialized")
+	ErrSerialAlreadyExists  = errors.New("serial number already exists")
+	ErrSerialNotFound       = errors.New("serial number not found")
+	ErrSerialNotInOrder     = errors.New("serial number was not sold in this order")
+	ErrNotEnoughSerials     = errors.New("there are not enough serial numbers at warehouse")
+)
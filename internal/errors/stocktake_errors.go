@@ -0,0 +1,12 @@
+package errors
+
+import "errors"
+
+var (
+	ErrStocktakeNotFound       = errors.New("stocktake not found")
+	ErrStocktakeNotOpen        = errors.New("stocktake is not open")
+	ErrStocktakeEmpty          = errors.New("there are no products to count at warehouse")
+	ErrProductNotInStocktake   = errors.New("product is not in stocktake")
+	ErrStocktakeLineNotCounted = errors.New("product is not counted yet")
+	ErrStocktakeSerialized     = errors.New("stock of serialized product cannot be corrected by stocktake")
+)
@@ -0,0 +1,8 @@
+package errors
+
+import "errors"
+
+var (
+	ErrTransferNotFound     = errors.New("transfer not found")
+	ErrTransferNotInTransit = errors.New("transfer is not in transit")
+)
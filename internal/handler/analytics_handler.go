@@ -2,15 +2,19 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 
-	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
 	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
 	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
 	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
@@ -18,9 +22,11 @@ import (
 //
 //go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
 type AnalyticsService interface {
-	AddProductSell(invs []*domain.Inventory)
-	GetWarehouseAnalytics(ctx context.Context, warehouseID string) (*dto.WarehouseAnalyticsResponse, error)
-	GetTopWarehouses(ctx context.Context, limit int) ([]*dto.WarehouseAnalyticsAtListResponse, error)
+	GetWarehouseAnalytics(ctx context.Context, warehouseID string, period *dto.AnalyticsPeriod) (*dto.WarehouseAnalyticsResponse, error)
+	GetTopWarehouses(ctx context.Context, limit int, period *dto.AnalyticsPeriod) ([]*dto.WarehouseAnalyticsAtListResponse, error)
+	GetSalesTimeSeries(ctx context.Context, filter *dto.SalesTimeSeriesFilter) (*dto.SalesTimeSeriesResponse, error)
+	GetTopProducts(ctx context.Context, filter *dto.TopProductsFilter) ([]*dto.TopProductResponse, error)
+	GetProductAnalytics(ctx context.Context, productID uuid.UUID, period *dto.AnalyticsPeriod) (*dto.ProductAnalyticsResponse, error)
 }
 
 // AnalyticsHandler обрабатывает запросы, связанные с аналитикой складов.
@@ -54,7 +60,13 @@ func (h *AnalyticsHandler) GetWarehouseAnalytics(w http.ResponseWriter, r *http.
 		return
 	}
 
-	response, err := h.service.GetWarehouseAnalytics(r.Context(), warehouseID)
+	period, err := parseAnalyticsPeriod(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.service.GetWarehouseAnalytics(r.Context(), warehouseID, period)
 	if err != nil {
 		log.Error("error from service module", zap.Error(err))
 		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting warehouse analytics")
@@ -83,7 +95,13 @@ func (h *AnalyticsHandler) GetTopWarehouses(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	response, err := h.service.GetTopWarehouses(r.Context(), limit)
+	period, err := parseAnalyticsPeriod(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.service.GetTopWarehouses(r.Context(), limit, period)
 	if err != nil {
 		log.Error("error from service module", zap.Error(err))
 		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting top warehouses")
@@ -102,3 +120,209 @@ func parseLimitParams(r *http.Request) (int, error) {
 
 	return strconv.Atoi(limitStr)
 }
+
+// parseAnalyticsPeriod извлекает период аналитики из параметров from и to запроса.
+//
+// Если заданы обе границы и from позже to, то возвращает ошибку.
+func parseAnalyticsPeriod(r *http.Request) (*dto.AnalyticsPeriod, error) {
+	from, err := parseTimeQuery(r, "from")
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := parseTimeQuery(r, "to")
+	if err != nil {
+		return nil, err
+	}
+
+	if from != nil && to != nil && from.After(*to) {
+		return nil, fmt.Errorf("from must not be after to")
+	}
+
+	return &dto.AnalyticsPeriod{
+		From: from,
+		To:   to,
+	}, nil
+}
+
+// salesBuckets - допустимые размеры периода временного ряда продаж.
+var salesBuckets = []string{"day", "week", "month"}
+
+// GetSalesTimeSeries обрабатывает запросы на получение временного ряда продаж склада или продукта.
+func (h *AnalyticsHandler) GetSalesTimeSeries(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.AnalyticsHandler.GetSalesTimeSeries"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	filter, err := parseSalesTimeSeriesFilter(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.service.GetSalesTimeSeries(r.Context(), filter)
+	if err != nil {
+		log.Error("error from service module", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting sales time series")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parseSalesTimeSeriesFilter извлекает параметры временного ряда продаж из параметров запроса.
+//
+// Должен быть указан склад, продукт или оба сразу. Если bucket не указан, то продажи группируются по дням.
+func parseSalesTimeSeriesFilter(r *http.Request) (*dto.SalesTimeSeriesFilter, error) {
+	query := r.URL.Query()
+
+	filter := &dto.SalesTimeSeriesFilter{
+		WarehouseID: query.Get("warehouse_id"),
+		ProductID:   query.Get("product_id"),
+		Bucket:      query.Get("bucket"),
+	}
+
+	if filter.WarehouseID == "" && filter.ProductID == "" {
+		return nil, fmt.Errorf("warehouse_id or product_id must be provided")
+	}
+
+	if filter.WarehouseID != "" {
+		if err := uuid.Validate(filter.WarehouseID); err != nil {
+			return nil, fmt.Errorf("warehouse id is not valid")
+		}
+	}
+
+	if filter.ProductID != "" {
+		if err := uuid.Validate(filter.ProductID); err != nil {
+			return nil, fmt.Errorf("product id is not valid")
+		}
+	}
+
+	if filter.Bucket == "" {
+		filter.Bucket = salesBuckets[0]
+	} else if !slices.Contains(salesBuckets, filter.Bucket) {
+		return nil, fmt.Errorf("bucket must be one of: %s", strings.Join(salesBuckets, ", "))
+	}
+
+	period, err := parseAnalyticsPeriod(r)
+	if err != nil {
+		return nil, err
+	}
+	filter.AnalyticsPeriod = *period
+
+	return filter, nil
+}
+
+// topProductsOrders - допустимые поля сортировки самых продаваемых продуктов.
+var topProductsOrders = []string{"revenue", "units"}
+
+// GetTopProducts обрабатывает запросы на получение самых продаваемых продуктов.
+func (h *AnalyticsHandler) GetTopProducts(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.AnalyticsHandler.GetTopProducts"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	limit, err := parseLimitParams(r)
+	if err != nil || limit <= 0 {
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong limit param")
+		return
+	}
+
+	filter, err := parseTopProductsFilter(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.Limit = limit
+
+	response, err := h.service.GetTopProducts(r.Context(), filter)
+	if err != nil {
+		log.Error("error from service module", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting top products")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parseTopProductsFilter извлекает склад, поле сортировки и период из параметров запроса.
+//
+// Если поле сортировки не указано, то продукты сортируются по выручке.
+func parseTopProductsFilter(r *http.Request) (*dto.TopProductsFilter, error) {
+	query := r.URL.Query()
+
+	filter := &dto.TopProductsFilter{
+		WarehouseID: query.Get("warehouse_id"),
+		OrderBy:     query.Get("by"),
+	}
+
+	if filter.WarehouseID != "" {
+		if err := uuid.Validate(filter.WarehouseID); err != nil {
+			return nil, fmt.Errorf("warehouse id is not valid")
+		}
+	}
+
+	if filter.OrderBy == "" {
+		filter.OrderBy = topProductsOrders[0]
+	} else if !slices.Contains(topProductsOrders, filter.OrderBy) {
+		return nil, fmt.Errorf("by must be one of: %s", strings.Join(topProductsOrders, ", "))
+	}
+
+	period, err := parseAnalyticsPeriod(r)
+	if err != nil {
+		return nil, err
+	}
+	filter.AnalyticsPeriod = *period
+
+	return filter, nil
+}
+
+// GetProductAnalytics обрабатывает запросы на получение продаж продукта по складам.
+func (h *AnalyticsHandler) GetProductAnalytics(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.AnalyticsHandler.GetProductAnalytics"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	productID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong product ID")
+		return
+	}
+
+	period, err := parseAnalyticsPeriod(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.service.GetProductAnalytics(r.Context(), productID, period)
+	if err != nil {
+		if errors.Is(err, custErr.ErrProductNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error from service module", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting product analytics")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAnalyticsPeriod(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Query   string
+		Want    *dto.AnalyticsPeriod
+		WantErr string
+	}{
+		{
+			Name:  "All time",
+			Query: "",
+			Want:  &dto.AnalyticsPeriod{},
+		},
+		{
+			Name:  "Both bounds",
+			Query: "from=2025-03-01&to=2025-03-31T23:59:59Z",
+			Want: &dto.AnalyticsPeriod{
+				From: ptr(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+				To:   ptr(time.Date(2025, 3, 31, 23, 59, 59, 0, time.UTC)),
+			},
+		},
+		{
+			Name:  "Same day",
+			Query: "from=2025-03-01&to=2025-03-01",
+			Want: &dto.AnalyticsPeriod{
+				From: ptr(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+				To:   ptr(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			Name:    "From after to",
+			Query:   "from=2025-04-01&to=2025-03-01",
+			WantErr: "from must not be after to",
+		},
+		{
+			Name:    "Wrong to",
+			Query:   "to=tomorrow",
+			WantErr: "to must be in RFC 3339 or YYYY-MM-DD format",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/?"+tc.Query, nil)
+
+			got, err := parseAnalyticsPeriod(req)
+			if tc.WantErr != "" {
+				require.EqualError(t, err, tc.WantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.Want, got)
+		})
+	}
+}
+
+func TestParseSalesTimeSeriesFilter(t *testing.T) {
+	const (
+		warehouseID = "17b79680-4657-4ef4-9c3d-554a83c31828"
+		productID   = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+	)
+
+	cases := []struct {
+		Name    string
+		Query   string
+		Want    *dto.SalesTimeSeriesFilter
+		WantErr string
+	}{
+		{
+			Name:  "Warehouse with default bucket",
+			Query: "warehouse_id=" + warehouseID,
+			Want:  &dto.SalesTimeSeriesFilter{WarehouseID: warehouseID, Bucket: "day"},
+		},
+		{
+			Name:  "Product by month",
+			Query: "product_id=" + productID + "&bucket=month&from=2025-01-01",
+			Want: &dto.SalesTimeSeriesFilter{
+				ProductID: productID,
+				Bucket:    "month",
+				AnalyticsPeriod: dto.AnalyticsPeriod{
+					From: ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			Name:    "No warehouse and product",
+			Query:   "bucket=week",
+			WantErr: "warehouse_id or product_id must be provided",
+		},
+		{
+			Name:    "Wrong warehouse ID",
+			Query:   "warehouse_id=warehouse",
+			WantErr: "warehouse id is not valid",
+		},
+		{
+			Name:    "Wrong product ID",
+			Query:   "product_id=product",
+			WantErr: "product id is not valid",
+		},
+		{
+			Name:    "Wrong bucket",
+			Query:   "warehouse_id=" + warehouseID + "&bucket=year",
+			WantErr: "bucket must be one of: day, week, month",
+		},
+		{
+			Name:    "From after to",
+			Query:   "warehouse_id=" + warehouseID + "&from=2025-02-01&to=2025-01-01",
+			WantErr: "from must not be after to",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/?"+tc.Query, nil)
+
+			got, err := parseSalesTimeSeriesFilter(req)
+			if tc.WantErr != "" {
+				require.EqualError(t, err, tc.WantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.Want, got)
+		})
+	}
+}
+
+func TestGetTopProducts(t *testing.T) {
+	const warehouseID = "17b79680-4657-4ef4-9c3d-554a83c31828"
+
+	cases := []struct {
+		Name         string
+		Query        string
+		WantFilter   *dto.TopProductsFilter
+		ReturnError  error
+		StatusCode   int
+		ResponseBody string
+	}{
+		{
+			Name:         "Success with defaults",
+			WantFilter:   &dto.TopProductsFilter{OrderBy: "revenue", Limit: 10},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `[{"product_id":"p","product_name":"Product","units":3,"revenue":30.00}]`,
+		},
+		{
+			Name:         "Success by units on warehouse",
+			Query:        "warehouse_id=" + warehouseID + "&by=units&limit=5",
+			WantFilter:   &dto.TopProductsFilter{WarehouseID: warehouseID, OrderBy: "units", Limit: 5},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `[{"product_id":"p","product_name":"Product","units":3,"revenue":30.00}]`,
+		},
+		{
+			Name:         "Zero limit",
+			Query:        "limit=0",
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"error":"wrong limit param"}`,
+		},
+		{
+			Name:         "Wrong order",
+			Query:        "by=margin",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"by must be one of: revenue, units"}`,
+		},
+		{
+			Name:         "Service error",
+			WantFilter:   &dto.TopProductsFilter{OrderBy: "revenue", Limit: 10},
+			ReturnError:  errors.New("internal server error"),
+			StatusCode:   http.StatusInternalServerError,
+			ResponseBody: `{"error":"error while getting top products"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockService := NewMockAnalyticsService(t)
+			if tc.WantFilter != nil {
+				var response []*dto.TopProductResponse
+				if tc.ReturnError == nil {
+					response = []*dto.TopProductResponse{{ProductID: "p", ProductName: "Product", Units: 3, Revenue: 3000}}
+				}
+				mockService.On("GetTopProducts", mock.Anything, tc.WantFilter).
+					Return(response, tc.ReturnError).
+					Once()
+			}
+
+			logger.CreateNOPLogger()
+
+			handler := NewAnalyticsHandler(mockService)
+			req := httptest.NewRequest(http.MethodGet, "/api/analytics/top_products?"+tc.Query, nil)
+			rr := httptest.NewRecorder()
+
+			handler.GetTopProducts(rr, req)
+			require.Equal(t, tc.StatusCode, rr.Code)
+			assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func TestGetProductAnalytics(t *testing.T) {
+	const productID = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+
+	cases := []struct {
+		Name         string
+		ProductID    string
+		Query        string
+		CallService  bool
+		ReturnError  error
+		StatusCode   int
+		ResponseBody string
+	}{
+		{
+			Name:         "Success",
+			ProductID:    productID,
+			CallService:  true,
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"product_id":"` + productID + `","product_name":"Product","total_units":0,"total_revenue":0.00,"warehouses":[]}`,
+		},
+		{
+			Name:         "Wrong product ID",
+			ProductID:    "product",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"wrong product ID"}`,
+		},
+		{
+			Name:         "Wrong period",
+			ProductID:    productID,
+			Query:        "from=2025-02-01&to=2025-01-01",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"from must not be after to"}`,
+		},
+		{
+			Name:         "Product not found",
+			ProductID:    productID,
+			CallService:  true,
+			ReturnError:  custErr.ErrProductNotFound,
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"error":"product not found"}`,
+		},
+		{
+			Name:         "Service error",
+			ProductID:    productID,
+			CallService:  true,
+			ReturnError:  errors.New("internal server error"),
+			StatusCode:   http.StatusInternalServerError,
+			ResponseBody: `{"error":"error while getting product analytics"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockService := NewMockAnalyticsService(t)
+			if tc.CallService {
+				var response *dto.ProductAnalyticsResponse
+				if tc.ReturnError == nil {
+					response = &dto.ProductAnalyticsResponse{
+						ProductID:   productID,
+						ProductName: "Product",
+						Warehouses:  []*dto.ProductAtWarehouseAnalytic{},
+					}
+				}
+				mockService.On("GetProductAnalytics", mock.Anything, uuid.MustParse(productID), &dto.AnalyticsPeriod{}).
+					Return(response, tc.ReturnError).
+					Once()
+			}
+
+			logger.CreateNOPLogger()
+
+			handler := NewAnalyticsHandler(mockService)
+			req := httptest.NewRequest(http.MethodGet, "/api/analytics/product/"+tc.ProductID+"?"+tc.Query, nil)
+			req.SetPathValue("id", tc.ProductID)
+			rr := httptest.NewRecorder()
+
+			handler.GetProductAnalytics(rr, req)
+			require.Equal(t, tc.StatusCode, rr.Code)
+			assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+		})
+	}
+}
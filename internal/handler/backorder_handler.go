@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// BackorderService определяет методы для работы с предзаказами товаров.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type BackorderService interface {
+	SetBackorderLimit(ctx context.Context, request *dto.BackorderLimitRequest) error
+	GetBackorders(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.BackordersResponse, error)
+}
+
+// BackorderHandler обрабатывает запросы, связанные с предзаказами товаров.
+type BackorderHandler struct {
+	service BackorderService
+}
+
+// NewBackorderHandler создает новый экземпляр BackorderHandler с заданным сервисом.
+func NewBackorderHandler(service BackorderService) *BackorderHandler {
+	return &BackorderHandler{
+		service: service,
+	}
+}
+
+// SetBackorderLimit обрабатывает запросы на задание лимита предзаказа товара на складе.
+func (h *BackorderHandler) SetBackorderLimit(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.BackorderHandler.SetBackorderLimit"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var limitReq dto.BackorderLimitRequest
+	if err := json.NewDecoder(r.Body).Decode(&limitReq); err != nil {
+		log.Error("error while parsing JSON", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "cannot parse JSON")
+		return
+	}
+
+	validErr := validateBackorderLimitRequest(&limitReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	err := h.service.SetBackorderLimit(r.Context(), &limitReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrInventoryNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, "there is no information about this product on warehouse")
+		case errors.Is(err, custErr.ErrBackorderSerialized):
+			custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		default:
+			log.Error("error while setting backorder limit", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while setting backorder limit")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateBackorderLimitRequest проверяет корректность данных запроса на задание лимита предзаказа.
+func validateBackorderLimitRequest(req *dto.BackorderLimitRequest) map[string]string {
+	validErr := make(map[string]string)
+
+	if req.ProductID == "" {
+		validErr["product_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.ProductID); err != nil {
+		validErr["product_id"] = "invalid product ID"
+	}
+
+	if req.WarehouseID == "" {
+		validErr["warehouse_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.WarehouseID); err != nil {
+		validErr["warehouse_id"] = "invalid warehouse ID"
+	}
+
+	if req.BackorderLimit == nil {
+		validErr["backorder_limit"] = "this field cannot be empty"
+	} else if *req.BackorderLimit < 0 {
+		validErr["backorder_limit"] = "backorder limit cannot be negative"
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
+
+// GetBackorders обрабатывает запросы на получение открытых предзаказов склада.
+func (h *BackorderHandler) GetBackorders(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.BackorderHandler.GetBackorders"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	warehouseID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong warehouseID")
+		return
+	}
+
+	response, err := h.service.GetBackorders(r.Context(), parseParams(r), warehouseID.String())
+	if err != nil {
+		log.Error("error while getting backorders", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting backorders")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// BatchService определяет методы для работы с партиями товаров.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type BatchService interface {
+	GetBatches(ctx context.Context, filter *dto.BatchFilter) (*dto.BatchesResponse, error)
+	GetExpiringBatches(ctx context.Context, params *dto.Pagination, warehouseID string, days int) (*dto.ExpiringBatchesResponse, error)
+}
+
+// BatchHandler обрабатывает запросы, связанные с партиями товаров.
+type BatchHandler struct {
+	service BatchService
+}
+
+// NewBatchHandler создает новый экземпляр BatchHandler с заданным сервисом.
+func NewBatchHandler(service BatchService) *BatchHandler {
+	return &BatchHandler{
+		service: service,
+	}
+}
+
+const (
+	defaultExpiringDays = 7     // горизонт отчета об истекающих партиях по умолчанию.
+	maxExpiringDays     = 36500 // наибольший горизонт отчета, примерно сто лет.
+)
+
+// GetBatches обрабатывает запросы на получение партий товаров на складе.
+func (h *BatchHandler) GetBatches(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.BatchHandler.GetBatches"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	warehouseID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong warehouseID")
+		return
+	}
+
+	productID := r.URL.Query().Get("product_id")
+	if productID != "" {
+		if err := uuid.Validate(productID); err != nil {
+			custErr.UnnamedError(w, http.StatusBadRequest, "product id is not valid")
+			return
+		}
+	}
+
+	response, err := h.service.GetBatches(r.Context(), &dto.BatchFilter{
+		WarehouseID: warehouseID.String(),
+		ProductID:   productID,
+		Pagination:  parseParams(r),
+	})
+	if err != nil {
+		log.Error("error while getting batches", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting batches")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// GetExpiringBatches обрабатывает запросы на получение партий, срок годности которых истекает в течение N дней.
+func (h *BatchHandler) GetExpiringBatches(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.BatchHandler.GetExpiringBatches"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	warehouseID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong warehouseID")
+		return
+	}
+
+	days, err := parseExpiringDays(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.service.GetExpiringBatches(r.Context(), parseParams(r), warehouseID.String(), days)
+	if err != nil {
+		log.Error("error while getting expiring batches", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting expiring batches")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parseExpiringDays извлекает из параметров запроса горизонт отчета в днях.
+//
+// Если параметр не указан, то возвращает defaultExpiringDays. Горизонт ограничен
+// maxExpiringDays, чтобы граница отчета оставалась в пределах дат базы данных.
+func parseExpiringDays(r *http.Request) (int, error) {
+	value := r.URL.Query().Get("days")
+	if value == "" {
+		return defaultExpiringDays, nil
+	}
+
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 0 || days > maxExpiringDays {
+		return 0, fmt.Errorf("days must be an integer between 0 and %d", maxExpiringDays)
+	}
+
+	return days, nil
+}
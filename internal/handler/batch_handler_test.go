@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpiringDays(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Query   string
+		Want    int
+		WantErr bool
+	}{
+		{Name: "Default", Query: "", Want: defaultExpiringDays},
+		{Name: "Only expired", Query: "days=0", Want: 0},
+		{Name: "Month", Query: "days=30", Want: 30},
+		{Name: "Max", Query: "days=36500", Want: maxExpiringDays},
+		{Name: "Negative", Query: "days=-1", WantErr: true},
+		{Name: "Too far", Query: "days=1000000000", WantErr: true},
+		{Name: "Not a number", Query: "days=week", WantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/?"+tc.Query, nil)
+
+			got, err := parseExpiringDays(req)
+			if tc.WantErr {
+				require.EqualError(t, err, "days must be an integer between 0 and 36500")
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.Want, got)
+		})
+	}
+}
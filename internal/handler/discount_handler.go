@@ -0,0 +1,333 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DiscountService определяет методы для работы с правилами скидок.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type DiscountService interface {
+	CreateDiscountRule(ctx context.Context, request *dto.DiscountRuleRequest) (*dto.DiscountRuleResponse, error)
+	GetDiscountRule(ctx context.Context, discountID uuid.UUID) (*dto.DiscountRuleResponse, error)
+	GetDiscountRules(ctx context.Context, filter *dto.DiscountRuleFilter) (*dto.DiscountRulesResponse, error)
+	UpdateDiscountRule(ctx context.Context, discountID uuid.UUID, request *dto.DiscountRuleRequest) (*dto.DiscountRuleResponse, error)
+	DeleteDiscountRule(ctx context.Context, discountID uuid.UUID) error
+}
+
+// DiscountHandler обрабатывает запросы, связанные с правилами скидок.
+type DiscountHandler struct {
+	service DiscountService
+}
+
+// NewDiscountHandler создает новый экземпляр DiscountHandler с заданным сервисом скидок.
+func NewDiscountHandler(service DiscountService) *DiscountHandler {
+	return &DiscountHandler{
+		service: service,
+	}
+}
+
+// DiscountsHandler обрабатывает запросы к списку правил скидок.
+func (h *DiscountHandler) DiscountsHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetDiscountRules(w, r)
+	case http.MethodPost:
+		h.CreateDiscountRule(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// DiscountRuleHandler обрабатывает запросы к одному правилу скидки.
+func (h *DiscountHandler) DiscountRuleHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetDiscountRule(w, r)
+	case http.MethodPut:
+		h.UpdateDiscountRule(w, r)
+	case http.MethodDelete:
+		h.DeleteDiscountRule(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// CreateDiscountRule обрабатывает запросы на создание правила скидки.
+func (h *DiscountHandler) CreateDiscountRule(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.DiscountHandler.CreateDiscountRule"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	ruleReq, err := parseDiscountRuleRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing discount rule", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	validErr := validateDiscountRuleRequest(ruleReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.CreateDiscountRule(r.Context(), ruleReq)
+	if err != nil {
+		if errors.Is(err, custErr.ErrInventoryNotFound) {
+			custErr.UnnamedError(w, http.StatusBadRequest, "there is no product in warehouse")
+			return
+		}
+		log.Error("error while creating discount rule", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while creating discount")
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, response)
+}
+
+// GetDiscountRules обрабатывает запросы на получение списка правил скидок.
+func (h *DiscountHandler) GetDiscountRules(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.DiscountHandler.GetDiscountRules"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	filter, err := parseDiscountRuleFilter(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.service.GetDiscountRules(r.Context(), filter)
+	if err != nil {
+		log.Error("error while getting discount rules", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting discounts")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parseDiscountRuleFilter извлекает параметры выборки правил скидок из параметров запроса.
+//
+// Если передан параметр active=true, то выбираются только правила, действующие сейчас.
+func parseDiscountRuleFilter(r *http.Request) (*dto.DiscountRuleFilter, error) {
+	query := r.URL.Query()
+
+	filter := &dto.DiscountRuleFilter{
+		WarehouseID: query.Get("warehouse_id"),
+		ProductID:   query.Get("product_id"),
+		Pagination:  parseParams(r),
+	}
+
+	if filter.WarehouseID != "" {
+		if err := uuid.Validate(filter.WarehouseID); err != nil {
+			return nil, fmt.Errorf("warehouse id is not valid")
+		}
+	}
+
+	if filter.ProductID != "" {
+		if err := uuid.Validate(filter.ProductID); err != nil {
+			return nil, fmt.Errorf("product id is not valid")
+		}
+	}
+
+	if value := query.Get("active"); value != "" {
+		active, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("active must be true or false")
+		}
+		if active {
+			now := time.Now()
+			filter.ActiveAt = &now
+		}
+	}
+
+	return filter, nil
+}
+
+// GetDiscountRule обрабатывает запросы на получение правила скидки.
+func (h *DiscountHandler) GetDiscountRule(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.DiscountHandler.GetDiscountRule"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	discountID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong discount ID")
+		return
+	}
+
+	response, err := h.service.GetDiscountRule(r.Context(), discountID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrDiscountRuleNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while getting discount rule", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting discount")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// UpdateDiscountRule обрабатывает запросы на изменение правила скидки.
+func (h *DiscountHandler) UpdateDiscountRule(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.DiscountHandler.UpdateDiscountRule"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	discountID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong discount ID")
+		return
+	}
+
+	ruleReq, err := parseDiscountRuleRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing discount rule", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	validErr := validateDiscountRuleRequest(ruleReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.UpdateDiscountRule(r.Context(), discountID, ruleReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrDiscountRuleNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, custErr.ErrInventoryNotFound):
+			custErr.UnnamedError(w, http.StatusBadRequest, "there is no product in warehouse")
+		default:
+			log.Error("error while updating discount rule", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while updating discount")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// DeleteDiscountRule обрабатывает запросы на удаление правила скидки.
+func (h *DiscountHandler) DeleteDiscountRule(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.DiscountHandler.DeleteDiscountRule"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	discountID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong discount ID")
+		return
+	}
+
+	err = h.service.DeleteDiscountRule(r.Context(), discountID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrDiscountRuleNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while deleting discount rule", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while deleting discount")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseDiscountRuleRequest извлекает правило скидки из запроса.
+func parseDiscountRuleRequest(r io.Reader) (*dto.DiscountRuleRequest, error) {
+	var req dto.DiscountRuleRequest
+
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// validateDiscountRuleRequest проверяет корректность правила скидки.
+func validateDiscountRuleRequest(req *dto.DiscountRuleRequest) map[string]any {
+	validErr := make(map[string]any)
+
+	if req.WarehouseID == "" {
+		validErr["warehouse_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.WarehouseID); err != nil {
+		validErr["warehouse_id"] = "invalid warehouse ID"
+	}
+
+	if req.ProductID == "" {
+		validErr["product_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.ProductID); err != nil {
+		validErr["product_id"] = "invalid product ID"
+	}
+
+	validateDiscountValue(validErr, req.Type, req.Percent, req.Amount)
+
+	if req.ValidFrom != nil && req.ValidTo != nil && !req.ValidFrom.Before(*req.ValidTo) {
+		validErr["valid_to"] = "valid_to must be after valid_from"
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
+
+// validateDiscountValue проверяет тип скидки и соответствующее ему значение
+// и записывает ошибки в validErr.
+//
+// Для процентной скидки обязателен процент, для фиксированной - сумма.
+func validateDiscountValue(validErr map[string]any, discountType string, percent *int, amount *domain.Money) {
+	switch domain.DiscountType(discountType) {
+	case domain.DiscountPercent:
+		if percent == nil {
+			validErr["discount_percent"] = "this field cannot be empty"
+		} else if *percent <= 0 || *percent > 100 {
+			validErr["discount_percent"] = "discount percent must be between 1 and 100"
+		}
+		if amount != nil {
+			validErr["discount_amount"] = "percent discount cannot have amount"
+		}
+	case domain.DiscountFixed:
+		if amount == nil {
+			validErr["discount_amount"] = "this field cannot be empty"
+		} else if *amount <= 0 {
+			validErr["discount_amount"] = "discount amount must be greater than 0"
+		}
+		if percent != nil {
+			validErr["discount_percent"] = "fixed discount cannot have percent"
+		}
+	case "":
+		validErr["discount_type"] = "this field cannot be empty"
+	default:
+		validErr["discount_type"] = "discount type must be one of: percent, fixed"
+	}
+}
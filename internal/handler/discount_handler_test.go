@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDiscountRuleRequest(t *testing.T) {
+	const (
+		warehouseID = "17b79680-4657-4ef4-9c3d-554a83c31828"
+		productID   = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+	)
+
+	from := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Name    string
+		Request *dto.DiscountRuleRequest
+		WantErr map[string]any
+	}{
+		{
+			Name: "Percent discount",
+			Request: &dto.DiscountRuleRequest{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				Type:        "percent",
+				Percent:     ptr(15),
+				ValidFrom:   &from,
+				ValidTo:     &to,
+			},
+		},
+		{
+			Name: "Fixed discount",
+			Request: &dto.DiscountRuleRequest{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				Type:        "fixed",
+				Amount:      ptr(domain.Money(500)),
+			},
+		},
+		{
+			Name:    "Empty request",
+			Request: &dto.DiscountRuleRequest{},
+			WantErr: map[string]any{
+				"warehouse_id":  "this field cannot be empty",
+				"product_id":    "this field cannot be empty",
+				"discount_type": "this field cannot be empty",
+			},
+		},
+		{
+			Name: "Wrong IDs and type",
+			Request: &dto.DiscountRuleRequest{
+				WarehouseID: "warehouse",
+				ProductID:   "product",
+				Type:        "gift",
+			},
+			WantErr: map[string]any{
+				"warehouse_id":  "invalid warehouse ID",
+				"product_id":    "invalid product ID",
+				"discount_type": "discount type must be one of: percent, fixed",
+			},
+		},
+		{
+			Name: "Percent out of range with amount",
+			Request: &dto.DiscountRuleRequest{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				Type:        "percent",
+				Percent:     ptr(101),
+				Amount:      ptr(domain.Money(500)),
+			},
+			WantErr: map[string]any{
+				"discount_percent": "discount percent must be between 1 and 100",
+				"discount_amount":  "percent discount cannot have amount",
+			},
+		},
+		{
+			Name: "Fixed without amount",
+			Request: &dto.DiscountRuleRequest{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				Type:        "fixed",
+				Percent:     ptr(10),
+			},
+			WantErr: map[string]any{
+				"discount_amount":  "this field cannot be empty",
+				"discount_percent": "fixed discount cannot have percent",
+			},
+		},
+		{
+			Name: "Zero amount",
+			Request: &dto.DiscountRuleRequest{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				Type:        "fixed",
+				Amount:      ptr(domain.Money(0)),
+			},
+			WantErr: map[string]any{
+				"discount_amount": "discount amount must be greater than 0",
+			},
+		},
+		{
+			Name: "Empty validity window",
+			Request: &dto.DiscountRuleRequest{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				Type:        "percent",
+				Percent:     ptr(10),
+				ValidFrom:   &to,
+				ValidTo:     &to,
+			},
+			WantErr: map[string]any{
+				"valid_to": "valid_to must be after valid_from",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			require.Equal(t, tc.WantErr, validateDiscountRuleRequest(tc.Request))
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// FulfillmentService определяет методы для сборки заказа с нескольких складов.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type FulfillmentService interface {
+	PlanFulfillment(ctx context.Context, req *dto.FulfillmentRequest) (*dto.FulfillmentResponse, error)
+	Fulfill(ctx context.Context, req *dto.FulfillmentRequest) (*dto.FulfillmentResponse, error)
+}
+
+// FulfillmentHandler обрабатывает запросы, связанные со сборкой заказа с нескольких складов.
+type FulfillmentHandler struct {
+	service FulfillmentService
+}
+
+// NewFulfillmentHandler создает новый экземпляр FulfillmentHandler с заданным сервисом.
+func NewFulfillmentHandler(service FulfillmentService) *FulfillmentHandler {
+	return &FulfillmentHandler{
+		service: service,
+	}
+}
+
+// PlanFulfillment обрабатывает запросы на расчет плана сборки заказа без покупки.
+func (h *FulfillmentHandler) PlanFulfillment(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.FulfillmentHandler.PlanFulfillment"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	req, err := parseFulfillmentRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing fulfillment request", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	if validErr := validateFulfillmentRequest(req); validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.PlanFulfillment(r.Context(), req)
+	if err != nil {
+		if custErr.Any(err, custErr.ErrNotEnoughProductCount) {
+			custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error("error in service module", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while planning fulfillment")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// Fulfill обрабатывает запросы на покупку заказа с нескольких складов.
+func (h *FulfillmentHandler) Fulfill(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.FulfillmentHandler.Fulfill"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	req, err := parseFulfillmentRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing fulfillment request", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	if validErr := validateFulfillmentRequest(req); validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.Fulfill(r.Context(), req)
+	if err != nil {
+		if custErr.Any(err, custErr.ErrNotEnoughProductCount, custErr.ErrNotFoundProductAtWarehouse) {
+			custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error("error in service module", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while fulfilling order")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parseFulfillmentRequest разбирает тело запроса на сборку заказа.
+func parseFulfillmentRequest(r io.Reader) (*dto.FulfillmentRequest, error) {
+	var req dto.FulfillmentRequest
+
+	err := json.NewDecoder(r).Decode(&req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// validateFulfillmentRequest проверяет корректность данных запроса на сборку заказа.
+func validateFulfillmentRequest(req *dto.FulfillmentRequest) map[string]any {
+	validErr := make(map[string]any)
+	var productsID []string
+
+	if req.PreferredWarehouseID != "" {
+		if err := uuid.Validate(req.PreferredWarehouseID); err != nil {
+			validErr["preferred_warehouse_id"] = "invalid warehouse ID"
+		}
+	}
+
+	if req.Strategy != "" {
+		if _, err := domain.ParseFulfillmentStrategy(req.Strategy); err != nil {
+			validErr["strategy"] = "unknown fulfillment strategy"
+		} else if domain.FulfillmentStrategy(req.Strategy) == domain.FulfillmentNearest && req.Latitude == nil && req.Longitude == nil {
+			validErr["location"] = "delivery location is required for nearest strategy"
+		}
+	}
+
+	locationErr := make(map[string]string)
+	validateLocation(locationErr, req.Latitude, req.Longitude)
+	for k, v := range locationErr {
+		validErr[k] = v
+	}
+
+	if len(req.Products) == 0 {
+		validErr["products"] = "there is no products in cart"
+	} else {
+		productsErr := make(map[int]any)
+		for idx, product := range req.Products {
+			if slices.Contains(productsID, product.ProductID) {
+				productsErr[idx] = map[string]string{"product_id": "product ID must be unique"}
+				continue
+			}
+			productsID = append(productsID, product.ProductID)
+			productErr := validateProductInCart(product)
+			if productErr != nil {
+				productsErr[idx] = productErr
+			}
+		}
+
+		if len(productsErr) != 0 {
+			validErr["products"] = productsErr
+		}
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
 	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
@@ -26,9 +27,13 @@ import (
 type InventoryService interface {
 	CreateInventory(ctx context.Context, request *dto.InventoryCreateRequest) error
 	ChangeProductCount(ctx context.Context, request *dto.ChangeProductCountRequest) error
+	ChangeProductPrice(ctx context.Context, request *dto.ChangeProductPriceRequest) (*dto.PriceChangeResponse, error)
+	ReceiveBatch(ctx context.Context, request *dto.BatchRequest) (*dto.BatchResponse, error)
+	SetStockThresholds(ctx context.Context, request *dto.StockThresholdsRequest) error
+	GetLowStockProducts(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.LowStockResponse, error)
 	AddDiscountToProduct(ctx context.Context, request *dto.DiscountToProductRequest) error
 	GetProductFromWarehouse(ctx context.Context, warehouseID, productID string) (*dto.ProductFromWarehouseResponse, error)
-	GetProductsAtWarehouse(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.ProductsResponse, error)
+	GetProductsAtWarehouse(ctx context.Context, filter *dto.StockFilter) (*dto.ProductsResponse, error)
 	CalculateCart(ctx context.Context, request *dto.CartRequest) (*dto.CartResponse, error)
 	BuyProducts(ctx context.Context, request *dto.CartRequest) (*dto.CartResponse, error)
 }
@@ -79,6 +84,9 @@ func (h *InventoryHandler) CreateInventory(w http.ResponseWriter, r *http.Reques
 			custErr.UnnamedError(w, http.StatusBadRequest, "wrong product ID or warehouse ID")
 			return
 		}
+		if writeSerialError(w, err) {
+			return
+		}
 
 		log.Error("error while creating inventory", zap.Error(err))
 		custErr.UnnamedError(w, http.StatusInternalServerError, "error while creating inventory")
@@ -127,6 +135,14 @@ func validateInventoryCreateRequest(req *dto.InventoryCreateRequest) map[string]
 		validErr["product_price"] = "invalid product price"
 	}
 
+	if msg := validateSerials(req.Serials, req.Count); msg != "" {
+		validErr["serials"] = msg
+	}
+
+	if req.UnitCost != nil && *req.UnitCost < 0 {
+		validErr["unit_cost"] = "invalid unit cost"
+	}
+
 	if len(validErr) > 0 {
 		return validErr
 	}
@@ -164,6 +180,9 @@ func (h *InventoryHandler) ChangeProductCount(w http.ResponseWriter, r *http.Req
 			custErr.UnnamedError(w, http.StatusNotFound, "there is no information about this product on warehouse")
 			return
 		}
+		if writeSerialError(w, err) {
+			return
+		}
 		log.Error("error while change product count", zap.Error(err))
 		custErr.UnnamedError(w, http.StatusInternalServerError, "error while changing product count")
 		return
@@ -205,6 +224,206 @@ func validateChangeProductCountRequest(req *dto.ChangeProductCountRequest) map[s
 		validErr["product_count"] = "invalid product count"
 	}
 
+	if msg := validateSerials(req.Serials, req.Count); msg != "" {
+		validErr["serials"] = msg
+	}
+
+	if req.UnitCost != nil && *req.UnitCost < 0 {
+		validErr["unit_cost"] = "invalid unit cost"
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
+
+// SetStockThresholds обрабатывает запросы на задание порогов остатка товара на складе.
+func (h *InventoryHandler) SetStockThresholds(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.InventoryHandler.SetStockThresholds"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var thresholdsReq dto.StockThresholdsRequest
+	if err := json.NewDecoder(r.Body).Decode(&thresholdsReq); err != nil {
+		log.Error("error while parsing JSON", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "cannot parse JSON")
+		return
+	}
+
+	validErr := validateStockThresholdsRequest(&thresholdsReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	err := h.service.SetStockThresholds(r.Context(), &thresholdsReq)
+	if err != nil {
+		if errors.Is(err, custErr.ErrInventoryNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, "there is no information about this product on warehouse")
+			return
+		}
+		log.Error("error while setting stock thresholds", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while setting stock thresholds")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateStockThresholdsRequest проверяет корректность данных запроса на задание порогов остатка.
+func validateStockThresholdsRequest(req *dto.StockThresholdsRequest) map[string]string {
+	validErr := make(map[string]string)
+
+	if req.ProductID == "" {
+		validErr["product_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.ProductID); err != nil {
+		validErr["product_id"] = "invalid product ID"
+	}
+
+	if req.WarehouseID == "" {
+		validErr["warehouse_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.WarehouseID); err != nil {
+		validErr["warehouse_id"] = "invalid warehouse ID"
+	}
+
+	if req.MinQuantity == nil {
+		validErr["min_quantity"] = "this field cannot be empty"
+	} else if *req.MinQuantity < 0 {
+		validErr["min_quantity"] = "min quantity cannot be negative"
+	}
+
+	if req.ReorderQuantity == nil {
+		validErr["reorder_quantity"] = "this field cannot be empty"
+	} else if *req.ReorderQuantity < 0 {
+		validErr["reorder_quantity"] = "reorder quantity cannot be negative"
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
+
+// GetLowStockProducts обрабатывает запросы на получение товаров склада, которые нужно дозаказать.
+func (h *InventoryHandler) GetLowStockProducts(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.InventoryHandler.GetLowStockProducts"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	warehouseID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong warehouseID")
+		return
+	}
+
+	response, err := h.service.GetLowStockProducts(r.Context(), parseParams(r), warehouseID.String())
+	if err != nil {
+		log.Error("error while getting low stock products", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting low stock products")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// maxChangedByLength - максимальная длина имени того, кто изменил цену.
+const maxChangedByLength = 128
+
+// ChangeProductPrice обрабатывает запросы на изменение цены товара на складе.
+func (h *InventoryHandler) ChangeProductPrice(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.InventoryHandler.ChangeProductPrice"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	priceReq, err := parseChangeProductPriceRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing JSON", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "cannot parse JSON")
+		return
+	}
+
+	validErr := validateChangeProductPriceRequest(priceReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.ChangeProductPrice(r.Context(), priceReq)
+	if err != nil {
+		if errors.Is(err, custErr.ErrInventoryNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, "there is no information about this product on warehouse")
+			return
+		}
+		log.Error("error while changing product price", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while changing product price")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parseChangeProductPriceRequest извлекает данные запроса на изменение цены товара.
+func parseChangeProductPriceRequest(r io.Reader) (*dto.ChangeProductPriceRequest, error) {
+	var request dto.ChangeProductPriceRequest
+
+	if err := json.NewDecoder(r).Decode(&request); err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// validateChangeProductPriceRequest проверяет корректность данных запроса на изменение цены товара.
+func validateChangeProductPriceRequest(req *dto.ChangeProductPriceRequest) map[string]string {
+	validErr := make(map[string]string)
+
+	if req.ProductID == "" {
+		validErr["product_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.ProductID); err != nil {
+		validErr["product_id"] = "invalid product ID"
+	}
+
+	if req.WarehouseID == "" {
+		validErr["warehouse_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.WarehouseID); err != nil {
+		validErr["warehouse_id"] = "invalid warehouse ID"
+	}
+
+	if req.Price == nil {
+		validErr["product_price"] = "this field cannot be empty"
+	} else if *req.Price < 0 {
+		validErr["product_price"] = "invalid product price"
+	}
+
+	if req.Sale != nil && (*req.Sale < 0 || *req.Sale > 100) {
+		validErr["discount"] = "discount must be between 0 and 100"
+	}
+
+	if len(req.ChangedBy) > maxChangedByLength {
+		validErr["changed_by"] = fmt.Sprintf("changed_by must be at most %d characters", maxChangedByLength)
+	}
+
 	if len(validErr) != 0 {
 		return validErr
 	}
@@ -212,6 +431,113 @@ func validateChangeProductCountRequest(req *dto.ChangeProductCountRequest) map[s
 	return nil
 }
 
+// maxLotNumberLength - максимальная длина номера партии.
+const maxLotNumberLength = 64
+
+// ReceiveBatch обрабатывает запросы на приемку партии товара на склад.
+func (h *InventoryHandler) ReceiveBatch(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.InventoryHandler.ReceiveBatch"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	batchReq, err := parseBatchRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing JSON", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "cannot parse JSON")
+		return
+	}
+
+	validErr := validateBatchRequest(batchReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.ReceiveBatch(r.Context(), batchReq)
+	if err != nil {
+		if errors.Is(err, custErr.ErrInventoryNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, "there is no information about this product on warehouse")
+			return
+		}
+		if errors.Is(err, custErr.ErrBatchAlreadyExists) {
+			custErr.UnnamedError(w, http.StatusConflict, custErr.ErrBatchAlreadyExists.Error())
+			return
+		}
+		if writeSerialError(w, err) {
+			return
+		}
+		log.Error("error while receiving batch", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while receiving batch")
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, response)
+}
+
+// parseBatchRequest извлекает данные запроса на приемку партии товара.
+func parseBatchRequest(r io.Reader) (*dto.BatchRequest, error) {
+	var request dto.BatchRequest
+
+	if err := json.NewDecoder(r).Decode(&request); err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// validateBatchRequest проверяет корректность данных запроса на приемку партии товара.
+func validateBatchRequest(req *dto.BatchRequest) map[string]string {
+	validErr := make(map[string]string)
+
+	if req.ProductID == "" {
+		validErr["product_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.ProductID); err != nil {
+		validErr["product_id"] = "invalid product ID"
+	}
+
+	if req.WarehouseID == "" {
+		validErr["warehouse_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.WarehouseID); err != nil {
+		validErr["warehouse_id"] = "invalid warehouse ID"
+	}
+
+	if strings.TrimSpace(req.LotNumber) == "" {
+		validErr["lot_number"] = "this field cannot be empty"
+	} else if len(req.LotNumber) > maxLotNumberLength {
+		validErr["lot_number"] = fmt.Sprintf("lot number must be at most %d characters", maxLotNumberLength)
+	}
+
+	if req.Quantity == nil {
+		validErr["quantity"] = "this field cannot be empty"
+	} else if *req.Quantity <= 0 {
+		validErr["quantity"] = "quantity must be positive"
+	}
+
+	if req.ExpiresAt != nil && req.ReceivedAt != nil && !req.ExpiresAt.After(*req.ReceivedAt) {
+		validErr["expires_at"] = "expiry date must be after received date"
+	}
+
+	if msg := validateSerials(req.Serials, req.Quantity); msg != "" {
+		validErr["serials"] = msg
+	}
+
+	if req.UnitCost != nil && *req.UnitCost < 0 {
+		validErr["unit_cost"] = "invalid unit cost"
+	}
+
+	if len(validErr) > 0 {
+		return validErr
+	}
+
+	return nil
+}
+
 // AddDiscountToProduct обрабатывает запросы на добавление скидок к товарам на складе.
 func (h *InventoryHandler) AddDiscountToProduct(w http.ResponseWriter, r *http.Request) {
 	log := logger.GetLogger().With(
@@ -421,9 +747,14 @@ func (h *InventoryHandler) GetProductsAtWarehouse(w http.ResponseWriter, r *http
 		return
 	}
 
-	params := parseParams(r)
+	filter, err := parseStockFilter(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.WarehouseID = warehouseID
 
-	response, err := h.service.GetProductsAtWarehouse(r.Context(), params, warehouseID)
+	response, err := h.service.GetProductsAtWarehouse(r.Context(), filter)
 	if err != nil {
 		log.Error("error while getting products", zap.Error(err))
 		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting products")
@@ -433,6 +764,96 @@ func (h *InventoryHandler) GetProductsAtWarehouse(w http.ResponseWriter, r *http
 	render.JSON(w, http.StatusOK, response)
 }
 
+// parseStockFilter извлекает из параметров запроса фильтры, сортировку и пагинацию товаров склада.
+//
+// Поддерживаются параметры sort (name, price, discount_price или count), order (asc или desc),
+// in_stock, discounted, min_price, max_price, cursor, page и limit.
+// Если поле сортировки не указано, то товары сортируются по названию.
+func parseStockFilter(r *http.Request) (*dto.StockFilter, error) {
+	query := r.URL.Query()
+
+	filter := &dto.StockFilter{
+		Sort:       domain.StockSortName,
+		Pagination: parseParams(r),
+	}
+
+	if value := query.Get("sort"); value != "" {
+		sort, err := domain.ParseStockSort(value)
+		if err != nil {
+			return nil, fmt.Errorf("sort must be one of: name, price, discount_price, count")
+		}
+		filter.Sort = sort
+	}
+
+	switch query.Get("order") {
+	case "", "asc":
+	case "desc":
+		filter.Desc = true
+	default:
+		return nil, fmt.Errorf("order must be one of: asc, desc")
+	}
+
+	var err error
+	if filter.InStock, err = parseBoolQuery(query.Get("in_stock"), "in_stock"); err != nil {
+		return nil, err
+	}
+	if filter.Discounted, err = parseBoolQuery(query.Get("discounted"), "discounted"); err != nil {
+		return nil, err
+	}
+	if filter.MinPrice, err = parsePriceQuery(query.Get("min_price"), "min_price"); err != nil {
+		return nil, err
+	}
+	if filter.MaxPrice, err = parsePriceQuery(query.Get("max_price"), "max_price"); err != nil {
+		return nil, err
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, fmt.Errorf("min_price cannot be greater than max_price")
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		cursor, err := domain.ParseStockCursor(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if cursor.Sort != filter.Sort || cursor.Desc != filter.Desc {
+			return nil, fmt.Errorf("cursor does not match sort and order")
+		}
+		filter.Cursor = cursor
+	}
+
+	return filter, nil
+}
+
+// parseBoolQuery разбирает логический параметр запроса name. Пустое значение считается false.
+func parseBoolQuery(value, name string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+
+	flag, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%s must be a boolean", name)
+	}
+
+	return flag, nil
+}
+
+// parsePriceQuery разбирает цену из параметра запроса name. Если параметр не указан, то возвращает nil.
+func parsePriceQuery(value, name string) (*domain.Money, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	price, err := domain.ParseMoney(value)
+	if err != nil || price < 0 {
+		return nil, fmt.Errorf("%s must be a non-negative number", name)
+	}
+
+	return &price, nil
+}
+
 // parseParams извлекает параметры пагинации из запроса и возвращает их в виде dto.Pagination.
 func parseParams(r *http.Request) *dto.Pagination {
 	page, err := strconv.Atoi(r.URL.Query().Get("page"))
@@ -481,7 +902,7 @@ func (h *InventoryHandler) CalculateCart(w http.ResponseWriter, r *http.Request)
 
 	resp, err := h.service.CalculateCart(r.Context(), cartReq)
 	if err != nil {
-		if custErr.Any(err, custErr.ErrNotEnoughProductCount, custErr.ErrNotFoundProductAtWarehouse) {
+		if custErr.Any(err, custErr.ErrNotEnoughProductCount, custErr.ErrNotFoundProductAtWarehouse) || isPromoCodeError(err) {
 			custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
 			return
 		}
@@ -516,6 +937,16 @@ func validateCartRequest(req *dto.CartRequest) map[string]any {
 		validErr["warehouse_id"] = "invalid warehouse ID"
 	}
 
+	if req.ReservationID != "" {
+		if err := uuid.Validate(req.ReservationID); err != nil {
+			validErr["reservation_id"] = "invalid reservation ID"
+		}
+	}
+
+	if len(req.PromoCode) > maxPromoCodeLength {
+		validErr["promo_code"] = "promo code is too long"
+	}
+
 	if len(req.Products) == 0 {
 		validErr["products"] = "there is no products in cart"
 	} else {
@@ -594,7 +1025,7 @@ func (h *InventoryHandler) BuyProducts(w http.ResponseWriter, r *http.Request) {
 
 	response, err := h.service.BuyProducts(r.Context(), cart)
 	if err != nil {
-		if custErr.Any(err, custErr.ErrNotEnoughProductCount, custErr.ErrNotFoundProductAtWarehouse) {
+		if custErr.Any(err, custErr.ErrNotEnoughProductCount, custErr.ErrNotFoundProductAtWarehouse, custErr.ErrReservationNotFound, custErr.ErrReservationMismatch) || isPromoCodeError(err) {
 			custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
 			return
 		}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeProductPrice(t *testing.T) {
+	const (
+		warehouseID = "17b79680-4657-4ef4-9c3d-554a83c31828"
+		productID   = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+	)
+
+	price := domain.Money(12050)
+	sale := 5
+
+	cases := []struct {
+		Name         string
+		Method       string
+		Body         string
+		WantRequest  *dto.ChangeProductPriceRequest
+		ReturnError  error
+		StatusCode   int
+		ResponseBody string
+	}{
+		{
+			Name:   "Success",
+			Method: http.MethodPost,
+			Body:   `{"warehouse_id":"` + warehouseID + `","product_id":"` + productID + `","product_price":120.50,"discount":5,"changed_by":"manager"}`,
+			WantRequest: &dto.ChangeProductPriceRequest{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				Price:       &price,
+				Sale:        &sale,
+				ChangedBy:   "manager",
+			},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"product_id":"` + productID + `","old_price":100.00,"new_price":120.50,"old_discount":0,"new_discount":5,"changed_by":"manager"}`,
+		},
+		{
+			Name:         "Wrong method",
+			Method:       http.MethodGet,
+			StatusCode:   http.StatusMethodNotAllowed,
+			ResponseBody: ``,
+		},
+		{
+			Name:         "Wrong body",
+			Method:       http.MethodPost,
+			Body:         `{"product_price":`,
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"error":"cannot parse JSON"}`,
+		},
+		{
+			Name:         "Empty fields",
+			Method:       http.MethodPost,
+			Body:         `{}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"product_id":"this field cannot be empty","warehouse_id":"this field cannot be empty","product_price":"this field cannot be empty"}`,
+		},
+		{
+			Name:         "Wrong fields",
+			Method:       http.MethodPost,
+			Body:         `{"warehouse_id":"warehouse","product_id":"product","product_price":-1,"discount":101,"changed_by":"` + strings.Repeat("a", maxChangedByLength+1) + `"}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"product_id":"invalid product ID","warehouse_id":"invalid warehouse ID","product_price":"invalid product price","discount":"discount must be between 0 and 100","changed_by":"changed_by must be at most 128 characters"}`,
+		},
+		{
+			Name:   "Not found",
+			Method: http.MethodPost,
+			Body:   `{"warehouse_id":"` + warehouseID + `","product_id":"` + productID + `","product_price":120.50}`,
+			WantRequest: &dto.ChangeProductPriceRequest{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				Price:       &price,
+			},
+			ReturnError:  custErr.ErrInventoryNotFound,
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"error":"there is no information about this product on warehouse"}`,
+		},
+		{
+			Name:   "Service error",
+			Method: http.MethodPost,
+			Body:   `{"warehouse_id":"` + warehouseID + `","product_id":"` + productID + `","product_price":120.50}`,
+			WantRequest: &dto.ChangeProductPriceRequest{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				Price:       &price,
+			},
+			ReturnError:  errors.New("internal server error"),
+			StatusCode:   http.StatusInternalServerError,
+			ResponseBody: `{"error":"error while changing product price"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockService := NewMockInventoryService(t)
+			if tc.WantRequest != nil {
+				var response *dto.PriceChangeResponse
+				if tc.ReturnError == nil {
+					response = &dto.PriceChangeResponse{
+						ProductID: productID,
+						OldPrice:  10000,
+						NewPrice:  price,
+						NewSale:   sale,
+						ChangedBy: "manager",
+					}
+				}
+				mockService.On("ChangeProductPrice", mock.Anything, tc.WantRequest).
+					Return(response, tc.ReturnError).
+					Once()
+			}
+
+			logger.CreateNOPLogger()
+
+			handler := NewInventoryHandler(mockService)
+			req := httptest.NewRequest(tc.Method, "/api/inventory/change_price", strings.NewReader(tc.Body))
+
+			rr := httptest.NewRecorder()
+
+			handler.ChangeProductPrice(rr, req)
+			require.Equal(t, tc.StatusCode, rr.Code)
+
+			if tc.ResponseBody == "" {
+				assert.Empty(t, rr.Body.String())
+			} else {
+				assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -40,123 +40,129 @@ func (_m *MockAnalyticsService) EXPECT() *MockAnalyticsService_Expecter {
 	return &MockAnalyticsService_Expecter{mock: &_m.Mock}
 }
 
-// GetTopWarehouses provides a mock function for the type MockAnalyticsService
-func (_mock *MockAnalyticsService) GetTopWarehouses(ctx context.Context, limit int) ([]*dto.WarehouseAnalyticsAtListResponse, error) {
-	ret := _mock.Called(ctx, limit)
+// GetProductAnalytics provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) GetProductAnalytics(ctx context.Context, productID uuid.UUID, period *dto.AnalyticsPeriod) (*dto.ProductAnalyticsResponse, error) {
+	ret := _mock.Called(ctx, productID, period)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTopWarehouses")
+		panic("no return value specified for GetProductAnalytics")
 	}
 
-	var r0 []*dto.WarehouseAnalyticsAtListResponse
+	var r0 *dto.ProductAnalyticsResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*dto.WarehouseAnalyticsAtListResponse, error)); ok {
-		return returnFunc(ctx, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.AnalyticsPeriod) (*dto.ProductAnalyticsResponse, error)); ok {
+		return returnFunc(ctx, productID, period)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*dto.WarehouseAnalyticsAtListResponse); ok {
-		r0 = returnFunc(ctx, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.AnalyticsPeriod) *dto.ProductAnalyticsResponse); ok {
+		r0 = returnFunc(ctx, productID, period)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dto.WarehouseAnalyticsAtListResponse)
+			r0 = ret.Get(0).(*dto.ProductAnalyticsResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = returnFunc(ctx, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.AnalyticsPeriod) error); ok {
+		r1 = returnFunc(ctx, productID, period)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAnalyticsService_GetTopWarehouses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopWarehouses'
-type MockAnalyticsService_GetTopWarehouses_Call struct {
+// MockAnalyticsService_GetProductAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductAnalytics'
+type MockAnalyticsService_GetProductAnalytics_Call struct {
 	*mock.Call
 }
 
-// GetTopWarehouses is a helper method to define mock.On call
+// GetProductAnalytics is a helper method to define mock.On call
 //   - ctx context.Context
-//   - limit int
-func (_e *MockAnalyticsService_Expecter) GetTopWarehouses(ctx interface{}, limit interface{}) *MockAnalyticsService_GetTopWarehouses_Call {
-	return &MockAnalyticsService_GetTopWarehouses_Call{Call: _e.mock.On("GetTopWarehouses", ctx, limit)}
+//   - productID uuid.UUID
+//   - period *dto.AnalyticsPeriod
+func (_e *MockAnalyticsService_Expecter) GetProductAnalytics(ctx interface{}, productID interface{}, period interface{}) *MockAnalyticsService_GetProductAnalytics_Call {
+	return &MockAnalyticsService_GetProductAnalytics_Call{Call: _e.mock.On("GetProductAnalytics", ctx, productID, period)}
 }
 
-func (_c *MockAnalyticsService_GetTopWarehouses_Call) Run(run func(ctx context.Context, limit int)) *MockAnalyticsService_GetTopWarehouses_Call {
+func (_c *MockAnalyticsService_GetProductAnalytics_Call) Run(run func(ctx context.Context, productID uuid.UUID, period *dto.AnalyticsPeriod)) *MockAnalyticsService_GetProductAnalytics_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *dto.AnalyticsPeriod
+		if args[2] != nil {
+			arg2 = args[2].(*dto.AnalyticsPeriod)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAnalyticsService_GetTopWarehouses_Call) Return(warehouseAnalyticsAtListResponses []*dto.WarehouseAnalyticsAtListResponse, err error) *MockAnalyticsService_GetTopWarehouses_Call {
-	_c.Call.Return(warehouseAnalyticsAtListResponses, err)
+func (_c *MockAnalyticsService_GetProductAnalytics_Call) Return(productAnalyticsResponse *dto.ProductAnalyticsResponse, err error) *MockAnalyticsService_GetProductAnalytics_Call {
+	_c.Call.Return(productAnalyticsResponse, err)
 	return _c
 }
 
-func (_c *MockAnalyticsService_GetTopWarehouses_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]*dto.WarehouseAnalyticsAtListResponse, error)) *MockAnalyticsService_GetTopWarehouses_Call {
+func (_c *MockAnalyticsService_GetProductAnalytics_Call) RunAndReturn(run func(ctx context.Context, productID uuid.UUID, period *dto.AnalyticsPeriod) (*dto.ProductAnalyticsResponse, error)) *MockAnalyticsService_GetProductAnalytics_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWarehouseAnalytics provides a mock function for the type MockAnalyticsService
-func (_mock *MockAnalyticsService) GetWarehouseAnalytics(ctx context.Context, warehouseID string) (*dto.WarehouseAnalyticsResponse, error) {
-	ret := _mock.Called(ctx, warehouseID)
+// GetSalesTimeSeries provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) GetSalesTimeSeries(ctx context.Context, filter *dto.SalesTimeSeriesFilter) (*dto.SalesTimeSeriesResponse, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWarehouseAnalytics")
+		panic("no return value specified for GetSalesTimeSeries")
 	}
 
-	var r0 *dto.WarehouseAnalyticsResponse
+	var r0 *dto.SalesTimeSeriesResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*dto.WarehouseAnalyticsResponse, error)); ok {
-		return returnFunc(ctx, warehouseID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.SalesTimeSeriesFilter) (*dto.SalesTimeSeriesResponse, error)); ok {
+		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *dto.WarehouseAnalyticsResponse); ok {
-		r0 = returnFunc(ctx, warehouseID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.SalesTimeSeriesFilter) *dto.SalesTimeSeriesResponse); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.WarehouseAnalyticsResponse)
+			r0 = ret.Get(0).(*dto.SalesTimeSeriesResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, warehouseID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.SalesTimeSeriesFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAnalyticsService_GetWarehouseAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWarehouseAnalytics'
-type MockAnalyticsService_GetWarehouseAnalytics_Call struct {
+// MockAnalyticsService_GetSalesTimeSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSalesTimeSeries'
+type MockAnalyticsService_GetSalesTimeSeries_Call struct {
 	*mock.Call
 }
 
-// GetWarehouseAnalytics is a helper method to define mock.On call
+// GetSalesTimeSeries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - warehouseID string
-func (_e *MockAnalyticsService_Expecter) GetWarehouseAnalytics(ctx interface{}, warehouseID interface{}) *MockAnalyticsService_GetWarehouseAnalytics_Call {
-	return &MockAnalyticsService_GetWarehouseAnalytics_Call{Call: _e.mock.On("GetWarehouseAnalytics", ctx, warehouseID)}
+//   - filter *dto.SalesTimeSeriesFilter
+func (_e *MockAnalyticsService_Expecter) GetSalesTimeSeries(ctx interface{}, filter interface{}) *MockAnalyticsService_GetSalesTimeSeries_Call {
+	return &MockAnalyticsService_GetSalesTimeSeries_Call{Call: _e.mock.On("GetSalesTimeSeries", ctx, filter)}
 }
 
-func (_c *MockAnalyticsService_GetWarehouseAnalytics_Call) Run(run func(ctx context.Context, warehouseID string)) *MockAnalyticsService_GetWarehouseAnalytics_Call {
+func (_c *MockAnalyticsService_GetSalesTimeSeries_Call) Run(run func(ctx context.Context, filter *dto.SalesTimeSeriesFilter)) *MockAnalyticsService_GetSalesTimeSeries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *dto.SalesTimeSeriesFilter
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*dto.SalesTimeSeriesFilter)
 		}
 		run(
 			arg0,
@@ -166,82 +172,65 @@ func (_c *MockAnalyticsService_GetWarehouseAnalytics_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockAnalyticsService_GetWarehouseAnalytics_Call) Return(warehouseAnalyticsResponse *dto.WarehouseAnalyticsResponse, err error) *MockAnalyticsService_GetWarehouseAnalytics_Call {
-	_c.Call.Return(warehouseAnalyticsResponse, err)
+func (_c *MockAnalyticsService_GetSalesTimeSeries_Call) Return(salesTimeSeriesResponse *dto.SalesTimeSeriesResponse, err error) *MockAnalyticsService_GetSalesTimeSeries_Call {
+	_c.Call.Return(salesTimeSeriesResponse, err)
 	return _c
 }
 
-func (_c *MockAnalyticsService_GetWarehouseAnalytics_Call) RunAndReturn(run func(ctx context.Context, warehouseID string) (*dto.WarehouseAnalyticsResponse, error)) *MockAnalyticsService_GetWarehouseAnalytics_Call {
+func (_c *MockAnalyticsService_GetSalesTimeSeries_Call) RunAndReturn(run func(ctx context.Context, filter *dto.SalesTimeSeriesFilter) (*dto.SalesTimeSeriesResponse, error)) *MockAnalyticsService_GetSalesTimeSeries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockInventoryService creates a new instance of MockInventoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockInventoryService(t interface {
-	mock.TestingT
-	Cleanup(func())
-},
-) *MockInventoryService {
-	mock := &MockInventoryService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockInventoryService is an autogenerated mock type for the InventoryService type
-type MockInventoryService struct {
-	mock.Mock
-}
-
-type MockInventoryService_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockInventoryService) EXPECT() *MockInventoryService_Expecter {
-	return &MockInventoryService_Expecter{mock: &_m.Mock}
-}
-
-// AddDiscountToProduct provides a mock function for the type MockInventoryService
-func (_mock *MockInventoryService) AddDiscountToProduct(ctx context.Context, request *dto.DiscountToProductRequest) error {
-	ret := _mock.Called(ctx, request)
+// GetTopProducts provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) GetTopProducts(ctx context.Context, filter *dto.TopProductsFilter) ([]*dto.TopProductResponse, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddDiscountToProduct")
+		panic("no return value specified for GetTopProducts")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.DiscountToProductRequest) error); ok {
-		r0 = returnFunc(ctx, request)
+	var r0 []*dto.TopProductResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TopProductsFilter) ([]*dto.TopProductResponse, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TopProductsFilter) []*dto.TopProductResponse); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.TopProductResponse)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TopProductsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockInventoryService_AddDiscountToProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDiscountToProduct'
-type MockInventoryService_AddDiscountToProduct_Call struct {
+// MockAnalyticsService_GetTopProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopProducts'
+type MockAnalyticsService_GetTopProducts_Call struct {
 	*mock.Call
 }
 
-// AddDiscountToProduct is a helper method to define mock.On call
+// GetTopProducts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dto.DiscountToProductRequest
-func (_e *MockInventoryService_Expecter) AddDiscountToProduct(ctx interface{}, request interface{}) *MockInventoryService_AddDiscountToProduct_Call {
-	return &MockInventoryService_AddDiscountToProduct_Call{Call: _e.mock.On("AddDiscountToProduct", ctx, request)}
+//   - filter *dto.TopProductsFilter
+func (_e *MockAnalyticsService_Expecter) GetTopProducts(ctx interface{}, filter interface{}) *MockAnalyticsService_GetTopProducts_Call {
+	return &MockAnalyticsService_GetTopProducts_Call{Call: _e.mock.On("GetTopProducts", ctx, filter)}
 }
 
-func (_c *MockInventoryService_AddDiscountToProduct_Call) Run(run func(ctx context.Context, request *dto.DiscountToProductRequest)) *MockInventoryService_AddDiscountToProduct_Call {
+func (_c *MockAnalyticsService_GetTopProducts_Call) Run(run func(ctx context.Context, filter *dto.TopProductsFilter)) *MockAnalyticsService_GetTopProducts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.DiscountToProductRequest
+		var arg1 *dto.TopProductsFilter
 		if args[1] != nil {
-			arg1 = args[1].(*dto.DiscountToProductRequest)
+			arg1 = args[1].(*dto.TopProductsFilter)
 		}
 		run(
 			arg0,
@@ -251,219 +240,276 @@ func (_c *MockInventoryService_AddDiscountToProduct_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockInventoryService_AddDiscountToProduct_Call) Return(err error) *MockInventoryService_AddDiscountToProduct_Call {
-	_c.Call.Return(err)
+func (_c *MockAnalyticsService_GetTopProducts_Call) Return(topProductResponses []*dto.TopProductResponse, err error) *MockAnalyticsService_GetTopProducts_Call {
+	_c.Call.Return(topProductResponses, err)
 	return _c
 }
 
-func (_c *MockInventoryService_AddDiscountToProduct_Call) RunAndReturn(run func(ctx context.Context, request *dto.DiscountToProductRequest) error) *MockInventoryService_AddDiscountToProduct_Call {
+func (_c *MockAnalyticsService_GetTopProducts_Call) RunAndReturn(run func(ctx context.Context, filter *dto.TopProductsFilter) ([]*dto.TopProductResponse, error)) *MockAnalyticsService_GetTopProducts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// BuyProducts provides a mock function for the type MockInventoryService
-func (_mock *MockInventoryService) BuyProducts(ctx context.Context, request *dto.CartRequest) (*dto.CartResponse, error) {
-	ret := _mock.Called(ctx, request)
+// GetTopWarehouses provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) GetTopWarehouses(ctx context.Context, limit int, period *dto.AnalyticsPeriod) ([]*dto.WarehouseAnalyticsAtListResponse, error) {
+	ret := _mock.Called(ctx, limit, period)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BuyProducts")
+		panic("no return value specified for GetTopWarehouses")
 	}
 
-	var r0 *dto.CartResponse
+	var r0 []*dto.WarehouseAnalyticsAtListResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.CartRequest) (*dto.CartResponse, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, *dto.AnalyticsPeriod) ([]*dto.WarehouseAnalyticsAtListResponse, error)); ok {
+		return returnFunc(ctx, limit, period)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.CartRequest) *dto.CartResponse); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, *dto.AnalyticsPeriod) []*dto.WarehouseAnalyticsAtListResponse); ok {
+		r0 = returnFunc(ctx, limit, period)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.CartResponse)
+			r0 = ret.Get(0).([]*dto.WarehouseAnalyticsAtListResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.CartRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, *dto.AnalyticsPeriod) error); ok {
+		r1 = returnFunc(ctx, limit, period)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockInventoryService_BuyProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuyProducts'
-type MockInventoryService_BuyProducts_Call struct {
+// MockAnalyticsService_GetTopWarehouses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopWarehouses'
+type MockAnalyticsService_GetTopWarehouses_Call struct {
 	*mock.Call
 }
 
-// BuyProducts is a helper method to define mock.On call
+// GetTopWarehouses is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dto.CartRequest
-func (_e *MockInventoryService_Expecter) BuyProducts(ctx interface{}, request interface{}) *MockInventoryService_BuyProducts_Call {
-	return &MockInventoryService_BuyProducts_Call{Call: _e.mock.On("BuyProducts", ctx, request)}
+//   - limit int
+//   - period *dto.AnalyticsPeriod
+func (_e *MockAnalyticsService_Expecter) GetTopWarehouses(ctx interface{}, limit interface{}, period interface{}) *MockAnalyticsService_GetTopWarehouses_Call {
+	return &MockAnalyticsService_GetTopWarehouses_Call{Call: _e.mock.On("GetTopWarehouses", ctx, limit, period)}
 }
 
-func (_c *MockInventoryService_BuyProducts_Call) Run(run func(ctx context.Context, request *dto.CartRequest)) *MockInventoryService_BuyProducts_Call {
+func (_c *MockAnalyticsService_GetTopWarehouses_Call) Run(run func(ctx context.Context, limit int, period *dto.AnalyticsPeriod)) *MockAnalyticsService_GetTopWarehouses_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.CartRequest
+		var arg1 int
 		if args[1] != nil {
-			arg1 = args[1].(*dto.CartRequest)
+			arg1 = args[1].(int)
+		}
+		var arg2 *dto.AnalyticsPeriod
+		if args[2] != nil {
+			arg2 = args[2].(*dto.AnalyticsPeriod)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockInventoryService_BuyProducts_Call) Return(cartResponse *dto.CartResponse, err error) *MockInventoryService_BuyProducts_Call {
-	_c.Call.Return(cartResponse, err)
+func (_c *MockAnalyticsService_GetTopWarehouses_Call) Return(warehouseAnalyticsAtListResponses []*dto.WarehouseAnalyticsAtListResponse, err error) *MockAnalyticsService_GetTopWarehouses_Call {
+	_c.Call.Return(warehouseAnalyticsAtListResponses, err)
 	return _c
 }
 
-func (_c *MockInventoryService_BuyProducts_Call) RunAndReturn(run func(ctx context.Context, request *dto.CartRequest) (*dto.CartResponse, error)) *MockInventoryService_BuyProducts_Call {
+func (_c *MockAnalyticsService_GetTopWarehouses_Call) RunAndReturn(run func(ctx context.Context, limit int, period *dto.AnalyticsPeriod) ([]*dto.WarehouseAnalyticsAtListResponse, error)) *MockAnalyticsService_GetTopWarehouses_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CalculateCart provides a mock function for the type MockInventoryService
-func (_mock *MockInventoryService) CalculateCart(ctx context.Context, request *dto.CartRequest) (*dto.CartResponse, error) {
-	ret := _mock.Called(ctx, request)
+// GetWarehouseAnalytics provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) GetWarehouseAnalytics(ctx context.Context, warehouseID string, period *dto.AnalyticsPeriod) (*dto.WarehouseAnalyticsResponse, error) {
+	ret := _mock.Called(ctx, warehouseID, period)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CalculateCart")
+		panic("no return value specified for GetWarehouseAnalytics")
 	}
 
-	var r0 *dto.CartResponse
+	var r0 *dto.WarehouseAnalyticsResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.CartRequest) (*dto.CartResponse, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.AnalyticsPeriod) (*dto.WarehouseAnalyticsResponse, error)); ok {
+		return returnFunc(ctx, warehouseID, period)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.CartRequest) *dto.CartResponse); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.AnalyticsPeriod) *dto.WarehouseAnalyticsResponse); ok {
+		r0 = returnFunc(ctx, warehouseID, period)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.CartResponse)
+			r0 = ret.Get(0).(*dto.WarehouseAnalyticsResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.CartRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.AnalyticsPeriod) error); ok {
+		r1 = returnFunc(ctx, warehouseID, period)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockInventoryService_CalculateCart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CalculateCart'
-type MockInventoryService_CalculateCart_Call struct {
+// MockAnalyticsService_GetWarehouseAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWarehouseAnalytics'
+type MockAnalyticsService_GetWarehouseAnalytics_Call struct {
 	*mock.Call
 }
 
-// CalculateCart is a helper method to define mock.On call
+// GetWarehouseAnalytics is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dto.CartRequest
-func (_e *MockInventoryService_Expecter) CalculateCart(ctx interface{}, request interface{}) *MockInventoryService_CalculateCart_Call {
-	return &MockInventoryService_CalculateCart_Call{Call: _e.mock.On("CalculateCart", ctx, request)}
+//   - warehouseID string
+//   - period *dto.AnalyticsPeriod
+func (_e *MockAnalyticsService_Expecter) GetWarehouseAnalytics(ctx interface{}, warehouseID interface{}, period interface{}) *MockAnalyticsService_GetWarehouseAnalytics_Call {
+	return &MockAnalyticsService_GetWarehouseAnalytics_Call{Call: _e.mock.On("GetWarehouseAnalytics", ctx, warehouseID, period)}
 }
 
-func (_c *MockInventoryService_CalculateCart_Call) Run(run func(ctx context.Context, request *dto.CartRequest)) *MockInventoryService_CalculateCart_Call {
+func (_c *MockAnalyticsService_GetWarehouseAnalytics_Call) Run(run func(ctx context.Context, warehouseID string, period *dto.AnalyticsPeriod)) *MockAnalyticsService_GetWarehouseAnalytics_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.CartRequest
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*dto.CartRequest)
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.AnalyticsPeriod
+		if args[2] != nil {
+			arg2 = args[2].(*dto.AnalyticsPeriod)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockInventoryService_CalculateCart_Call) Return(cartResponse *dto.CartResponse, err error) *MockInventoryService_CalculateCart_Call {
-	_c.Call.Return(cartResponse, err)
+func (_c *MockAnalyticsService_GetWarehouseAnalytics_Call) Return(warehouseAnalyticsResponse *dto.WarehouseAnalyticsResponse, err error) *MockAnalyticsService_GetWarehouseAnalytics_Call {
+	_c.Call.Return(warehouseAnalyticsResponse, err)
 	return _c
 }
 
-func (_c *MockInventoryService_CalculateCart_Call) RunAndReturn(run func(ctx context.Context, request *dto.CartRequest) (*dto.CartResponse, error)) *MockInventoryService_CalculateCart_Call {
+func (_c *MockAnalyticsService_GetWarehouseAnalytics_Call) RunAndReturn(run func(ctx context.Context, warehouseID string, period *dto.AnalyticsPeriod) (*dto.WarehouseAnalyticsResponse, error)) *MockAnalyticsService_GetWarehouseAnalytics_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ChangeProductCount provides a mock function for the type MockInventoryService
-func (_mock *MockInventoryService) ChangeProductCount(ctx context.Context, request *dto.ChangeProductCountRequest) error {
-	ret := _mock.Called(ctx, request)
+// NewMockBackorderService creates a new instance of MockBackorderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBackorderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockBackorderService {
+	mock := &MockBackorderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBackorderService is an autogenerated mock type for the BackorderService type
+type MockBackorderService struct {
+	mock.Mock
+}
+
+type MockBackorderService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBackorderService) EXPECT() *MockBackorderService_Expecter {
+	return &MockBackorderService_Expecter{mock: &_m.Mock}
+}
+
+// GetBackorders provides a mock function for the type MockBackorderService
+func (_mock *MockBackorderService) GetBackorders(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.BackordersResponse, error) {
+	ret := _mock.Called(ctx, params, warehouseID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ChangeProductCount")
+		panic("no return value specified for GetBackorders")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.ChangeProductCountRequest) error); ok {
-		r0 = returnFunc(ctx, request)
+	var r0 *dto.BackordersResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.Pagination, string) (*dto.BackordersResponse, error)); ok {
+		return returnFunc(ctx, params, warehouseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.Pagination, string) *dto.BackordersResponse); ok {
+		r0 = returnFunc(ctx, params, warehouseID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BackordersResponse)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.Pagination, string) error); ok {
+		r1 = returnFunc(ctx, params, warehouseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockInventoryService_ChangeProductCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeProductCount'
-type MockInventoryService_ChangeProductCount_Call struct {
+// MockBackorderService_GetBackorders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBackorders'
+type MockBackorderService_GetBackorders_Call struct {
 	*mock.Call
 }
 
-// ChangeProductCount is a helper method to define mock.On call
+// GetBackorders is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dto.ChangeProductCountRequest
-func (_e *MockInventoryService_Expecter) ChangeProductCount(ctx interface{}, request interface{}) *MockInventoryService_ChangeProductCount_Call {
-	return &MockInventoryService_ChangeProductCount_Call{Call: _e.mock.On("ChangeProductCount", ctx, request)}
+//   - params *dto.Pagination
+//   - warehouseID string
+func (_e *MockBackorderService_Expecter) GetBackorders(ctx interface{}, params interface{}, warehouseID interface{}) *MockBackorderService_GetBackorders_Call {
+	return &MockBackorderService_GetBackorders_Call{Call: _e.mock.On("GetBackorders", ctx, params, warehouseID)}
 }
 
-func (_c *MockInventoryService_ChangeProductCount_Call) Run(run func(ctx context.Context, request *dto.ChangeProductCountRequest)) *MockInventoryService_ChangeProductCount_Call {
+func (_c *MockBackorderService_GetBackorders_Call) Run(run func(ctx context.Context, params *dto.Pagination, warehouseID string)) *MockBackorderService_GetBackorders_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.ChangeProductCountRequest
+		var arg1 *dto.Pagination
 		if args[1] != nil {
-			arg1 = args[1].(*dto.ChangeProductCountRequest)
+			arg1 = args[1].(*dto.Pagination)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockInventoryService_ChangeProductCount_Call) Return(err error) *MockInventoryService_ChangeProductCount_Call {
-	_c.Call.Return(err)
+func (_c *MockBackorderService_GetBackorders_Call) Return(backordersResponse *dto.BackordersResponse, err error) *MockBackorderService_GetBackorders_Call {
+	_c.Call.Return(backordersResponse, err)
 	return _c
 }
 
-func (_c *MockInventoryService_ChangeProductCount_Call) RunAndReturn(run func(ctx context.Context, request *dto.ChangeProductCountRequest) error) *MockInventoryService_ChangeProductCount_Call {
+func (_c *MockBackorderService_GetBackorders_Call) RunAndReturn(run func(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.BackordersResponse, error)) *MockBackorderService_GetBackorders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateInventory provides a mock function for the type MockInventoryService
-func (_mock *MockInventoryService) CreateInventory(ctx context.Context, request *dto.InventoryCreateRequest) error {
+// SetBackorderLimit provides a mock function for the type MockBackorderService
+func (_mock *MockBackorderService) SetBackorderLimit(ctx context.Context, request *dto.BackorderLimitRequest) error {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateInventory")
+		panic("no return value specified for SetBackorderLimit")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.InventoryCreateRequest) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.BackorderLimitRequest) error); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		r0 = ret.Error(0)
@@ -471,27 +517,27 @@ func (_mock *MockInventoryService) CreateInventory(ctx context.Context, request
 	return r0
 }
 
-// MockInventoryService_CreateInventory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInventory'
-type MockInventoryService_CreateInventory_Call struct {
+// MockBackorderService_SetBackorderLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBackorderLimit'
+type MockBackorderService_SetBackorderLimit_Call struct {
 	*mock.Call
 }
 
-// CreateInventory is a helper method to define mock.On call
+// SetBackorderLimit is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dto.InventoryCreateRequest
-func (_e *MockInventoryService_Expecter) CreateInventory(ctx interface{}, request interface{}) *MockInventoryService_CreateInventory_Call {
-	return &MockInventoryService_CreateInventory_Call{Call: _e.mock.On("CreateInventory", ctx, request)}
+//   - request *dto.BackorderLimitRequest
+func (_e *MockBackorderService_Expecter) SetBackorderLimit(ctx interface{}, request interface{}) *MockBackorderService_SetBackorderLimit_Call {
+	return &MockBackorderService_SetBackorderLimit_Call{Call: _e.mock.On("SetBackorderLimit", ctx, request)}
 }
 
-func (_c *MockInventoryService_CreateInventory_Call) Run(run func(ctx context.Context, request *dto.InventoryCreateRequest)) *MockInventoryService_CreateInventory_Call {
+func (_c *MockBackorderService_SetBackorderLimit_Call) Run(run func(ctx context.Context, request *dto.BackorderLimitRequest)) *MockBackorderService_SetBackorderLimit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.InventoryCreateRequest
+		var arg1 *dto.BackorderLimitRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dto.InventoryCreateRequest)
+			arg1 = args[1].(*dto.BackorderLimitRequest)
 		}
 		run(
 			arg0,
@@ -501,132 +547,155 @@ func (_c *MockInventoryService_CreateInventory_Call) Run(run func(ctx context.Co
 	return _c
 }
 
-func (_c *MockInventoryService_CreateInventory_Call) Return(err error) *MockInventoryService_CreateInventory_Call {
+func (_c *MockBackorderService_SetBackorderLimit_Call) Return(err error) *MockBackorderService_SetBackorderLimit_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockInventoryService_CreateInventory_Call) RunAndReturn(run func(ctx context.Context, request *dto.InventoryCreateRequest) error) *MockInventoryService_CreateInventory_Call {
+func (_c *MockBackorderService_SetBackorderLimit_Call) RunAndReturn(run func(ctx context.Context, request *dto.BackorderLimitRequest) error) *MockBackorderService_SetBackorderLimit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProductFromWarehouse provides a mock function for the type MockInventoryService
-func (_mock *MockInventoryService) GetProductFromWarehouse(ctx context.Context, warehouseID string, productID string) (*dto.ProductFromWarehouseResponse, error) {
-	ret := _mock.Called(ctx, warehouseID, productID)
+// NewMockBatchService creates a new instance of MockBatchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBatchService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockBatchService {
+	mock := &MockBatchService{}
+	mock.Mock.Test(t)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetProductFromWarehouse")
-	}
+	t.Cleanup(func() { mock.AssertExpectations(t) })
 
-	var r0 *dto.ProductFromWarehouseResponse
+	return mock
+}
+
+// MockBatchService is an autogenerated mock type for the BatchService type
+type MockBatchService struct {
+	mock.Mock
+}
+
+type MockBatchService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBatchService) EXPECT() *MockBatchService_Expecter {
+	return &MockBatchService_Expecter{mock: &_m.Mock}
+}
+
+// GetBatches provides a mock function for the type MockBatchService
+func (_mock *MockBatchService) GetBatches(ctx context.Context, filter *dto.BatchFilter) (*dto.BatchesResponse, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBatches")
+	}
+
+	var r0 *dto.BatchesResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*dto.ProductFromWarehouseResponse, error)); ok {
-		return returnFunc(ctx, warehouseID, productID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.BatchFilter) (*dto.BatchesResponse, error)); ok {
+		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *dto.ProductFromWarehouseResponse); ok {
-		r0 = returnFunc(ctx, warehouseID, productID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.BatchFilter) *dto.BatchesResponse); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.ProductFromWarehouseResponse)
+			r0 = ret.Get(0).(*dto.BatchesResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, warehouseID, productID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.BatchFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockInventoryService_GetProductFromWarehouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductFromWarehouse'
-type MockInventoryService_GetProductFromWarehouse_Call struct {
+// MockBatchService_GetBatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBatches'
+type MockBatchService_GetBatches_Call struct {
 	*mock.Call
 }
 
-// GetProductFromWarehouse is a helper method to define mock.On call
+// GetBatches is a helper method to define mock.On call
 //   - ctx context.Context
-//   - warehouseID string
-//   - productID string
-func (_e *MockInventoryService_Expecter) GetProductFromWarehouse(ctx interface{}, warehouseID interface{}, productID interface{}) *MockInventoryService_GetProductFromWarehouse_Call {
-	return &MockInventoryService_GetProductFromWarehouse_Call{Call: _e.mock.On("GetProductFromWarehouse", ctx, warehouseID, productID)}
+//   - filter *dto.BatchFilter
+func (_e *MockBatchService_Expecter) GetBatches(ctx interface{}, filter interface{}) *MockBatchService_GetBatches_Call {
+	return &MockBatchService_GetBatches_Call{Call: _e.mock.On("GetBatches", ctx, filter)}
 }
 
-func (_c *MockInventoryService_GetProductFromWarehouse_Call) Run(run func(ctx context.Context, warehouseID string, productID string)) *MockInventoryService_GetProductFromWarehouse_Call {
+func (_c *MockBatchService_GetBatches_Call) Run(run func(ctx context.Context, filter *dto.BatchFilter)) *MockBatchService_GetBatches_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *dto.BatchFilter
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg1 = args[1].(*dto.BatchFilter)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockInventoryService_GetProductFromWarehouse_Call) Return(productFromWarehouseResponse *dto.ProductFromWarehouseResponse, err error) *MockInventoryService_GetProductFromWarehouse_Call {
-	_c.Call.Return(productFromWarehouseResponse, err)
+func (_c *MockBatchService_GetBatches_Call) Return(batchesResponse *dto.BatchesResponse, err error) *MockBatchService_GetBatches_Call {
+	_c.Call.Return(batchesResponse, err)
 	return _c
 }
 
-func (_c *MockInventoryService_GetProductFromWarehouse_Call) RunAndReturn(run func(ctx context.Context, warehouseID string, productID string) (*dto.ProductFromWarehouseResponse, error)) *MockInventoryService_GetProductFromWarehouse_Call {
+func (_c *MockBatchService_GetBatches_Call) RunAndReturn(run func(ctx context.Context, filter *dto.BatchFilter) (*dto.BatchesResponse, error)) *MockBatchService_GetBatches_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProductsAtWarehouse provides a mock function for the type MockInventoryService
-func (_mock *MockInventoryService) GetProductsAtWarehouse(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.ProductsResponse, error) {
-	ret := _mock.Called(ctx, params, warehouseID)
+// GetExpiringBatches provides a mock function for the type MockBatchService
+func (_mock *MockBatchService) GetExpiringBatches(ctx context.Context, params *dto.Pagination, warehouseID string, days int) (*dto.ExpiringBatchesResponse, error) {
+	ret := _mock.Called(ctx, params, warehouseID, days)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProductsAtWarehouse")
+		panic("no return value specified for GetExpiringBatches")
 	}
 
-	var r0 *dto.ProductsResponse
+	var r0 *dto.ExpiringBatchesResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.Pagination, string) (*dto.ProductsResponse, error)); ok {
-		return returnFunc(ctx, params, warehouseID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.Pagination, string, int) (*dto.ExpiringBatchesResponse, error)); ok {
+		return returnFunc(ctx, params, warehouseID, days)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.Pagination, string) *dto.ProductsResponse); ok {
-		r0 = returnFunc(ctx, params, warehouseID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.Pagination, string, int) *dto.ExpiringBatchesResponse); ok {
+		r0 = returnFunc(ctx, params, warehouseID, days)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.ProductsResponse)
+			r0 = ret.Get(0).(*dto.ExpiringBatchesResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.Pagination, string) error); ok {
-		r1 = returnFunc(ctx, params, warehouseID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.Pagination, string, int) error); ok {
+		r1 = returnFunc(ctx, params, warehouseID, days)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockInventoryService_GetProductsAtWarehouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductsAtWarehouse'
-type MockInventoryService_GetProductsAtWarehouse_Call struct {
+// MockBatchService_GetExpiringBatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpiringBatches'
+type MockBatchService_GetExpiringBatches_Call struct {
 	*mock.Call
 }
 
-// GetProductsAtWarehouse is a helper method to define mock.On call
+// GetExpiringBatches is a helper method to define mock.On call
 //   - ctx context.Context
 //   - params *dto.Pagination
 //   - warehouseID string
-func (_e *MockInventoryService_Expecter) GetProductsAtWarehouse(ctx interface{}, params interface{}, warehouseID interface{}) *MockInventoryService_GetProductsAtWarehouse_Call {
-	return &MockInventoryService_GetProductsAtWarehouse_Call{Call: _e.mock.On("GetProductsAtWarehouse", ctx, params, warehouseID)}
+//   - days int
+func (_e *MockBatchService_Expecter) GetExpiringBatches(ctx interface{}, params interface{}, warehouseID interface{}, days interface{}) *MockBatchService_GetExpiringBatches_Call {
+	return &MockBatchService_GetExpiringBatches_Call{Call: _e.mock.On("GetExpiringBatches", ctx, params, warehouseID, days)}
 }
 
-func (_c *MockInventoryService_GetProductsAtWarehouse_Call) Run(run func(ctx context.Context, params *dto.Pagination, warehouseID string)) *MockInventoryService_GetProductsAtWarehouse_Call {
+func (_c *MockBatchService_GetExpiringBatches_Call) Run(run func(ctx context.Context, params *dto.Pagination, warehouseID string, days int)) *MockBatchService_GetExpiringBatches_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -640,33 +709,38 @@ func (_c *MockInventoryService_GetProductsAtWarehouse_Call) Run(run func(ctx con
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockInventoryService_GetProductsAtWarehouse_Call) Return(productsResponse *dto.ProductsResponse, err error) *MockInventoryService_GetProductsAtWarehouse_Call {
-	_c.Call.Return(productsResponse, err)
+func (_c *MockBatchService_GetExpiringBatches_Call) Return(expiringBatchesResponse *dto.ExpiringBatchesResponse, err error) *MockBatchService_GetExpiringBatches_Call {
+	_c.Call.Return(expiringBatchesResponse, err)
 	return _c
 }
 
-func (_c *MockInventoryService_GetProductsAtWarehouse_Call) RunAndReturn(run func(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.ProductsResponse, error)) *MockInventoryService_GetProductsAtWarehouse_Call {
+func (_c *MockBatchService_GetExpiringBatches_Call) RunAndReturn(run func(ctx context.Context, params *dto.Pagination, warehouseID string, days int) (*dto.ExpiringBatchesResponse, error)) *MockBatchService_GetExpiringBatches_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProductService creates a new instance of MockProductService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockDiscountService creates a new instance of MockDiscountService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProductService(t interface {
+func NewMockDiscountService(t interface {
 	mock.TestingT
 	Cleanup(func())
 },
-) *MockProductService {
-	mock := &MockProductService{}
+) *MockDiscountService {
+	mock := &MockDiscountService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -674,57 +748,125 @@ func NewMockProductService(t interface {
 	return mock
 }
 
-// MockProductService is an autogenerated mock type for the ProductService type
-type MockProductService struct {
+// MockDiscountService is an autogenerated mock type for the DiscountService type
+type MockDiscountService struct {
 	mock.Mock
 }
 
-type MockProductService_Expecter struct {
+type MockDiscountService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProductService) EXPECT() *MockProductService_Expecter {
-	return &MockProductService_Expecter{mock: &_m.Mock}
+func (_m *MockDiscountService) EXPECT() *MockDiscountService_Expecter {
+	return &MockDiscountService_Expecter{mock: &_m.Mock}
 }
 
-// AddProduct provides a mock function for the type MockProductService
-func (_mock *MockProductService) AddProduct(ctx context.Context, request *dto.ProductRequest) error {
+// CreateDiscountRule provides a mock function for the type MockDiscountService
+func (_mock *MockDiscountService) CreateDiscountRule(ctx context.Context, request *dto.DiscountRuleRequest) (*dto.DiscountRuleResponse, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddProduct")
+		panic("no return value specified for CreateDiscountRule")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.ProductRequest) error); ok {
+	var r0 *dto.DiscountRuleResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.DiscountRuleRequest) (*dto.DiscountRuleResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.DiscountRuleRequest) *dto.DiscountRuleResponse); ok {
 		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DiscountRuleResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.DiscountRuleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDiscountService_CreateDiscountRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDiscountRule'
+type MockDiscountService_CreateDiscountRule_Call struct {
+	*mock.Call
+}
+
+// CreateDiscountRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.DiscountRuleRequest
+func (_e *MockDiscountService_Expecter) CreateDiscountRule(ctx interface{}, request interface{}) *MockDiscountService_CreateDiscountRule_Call {
+	return &MockDiscountService_CreateDiscountRule_Call{Call: _e.mock.On("CreateDiscountRule", ctx, request)}
+}
+
+func (_c *MockDiscountService_CreateDiscountRule_Call) Run(run func(ctx context.Context, request *dto.DiscountRuleRequest)) *MockDiscountService_CreateDiscountRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.DiscountRuleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.DiscountRuleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDiscountService_CreateDiscountRule_Call) Return(discountRuleResponse *dto.DiscountRuleResponse, err error) *MockDiscountService_CreateDiscountRule_Call {
+	_c.Call.Return(discountRuleResponse, err)
+	return _c
+}
+
+func (_c *MockDiscountService_CreateDiscountRule_Call) RunAndReturn(run func(ctx context.Context, request *dto.DiscountRuleRequest) (*dto.DiscountRuleResponse, error)) *MockDiscountService_CreateDiscountRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDiscountRule provides a mock function for the type MockDiscountService
+func (_mock *MockDiscountService) DeleteDiscountRule(ctx context.Context, discountID uuid.UUID) error {
+	ret := _mock.Called(ctx, discountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDiscountRule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, discountID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockProductService_AddProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProduct'
-type MockProductService_AddProduct_Call struct {
+// MockDiscountService_DeleteDiscountRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDiscountRule'
+type MockDiscountService_DeleteDiscountRule_Call struct {
 	*mock.Call
 }
 
-// AddProduct is a helper method to define mock.On call
+// DeleteDiscountRule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dto.ProductRequest
-func (_e *MockProductService_Expecter) AddProduct(ctx interface{}, request interface{}) *MockProductService_AddProduct_Call {
-	return &MockProductService_AddProduct_Call{Call: _e.mock.On("AddProduct", ctx, request)}
+//   - discountID uuid.UUID
+func (_e *MockDiscountService_Expecter) DeleteDiscountRule(ctx interface{}, discountID interface{}) *MockDiscountService_DeleteDiscountRule_Call {
+	return &MockDiscountService_DeleteDiscountRule_Call{Call: _e.mock.On("DeleteDiscountRule", ctx, discountID)}
 }
 
-func (_c *MockProductService_AddProduct_Call) Run(run func(ctx context.Context, request *dto.ProductRequest)) *MockProductService_AddProduct_Call {
+func (_c *MockDiscountService_DeleteDiscountRule_Call) Run(run func(ctx context.Context, discountID uuid.UUID)) *MockDiscountService_DeleteDiscountRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.ProductRequest
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*dto.ProductRequest)
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
@@ -734,109 +876,194 @@ func (_c *MockProductService_AddProduct_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockProductService_AddProduct_Call) Return(err error) *MockProductService_AddProduct_Call {
+func (_c *MockDiscountService_DeleteDiscountRule_Call) Return(err error) *MockDiscountService_DeleteDiscountRule_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProductService_AddProduct_Call) RunAndReturn(run func(ctx context.Context, request *dto.ProductRequest) error) *MockProductService_AddProduct_Call {
+func (_c *MockDiscountService_DeleteDiscountRule_Call) RunAndReturn(run func(ctx context.Context, discountID uuid.UUID) error) *MockDiscountService_DeleteDiscountRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProducts provides a mock function for the type MockProductService
-func (_mock *MockProductService) GetProducts(ctx context.Context) ([]*dto.ProductAtListResponse, error) {
-	ret := _mock.Called(ctx)
+// GetDiscountRule provides a mock function for the type MockDiscountService
+func (_mock *MockDiscountService) GetDiscountRule(ctx context.Context, discountID uuid.UUID) (*dto.DiscountRuleResponse, error) {
+	ret := _mock.Called(ctx, discountID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProducts")
+		panic("no return value specified for GetDiscountRule")
 	}
 
-	var r0 []*dto.ProductAtListResponse
+	var r0 *dto.DiscountRuleResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*dto.ProductAtListResponse, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.DiscountRuleResponse, error)); ok {
+		return returnFunc(ctx, discountID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*dto.ProductAtListResponse); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.DiscountRuleResponse); ok {
+		r0 = returnFunc(ctx, discountID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dto.ProductAtListResponse)
+			r0 = ret.Get(0).(*dto.DiscountRuleResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, discountID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockProductService_GetProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProducts'
-type MockProductService_GetProducts_Call struct {
+// MockDiscountService_GetDiscountRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiscountRule'
+type MockDiscountService_GetDiscountRule_Call struct {
 	*mock.Call
 }
 
-// GetProducts is a helper method to define mock.On call
+// GetDiscountRule is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockProductService_Expecter) GetProducts(ctx interface{}) *MockProductService_GetProducts_Call {
-	return &MockProductService_GetProducts_Call{Call: _e.mock.On("GetProducts", ctx)}
+//   - discountID uuid.UUID
+func (_e *MockDiscountService_Expecter) GetDiscountRule(ctx interface{}, discountID interface{}) *MockDiscountService_GetDiscountRule_Call {
+	return &MockDiscountService_GetDiscountRule_Call{Call: _e.mock.On("GetDiscountRule", ctx, discountID)}
 }
 
-func (_c *MockProductService_GetProducts_Call) Run(run func(ctx context.Context)) *MockProductService_GetProducts_Call {
+func (_c *MockDiscountService_GetDiscountRule_Call) Run(run func(ctx context.Context, discountID uuid.UUID)) *MockDiscountService_GetDiscountRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockProductService_GetProducts_Call) Return(productAtListResponses []*dto.ProductAtListResponse, err error) *MockProductService_GetProducts_Call {
-	_c.Call.Return(productAtListResponses, err)
+func (_c *MockDiscountService_GetDiscountRule_Call) Return(discountRuleResponse *dto.DiscountRuleResponse, err error) *MockDiscountService_GetDiscountRule_Call {
+	_c.Call.Return(discountRuleResponse, err)
 	return _c
 }
 
-func (_c *MockProductService_GetProducts_Call) RunAndReturn(run func(ctx context.Context) ([]*dto.ProductAtListResponse, error)) *MockProductService_GetProducts_Call {
+func (_c *MockDiscountService_GetDiscountRule_Call) RunAndReturn(run func(ctx context.Context, discountID uuid.UUID) (*dto.DiscountRuleResponse, error)) *MockDiscountService_GetDiscountRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateProduct provides a mock function for the type MockProductService
-func (_mock *MockProductService) UpdateProduct(ctx context.Context, productID uuid.UUID, request *dto.ProductRequest) error {
-	ret := _mock.Called(ctx, productID, request)
+// GetDiscountRules provides a mock function for the type MockDiscountService
+func (_mock *MockDiscountService) GetDiscountRules(ctx context.Context, filter *dto.DiscountRuleFilter) (*dto.DiscountRulesResponse, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateProduct")
+		panic("no return value specified for GetDiscountRules")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.ProductRequest) error); ok {
-		r0 = returnFunc(ctx, productID, request)
+	var r0 *dto.DiscountRulesResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.DiscountRuleFilter) (*dto.DiscountRulesResponse, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.DiscountRuleFilter) *dto.DiscountRulesResponse); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DiscountRulesResponse)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.DiscountRuleFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockProductService_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
-type MockProductService_UpdateProduct_Call struct {
+// MockDiscountService_GetDiscountRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiscountRules'
+type MockDiscountService_GetDiscountRules_Call struct {
 	*mock.Call
 }
 
-// UpdateProduct is a helper method to define mock.On call
+// GetDiscountRules is a helper method to define mock.On call
 //   - ctx context.Context
-//   - productID uuid.UUID
-//   - request *dto.ProductRequest
-func (_e *MockProductService_Expecter) UpdateProduct(ctx interface{}, productID interface{}, request interface{}) *MockProductService_UpdateProduct_Call {
-	return &MockProductService_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, productID, request)}
+//   - filter *dto.DiscountRuleFilter
+func (_e *MockDiscountService_Expecter) GetDiscountRules(ctx interface{}, filter interface{}) *MockDiscountService_GetDiscountRules_Call {
+	return &MockDiscountService_GetDiscountRules_Call{Call: _e.mock.On("GetDiscountRules", ctx, filter)}
 }
 
-func (_c *MockProductService_UpdateProduct_Call) Run(run func(ctx context.Context, productID uuid.UUID, request *dto.ProductRequest)) *MockProductService_UpdateProduct_Call {
+func (_c *MockDiscountService_GetDiscountRules_Call) Run(run func(ctx context.Context, filter *dto.DiscountRuleFilter)) *MockDiscountService_GetDiscountRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.DiscountRuleFilter
+		if args[1] != nil {
+			arg1 = args[1].(*dto.DiscountRuleFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDiscountService_GetDiscountRules_Call) Return(discountRulesResponse *dto.DiscountRulesResponse, err error) *MockDiscountService_GetDiscountRules_Call {
+	_c.Call.Return(discountRulesResponse, err)
+	return _c
+}
+
+func (_c *MockDiscountService_GetDiscountRules_Call) RunAndReturn(run func(ctx context.Context, filter *dto.DiscountRuleFilter) (*dto.DiscountRulesResponse, error)) *MockDiscountService_GetDiscountRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDiscountRule provides a mock function for the type MockDiscountService
+func (_mock *MockDiscountService) UpdateDiscountRule(ctx context.Context, discountID uuid.UUID, request *dto.DiscountRuleRequest) (*dto.DiscountRuleResponse, error) {
+	ret := _mock.Called(ctx, discountID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDiscountRule")
+	}
+
+	var r0 *dto.DiscountRuleResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.DiscountRuleRequest) (*dto.DiscountRuleResponse, error)); ok {
+		return returnFunc(ctx, discountID, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.DiscountRuleRequest) *dto.DiscountRuleResponse); ok {
+		r0 = returnFunc(ctx, discountID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DiscountRuleResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.DiscountRuleRequest) error); ok {
+		r1 = returnFunc(ctx, discountID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDiscountService_UpdateDiscountRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDiscountRule'
+type MockDiscountService_UpdateDiscountRule_Call struct {
+	*mock.Call
+}
+
+// UpdateDiscountRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - discountID uuid.UUID
+//   - request *dto.DiscountRuleRequest
+func (_e *MockDiscountService_Expecter) UpdateDiscountRule(ctx interface{}, discountID interface{}, request interface{}) *MockDiscountService_UpdateDiscountRule_Call {
+	return &MockDiscountService_UpdateDiscountRule_Call{Call: _e.mock.On("UpdateDiscountRule", ctx, discountID, request)}
+}
+
+func (_c *MockDiscountService_UpdateDiscountRule_Call) Run(run func(ctx context.Context, discountID uuid.UUID, request *dto.DiscountRuleRequest)) *MockDiscountService_UpdateDiscountRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -846,9 +1073,9 @@ func (_c *MockProductService_UpdateProduct_Call) Run(run func(ctx context.Contex
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 *dto.ProductRequest
+		var arg2 *dto.DiscountRuleRequest
 		if args[2] != nil {
-			arg2 = args[2].(*dto.ProductRequest)
+			arg2 = args[2].(*dto.DiscountRuleRequest)
 		}
 		run(
 			arg0,
@@ -859,12 +1086,3947 @@ func (_c *MockProductService_UpdateProduct_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockProductService_UpdateProduct_Call) Return(err error) *MockProductService_UpdateProduct_Call {
-	_c.Call.Return(err)
+func (_c *MockDiscountService_UpdateDiscountRule_Call) Return(discountRuleResponse *dto.DiscountRuleResponse, err error) *MockDiscountService_UpdateDiscountRule_Call {
+	_c.Call.Return(discountRuleResponse, err)
 	return _c
 }
 
-func (_c *MockProductService_UpdateProduct_Call) RunAndReturn(run func(ctx context.Context, productID uuid.UUID, request *dto.ProductRequest) error) *MockProductService_UpdateProduct_Call {
+func (_c *MockDiscountService_UpdateDiscountRule_Call) RunAndReturn(run func(ctx context.Context, discountID uuid.UUID, request *dto.DiscountRuleRequest) (*dto.DiscountRuleResponse, error)) *MockDiscountService_UpdateDiscountRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFulfillmentService creates a new instance of MockFulfillmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFulfillmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockFulfillmentService {
+	mock := &MockFulfillmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFulfillmentService is an autogenerated mock type for the FulfillmentService type
+type MockFulfillmentService struct {
+	mock.Mock
+}
+
+type MockFulfillmentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFulfillmentService) EXPECT() *MockFulfillmentService_Expecter {
+	return &MockFulfillmentService_Expecter{mock: &_m.Mock}
+}
+
+// Fulfill provides a mock function for the type MockFulfillmentService
+func (_mock *MockFulfillmentService) Fulfill(ctx context.Context, req *dto.FulfillmentRequest) (*dto.FulfillmentResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fulfill")
+	}
+
+	var r0 *dto.FulfillmentResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.FulfillmentRequest) (*dto.FulfillmentResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.FulfillmentRequest) *dto.FulfillmentResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FulfillmentResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.FulfillmentRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFulfillmentService_Fulfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fulfill'
+type MockFulfillmentService_Fulfill_Call struct {
+	*mock.Call
+}
+
+// Fulfill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.FulfillmentRequest
+func (_e *MockFulfillmentService_Expecter) Fulfill(ctx interface{}, req interface{}) *MockFulfillmentService_Fulfill_Call {
+	return &MockFulfillmentService_Fulfill_Call{Call: _e.mock.On("Fulfill", ctx, req)}
+}
+
+func (_c *MockFulfillmentService_Fulfill_Call) Run(run func(ctx context.Context, req *dto.FulfillmentRequest)) *MockFulfillmentService_Fulfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.FulfillmentRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.FulfillmentRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFulfillmentService_Fulfill_Call) Return(fulfillmentResponse *dto.FulfillmentResponse, err error) *MockFulfillmentService_Fulfill_Call {
+	_c.Call.Return(fulfillmentResponse, err)
+	return _c
+}
+
+func (_c *MockFulfillmentService_Fulfill_Call) RunAndReturn(run func(ctx context.Context, req *dto.FulfillmentRequest) (*dto.FulfillmentResponse, error)) *MockFulfillmentService_Fulfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlanFulfillment provides a mock function for the type MockFulfillmentService
+func (_mock *MockFulfillmentService) PlanFulfillment(ctx context.Context, req *dto.FulfillmentRequest) (*dto.FulfillmentResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanFulfillment")
+	}
+
+	var r0 *dto.FulfillmentResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.FulfillmentRequest) (*dto.FulfillmentResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.FulfillmentRequest) *dto.FulfillmentResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FulfillmentResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.FulfillmentRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFulfillmentService_PlanFulfillment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanFulfillment'
+type MockFulfillmentService_PlanFulfillment_Call struct {
+	*mock.Call
+}
+
+// PlanFulfillment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.FulfillmentRequest
+func (_e *MockFulfillmentService_Expecter) PlanFulfillment(ctx interface{}, req interface{}) *MockFulfillmentService_PlanFulfillment_Call {
+	return &MockFulfillmentService_PlanFulfillment_Call{Call: _e.mock.On("PlanFulfillment", ctx, req)}
+}
+
+func (_c *MockFulfillmentService_PlanFulfillment_Call) Run(run func(ctx context.Context, req *dto.FulfillmentRequest)) *MockFulfillmentService_PlanFulfillment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.FulfillmentRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.FulfillmentRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFulfillmentService_PlanFulfillment_Call) Return(fulfillmentResponse *dto.FulfillmentResponse, err error) *MockFulfillmentService_PlanFulfillment_Call {
+	_c.Call.Return(fulfillmentResponse, err)
+	return _c
+}
+
+func (_c *MockFulfillmentService_PlanFulfillment_Call) RunAndReturn(run func(ctx context.Context, req *dto.FulfillmentRequest) (*dto.FulfillmentResponse, error)) *MockFulfillmentService_PlanFulfillment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockInventoryService creates a new instance of MockInventoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInventoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockInventoryService {
+	mock := &MockInventoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockInventoryService is an autogenerated mock type for the InventoryService type
+type MockInventoryService struct {
+	mock.Mock
+}
+
+type MockInventoryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInventoryService) EXPECT() *MockInventoryService_Expecter {
+	return &MockInventoryService_Expecter{mock: &_m.Mock}
+}
+
+// AddDiscountToProduct provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) AddDiscountToProduct(ctx context.Context, request *dto.DiscountToProductRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDiscountToProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.DiscountToProductRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryService_AddDiscountToProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDiscountToProduct'
+type MockInventoryService_AddDiscountToProduct_Call struct {
+	*mock.Call
+}
+
+// AddDiscountToProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.DiscountToProductRequest
+func (_e *MockInventoryService_Expecter) AddDiscountToProduct(ctx interface{}, request interface{}) *MockInventoryService_AddDiscountToProduct_Call {
+	return &MockInventoryService_AddDiscountToProduct_Call{Call: _e.mock.On("AddDiscountToProduct", ctx, request)}
+}
+
+func (_c *MockInventoryService_AddDiscountToProduct_Call) Run(run func(ctx context.Context, request *dto.DiscountToProductRequest)) *MockInventoryService_AddDiscountToProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.DiscountToProductRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.DiscountToProductRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_AddDiscountToProduct_Call) Return(err error) *MockInventoryService_AddDiscountToProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryService_AddDiscountToProduct_Call) RunAndReturn(run func(ctx context.Context, request *dto.DiscountToProductRequest) error) *MockInventoryService_AddDiscountToProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuyProducts provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) BuyProducts(ctx context.Context, request *dto.CartRequest) (*dto.CartResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuyProducts")
+	}
+
+	var r0 *dto.CartResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.CartRequest) (*dto.CartResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.CartRequest) *dto.CartResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CartResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.CartRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInventoryService_BuyProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuyProducts'
+type MockInventoryService_BuyProducts_Call struct {
+	*mock.Call
+}
+
+// BuyProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.CartRequest
+func (_e *MockInventoryService_Expecter) BuyProducts(ctx interface{}, request interface{}) *MockInventoryService_BuyProducts_Call {
+	return &MockInventoryService_BuyProducts_Call{Call: _e.mock.On("BuyProducts", ctx, request)}
+}
+
+func (_c *MockInventoryService_BuyProducts_Call) Run(run func(ctx context.Context, request *dto.CartRequest)) *MockInventoryService_BuyProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.CartRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.CartRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_BuyProducts_Call) Return(cartResponse *dto.CartResponse, err error) *MockInventoryService_BuyProducts_Call {
+	_c.Call.Return(cartResponse, err)
+	return _c
+}
+
+func (_c *MockInventoryService_BuyProducts_Call) RunAndReturn(run func(ctx context.Context, request *dto.CartRequest) (*dto.CartResponse, error)) *MockInventoryService_BuyProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CalculateCart provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) CalculateCart(ctx context.Context, request *dto.CartRequest) (*dto.CartResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CalculateCart")
+	}
+
+	var r0 *dto.CartResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.CartRequest) (*dto.CartResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.CartRequest) *dto.CartResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CartResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.CartRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInventoryService_CalculateCart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CalculateCart'
+type MockInventoryService_CalculateCart_Call struct {
+	*mock.Call
+}
+
+// CalculateCart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.CartRequest
+func (_e *MockInventoryService_Expecter) CalculateCart(ctx interface{}, request interface{}) *MockInventoryService_CalculateCart_Call {
+	return &MockInventoryService_CalculateCart_Call{Call: _e.mock.On("CalculateCart", ctx, request)}
+}
+
+func (_c *MockInventoryService_CalculateCart_Call) Run(run func(ctx context.Context, request *dto.CartRequest)) *MockInventoryService_CalculateCart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.CartRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.CartRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_CalculateCart_Call) Return(cartResponse *dto.CartResponse, err error) *MockInventoryService_CalculateCart_Call {
+	_c.Call.Return(cartResponse, err)
+	return _c
+}
+
+func (_c *MockInventoryService_CalculateCart_Call) RunAndReturn(run func(ctx context.Context, request *dto.CartRequest) (*dto.CartResponse, error)) *MockInventoryService_CalculateCart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeProductCount provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) ChangeProductCount(ctx context.Context, request *dto.ChangeProductCountRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeProductCount")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.ChangeProductCountRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryService_ChangeProductCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeProductCount'
+type MockInventoryService_ChangeProductCount_Call struct {
+	*mock.Call
+}
+
+// ChangeProductCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.ChangeProductCountRequest
+func (_e *MockInventoryService_Expecter) ChangeProductCount(ctx interface{}, request interface{}) *MockInventoryService_ChangeProductCount_Call {
+	return &MockInventoryService_ChangeProductCount_Call{Call: _e.mock.On("ChangeProductCount", ctx, request)}
+}
+
+func (_c *MockInventoryService_ChangeProductCount_Call) Run(run func(ctx context.Context, request *dto.ChangeProductCountRequest)) *MockInventoryService_ChangeProductCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.ChangeProductCountRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.ChangeProductCountRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_ChangeProductCount_Call) Return(err error) *MockInventoryService_ChangeProductCount_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryService_ChangeProductCount_Call) RunAndReturn(run func(ctx context.Context, request *dto.ChangeProductCountRequest) error) *MockInventoryService_ChangeProductCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeProductPrice provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) ChangeProductPrice(ctx context.Context, request *dto.ChangeProductPriceRequest) (*dto.PriceChangeResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeProductPrice")
+	}
+
+	var r0 *dto.PriceChangeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.ChangeProductPriceRequest) (*dto.PriceChangeResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.ChangeProductPriceRequest) *dto.PriceChangeResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PriceChangeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.ChangeProductPriceRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInventoryService_ChangeProductPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeProductPrice'
+type MockInventoryService_ChangeProductPrice_Call struct {
+	*mock.Call
+}
+
+// ChangeProductPrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.ChangeProductPriceRequest
+func (_e *MockInventoryService_Expecter) ChangeProductPrice(ctx interface{}, request interface{}) *MockInventoryService_ChangeProductPrice_Call {
+	return &MockInventoryService_ChangeProductPrice_Call{Call: _e.mock.On("ChangeProductPrice", ctx, request)}
+}
+
+func (_c *MockInventoryService_ChangeProductPrice_Call) Run(run func(ctx context.Context, request *dto.ChangeProductPriceRequest)) *MockInventoryService_ChangeProductPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.ChangeProductPriceRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.ChangeProductPriceRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_ChangeProductPrice_Call) Return(priceChangeResponse *dto.PriceChangeResponse, err error) *MockInventoryService_ChangeProductPrice_Call {
+	_c.Call.Return(priceChangeResponse, err)
+	return _c
+}
+
+func (_c *MockInventoryService_ChangeProductPrice_Call) RunAndReturn(run func(ctx context.Context, request *dto.ChangeProductPriceRequest) (*dto.PriceChangeResponse, error)) *MockInventoryService_ChangeProductPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInventory provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) CreateInventory(ctx context.Context, request *dto.InventoryCreateRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInventory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.InventoryCreateRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryService_CreateInventory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInventory'
+type MockInventoryService_CreateInventory_Call struct {
+	*mock.Call
+}
+
+// CreateInventory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.InventoryCreateRequest
+func (_e *MockInventoryService_Expecter) CreateInventory(ctx interface{}, request interface{}) *MockInventoryService_CreateInventory_Call {
+	return &MockInventoryService_CreateInventory_Call{Call: _e.mock.On("CreateInventory", ctx, request)}
+}
+
+func (_c *MockInventoryService_CreateInventory_Call) Run(run func(ctx context.Context, request *dto.InventoryCreateRequest)) *MockInventoryService_CreateInventory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.InventoryCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.InventoryCreateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_CreateInventory_Call) Return(err error) *MockInventoryService_CreateInventory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryService_CreateInventory_Call) RunAndReturn(run func(ctx context.Context, request *dto.InventoryCreateRequest) error) *MockInventoryService_CreateInventory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLowStockProducts provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) GetLowStockProducts(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.LowStockResponse, error) {
+	ret := _mock.Called(ctx, params, warehouseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLowStockProducts")
+	}
+
+	var r0 *dto.LowStockResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.Pagination, string) (*dto.LowStockResponse, error)); ok {
+		return returnFunc(ctx, params, warehouseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.Pagination, string) *dto.LowStockResponse); ok {
+		r0 = returnFunc(ctx, params, warehouseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LowStockResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.Pagination, string) error); ok {
+		r1 = returnFunc(ctx, params, warehouseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInventoryService_GetLowStockProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLowStockProducts'
+type MockInventoryService_GetLowStockProducts_Call struct {
+	*mock.Call
+}
+
+// GetLowStockProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *dto.Pagination
+//   - warehouseID string
+func (_e *MockInventoryService_Expecter) GetLowStockProducts(ctx interface{}, params interface{}, warehouseID interface{}) *MockInventoryService_GetLowStockProducts_Call {
+	return &MockInventoryService_GetLowStockProducts_Call{Call: _e.mock.On("GetLowStockProducts", ctx, params, warehouseID)}
+}
+
+func (_c *MockInventoryService_GetLowStockProducts_Call) Run(run func(ctx context.Context, params *dto.Pagination, warehouseID string)) *MockInventoryService_GetLowStockProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.Pagination
+		if args[1] != nil {
+			arg1 = args[1].(*dto.Pagination)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_GetLowStockProducts_Call) Return(lowStockResponse *dto.LowStockResponse, err error) *MockInventoryService_GetLowStockProducts_Call {
+	_c.Call.Return(lowStockResponse, err)
+	return _c
+}
+
+func (_c *MockInventoryService_GetLowStockProducts_Call) RunAndReturn(run func(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.LowStockResponse, error)) *MockInventoryService_GetLowStockProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductFromWarehouse provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) GetProductFromWarehouse(ctx context.Context, warehouseID string, productID string) (*dto.ProductFromWarehouseResponse, error) {
+	ret := _mock.Called(ctx, warehouseID, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductFromWarehouse")
+	}
+
+	var r0 *dto.ProductFromWarehouseResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*dto.ProductFromWarehouseResponse, error)); ok {
+		return returnFunc(ctx, warehouseID, productID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *dto.ProductFromWarehouseResponse); ok {
+		r0 = returnFunc(ctx, warehouseID, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProductFromWarehouseResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, warehouseID, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInventoryService_GetProductFromWarehouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductFromWarehouse'
+type MockInventoryService_GetProductFromWarehouse_Call struct {
+	*mock.Call
+}
+
+// GetProductFromWarehouse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - warehouseID string
+//   - productID string
+func (_e *MockInventoryService_Expecter) GetProductFromWarehouse(ctx interface{}, warehouseID interface{}, productID interface{}) *MockInventoryService_GetProductFromWarehouse_Call {
+	return &MockInventoryService_GetProductFromWarehouse_Call{Call: _e.mock.On("GetProductFromWarehouse", ctx, warehouseID, productID)}
+}
+
+func (_c *MockInventoryService_GetProductFromWarehouse_Call) Run(run func(ctx context.Context, warehouseID string, productID string)) *MockInventoryService_GetProductFromWarehouse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_GetProductFromWarehouse_Call) Return(productFromWarehouseResponse *dto.ProductFromWarehouseResponse, err error) *MockInventoryService_GetProductFromWarehouse_Call {
+	_c.Call.Return(productFromWarehouseResponse, err)
+	return _c
+}
+
+func (_c *MockInventoryService_GetProductFromWarehouse_Call) RunAndReturn(run func(ctx context.Context, warehouseID string, productID string) (*dto.ProductFromWarehouseResponse, error)) *MockInventoryService_GetProductFromWarehouse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductsAtWarehouse provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) GetProductsAtWarehouse(ctx context.Context, filter *dto.StockFilter) (*dto.ProductsResponse, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductsAtWarehouse")
+	}
+
+	var r0 *dto.ProductsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.StockFilter) (*dto.ProductsResponse, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.StockFilter) *dto.ProductsResponse); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProductsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.StockFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInventoryService_GetProductsAtWarehouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductsAtWarehouse'
+type MockInventoryService_GetProductsAtWarehouse_Call struct {
+	*mock.Call
+}
+
+// GetProductsAtWarehouse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *dto.StockFilter
+func (_e *MockInventoryService_Expecter) GetProductsAtWarehouse(ctx interface{}, filter interface{}) *MockInventoryService_GetProductsAtWarehouse_Call {
+	return &MockInventoryService_GetProductsAtWarehouse_Call{Call: _e.mock.On("GetProductsAtWarehouse", ctx, filter)}
+}
+
+func (_c *MockInventoryService_GetProductsAtWarehouse_Call) Run(run func(ctx context.Context, filter *dto.StockFilter)) *MockInventoryService_GetProductsAtWarehouse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.StockFilter
+		if args[1] != nil {
+			arg1 = args[1].(*dto.StockFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_GetProductsAtWarehouse_Call) Return(productsResponse *dto.ProductsResponse, err error) *MockInventoryService_GetProductsAtWarehouse_Call {
+	_c.Call.Return(productsResponse, err)
+	return _c
+}
+
+func (_c *MockInventoryService_GetProductsAtWarehouse_Call) RunAndReturn(run func(ctx context.Context, filter *dto.StockFilter) (*dto.ProductsResponse, error)) *MockInventoryService_GetProductsAtWarehouse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveBatch provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) ReceiveBatch(ctx context.Context, request *dto.BatchRequest) (*dto.BatchResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveBatch")
+	}
+
+	var r0 *dto.BatchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.BatchRequest) (*dto.BatchResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.BatchRequest) *dto.BatchResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BatchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.BatchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInventoryService_ReceiveBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveBatch'
+type MockInventoryService_ReceiveBatch_Call struct {
+	*mock.Call
+}
+
+// ReceiveBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.BatchRequest
+func (_e *MockInventoryService_Expecter) ReceiveBatch(ctx interface{}, request interface{}) *MockInventoryService_ReceiveBatch_Call {
+	return &MockInventoryService_ReceiveBatch_Call{Call: _e.mock.On("ReceiveBatch", ctx, request)}
+}
+
+func (_c *MockInventoryService_ReceiveBatch_Call) Run(run func(ctx context.Context, request *dto.BatchRequest)) *MockInventoryService_ReceiveBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.BatchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.BatchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_ReceiveBatch_Call) Return(batchResponse *dto.BatchResponse, err error) *MockInventoryService_ReceiveBatch_Call {
+	_c.Call.Return(batchResponse, err)
+	return _c
+}
+
+func (_c *MockInventoryService_ReceiveBatch_Call) RunAndReturn(run func(ctx context.Context, request *dto.BatchRequest) (*dto.BatchResponse, error)) *MockInventoryService_ReceiveBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStockThresholds provides a mock function for the type MockInventoryService
+func (_mock *MockInventoryService) SetStockThresholds(ctx context.Context, request *dto.StockThresholdsRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStockThresholds")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.StockThresholdsRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryService_SetStockThresholds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStockThresholds'
+type MockInventoryService_SetStockThresholds_Call struct {
+	*mock.Call
+}
+
+// SetStockThresholds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.StockThresholdsRequest
+func (_e *MockInventoryService_Expecter) SetStockThresholds(ctx interface{}, request interface{}) *MockInventoryService_SetStockThresholds_Call {
+	return &MockInventoryService_SetStockThresholds_Call{Call: _e.mock.On("SetStockThresholds", ctx, request)}
+}
+
+func (_c *MockInventoryService_SetStockThresholds_Call) Run(run func(ctx context.Context, request *dto.StockThresholdsRequest)) *MockInventoryService_SetStockThresholds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.StockThresholdsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.StockThresholdsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryService_SetStockThresholds_Call) Return(err error) *MockInventoryService_SetStockThresholds_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryService_SetStockThresholds_Call) RunAndReturn(run func(ctx context.Context, request *dto.StockThresholdsRequest) error) *MockInventoryService_SetStockThresholds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrderService creates a new instance of MockOrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockOrderService {
+	mock := &MockOrderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOrderService is an autogenerated mock type for the OrderService type
+type MockOrderService struct {
+	mock.Mock
+}
+
+type MockOrderService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrderService) EXPECT() *MockOrderService_Expecter {
+	return &MockOrderService_Expecter{mock: &_m.Mock}
+}
+
+// CancelOrder provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) CancelOrder(ctx context.Context, orderID uuid.UUID, request *dto.OrderCancelRequest) (*dto.OrderReturnResponse, error) {
+	ret := _mock.Called(ctx, orderID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrder")
+	}
+
+	var r0 *dto.OrderReturnResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.OrderCancelRequest) (*dto.OrderReturnResponse, error)); ok {
+		return returnFunc(ctx, orderID, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.OrderCancelRequest) *dto.OrderReturnResponse); ok {
+		r0 = returnFunc(ctx, orderID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrderReturnResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.OrderCancelRequest) error); ok {
+		r1 = returnFunc(ctx, orderID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_CancelOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrder'
+type MockOrderService_CancelOrder_Call struct {
+	*mock.Call
+}
+
+// CancelOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+//   - request *dto.OrderCancelRequest
+func (_e *MockOrderService_Expecter) CancelOrder(ctx interface{}, orderID interface{}, request interface{}) *MockOrderService_CancelOrder_Call {
+	return &MockOrderService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, orderID, request)}
+}
+
+func (_c *MockOrderService_CancelOrder_Call) Run(run func(ctx context.Context, orderID uuid.UUID, request *dto.OrderCancelRequest)) *MockOrderService_CancelOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *dto.OrderCancelRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.OrderCancelRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_CancelOrder_Call) Return(orderReturnResponse *dto.OrderReturnResponse, err error) *MockOrderService_CancelOrder_Call {
+	_c.Call.Return(orderReturnResponse, err)
+	return _c
+}
+
+func (_c *MockOrderService_CancelOrder_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID, request *dto.OrderCancelRequest) (*dto.OrderReturnResponse, error)) *MockOrderService_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrder provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) GetOrder(ctx context.Context, orderID uuid.UUID) (*dto.OrderResponse, error) {
+	ret := _mock.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrder")
+	}
+
+	var r0 *dto.OrderResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.OrderResponse, error)); ok {
+		return returnFunc(ctx, orderID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.OrderResponse); ok {
+		r0 = returnFunc(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrderResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_GetOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrder'
+type MockOrderService_GetOrder_Call struct {
+	*mock.Call
+}
+
+// GetOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+func (_e *MockOrderService_Expecter) GetOrder(ctx interface{}, orderID interface{}) *MockOrderService_GetOrder_Call {
+	return &MockOrderService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, orderID)}
+}
+
+func (_c *MockOrderService_GetOrder_Call) Run(run func(ctx context.Context, orderID uuid.UUID)) *MockOrderService_GetOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_GetOrder_Call) Return(orderResponse *dto.OrderResponse, err error) *MockOrderService_GetOrder_Call {
+	_c.Call.Return(orderResponse, err)
+	return _c
+}
+
+func (_c *MockOrderService_GetOrder_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID) (*dto.OrderResponse, error)) *MockOrderService_GetOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrders provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) GetOrders(ctx context.Context, filter *dto.OrderFilter) (*dto.OrdersResponse, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrders")
+	}
+
+	var r0 *dto.OrdersResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.OrderFilter) (*dto.OrdersResponse, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.OrderFilter) *dto.OrdersResponse); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrdersResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.OrderFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_GetOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrders'
+type MockOrderService_GetOrders_Call struct {
+	*mock.Call
+}
+
+// GetOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *dto.OrderFilter
+func (_e *MockOrderService_Expecter) GetOrders(ctx interface{}, filter interface{}) *MockOrderService_GetOrders_Call {
+	return &MockOrderService_GetOrders_Call{Call: _e.mock.On("GetOrders", ctx, filter)}
+}
+
+func (_c *MockOrderService_GetOrders_Call) Run(run func(ctx context.Context, filter *dto.OrderFilter)) *MockOrderService_GetOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.OrderFilter
+		if args[1] != nil {
+			arg1 = args[1].(*dto.OrderFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_GetOrders_Call) Return(ordersResponse *dto.OrdersResponse, err error) *MockOrderService_GetOrders_Call {
+	_c.Call.Return(ordersResponse, err)
+	return _c
+}
+
+func (_c *MockOrderService_GetOrders_Call) RunAndReturn(run func(ctx context.Context, filter *dto.OrderFilter) (*dto.OrdersResponse, error)) *MockOrderService_GetOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReturnOrderProducts provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) ReturnOrderProducts(ctx context.Context, orderID uuid.UUID, request *dto.OrderReturnRequest) (*dto.OrderReturnResponse, error) {
+	ret := _mock.Called(ctx, orderID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReturnOrderProducts")
+	}
+
+	var r0 *dto.OrderReturnResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.OrderReturnRequest) (*dto.OrderReturnResponse, error)); ok {
+		return returnFunc(ctx, orderID, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.OrderReturnRequest) *dto.OrderReturnResponse); ok {
+		r0 = returnFunc(ctx, orderID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrderReturnResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.OrderReturnRequest) error); ok {
+		r1 = returnFunc(ctx, orderID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_ReturnOrderProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReturnOrderProducts'
+type MockOrderService_ReturnOrderProducts_Call struct {
+	*mock.Call
+}
+
+// ReturnOrderProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+//   - request *dto.OrderReturnRequest
+func (_e *MockOrderService_Expecter) ReturnOrderProducts(ctx interface{}, orderID interface{}, request interface{}) *MockOrderService_ReturnOrderProducts_Call {
+	return &MockOrderService_ReturnOrderProducts_Call{Call: _e.mock.On("ReturnOrderProducts", ctx, orderID, request)}
+}
+
+func (_c *MockOrderService_ReturnOrderProducts_Call) Run(run func(ctx context.Context, orderID uuid.UUID, request *dto.OrderReturnRequest)) *MockOrderService_ReturnOrderProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *dto.OrderReturnRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.OrderReturnRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_ReturnOrderProducts_Call) Return(orderReturnResponse *dto.OrderReturnResponse, err error) *MockOrderService_ReturnOrderProducts_Call {
+	_c.Call.Return(orderReturnResponse, err)
+	return _c
+}
+
+func (_c *MockOrderService_ReturnOrderProducts_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID, request *dto.OrderReturnRequest) (*dto.OrderReturnResponse, error)) *MockOrderService_ReturnOrderProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrderStatus provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) UpdateOrderStatus(ctx context.Context, orderID uuid.UUID, request *dto.OrderStatusRequest) (*dto.OrderResponse, error) {
+	ret := _mock.Called(ctx, orderID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderStatus")
+	}
+
+	var r0 *dto.OrderResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.OrderStatusRequest) (*dto.OrderResponse, error)); ok {
+		return returnFunc(ctx, orderID, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.OrderStatusRequest) *dto.OrderResponse); ok {
+		r0 = returnFunc(ctx, orderID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrderResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.OrderStatusRequest) error); ok {
+		r1 = returnFunc(ctx, orderID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_UpdateOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrderStatus'
+type MockOrderService_UpdateOrderStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateOrderStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+//   - request *dto.OrderStatusRequest
+func (_e *MockOrderService_Expecter) UpdateOrderStatus(ctx interface{}, orderID interface{}, request interface{}) *MockOrderService_UpdateOrderStatus_Call {
+	return &MockOrderService_UpdateOrderStatus_Call{Call: _e.mock.On("UpdateOrderStatus", ctx, orderID, request)}
+}
+
+func (_c *MockOrderService_UpdateOrderStatus_Call) Run(run func(ctx context.Context, orderID uuid.UUID, request *dto.OrderStatusRequest)) *MockOrderService_UpdateOrderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *dto.OrderStatusRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.OrderStatusRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_UpdateOrderStatus_Call) Return(orderResponse *dto.OrderResponse, err error) *MockOrderService_UpdateOrderStatus_Call {
+	_c.Call.Return(orderResponse, err)
+	return _c
+}
+
+func (_c *MockOrderService_UpdateOrderStatus_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID, request *dto.OrderStatusRequest) (*dto.OrderResponse, error)) *MockOrderService_UpdateOrderStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPriceHistoryService creates a new instance of MockPriceHistoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPriceHistoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockPriceHistoryService {
+	mock := &MockPriceHistoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPriceHistoryService is an autogenerated mock type for the PriceHistoryService type
+type MockPriceHistoryService struct {
+	mock.Mock
+}
+
+type MockPriceHistoryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPriceHistoryService) EXPECT() *MockPriceHistoryService_Expecter {
+	return &MockPriceHistoryService_Expecter{mock: &_m.Mock}
+}
+
+// GetPriceHistory provides a mock function for the type MockPriceHistoryService
+func (_mock *MockPriceHistoryService) GetPriceHistory(ctx context.Context, filter *dto.PriceHistoryFilter) (*dto.PriceHistoryResponse, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceHistory")
+	}
+
+	var r0 *dto.PriceHistoryResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PriceHistoryFilter) (*dto.PriceHistoryResponse, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PriceHistoryFilter) *dto.PriceHistoryResponse); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PriceHistoryResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.PriceHistoryFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPriceHistoryService_GetPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceHistory'
+type MockPriceHistoryService_GetPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *dto.PriceHistoryFilter
+func (_e *MockPriceHistoryService_Expecter) GetPriceHistory(ctx interface{}, filter interface{}) *MockPriceHistoryService_GetPriceHistory_Call {
+	return &MockPriceHistoryService_GetPriceHistory_Call{Call: _e.mock.On("GetPriceHistory", ctx, filter)}
+}
+
+func (_c *MockPriceHistoryService_GetPriceHistory_Call) Run(run func(ctx context.Context, filter *dto.PriceHistoryFilter)) *MockPriceHistoryService_GetPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.PriceHistoryFilter
+		if args[1] != nil {
+			arg1 = args[1].(*dto.PriceHistoryFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPriceHistoryService_GetPriceHistory_Call) Return(priceHistoryResponse *dto.PriceHistoryResponse, err error) *MockPriceHistoryService_GetPriceHistory_Call {
+	_c.Call.Return(priceHistoryResponse, err)
+	return _c
+}
+
+func (_c *MockPriceHistoryService_GetPriceHistory_Call) RunAndReturn(run func(ctx context.Context, filter *dto.PriceHistoryFilter) (*dto.PriceHistoryResponse, error)) *MockPriceHistoryService_GetPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPricingRuleService creates a new instance of MockPricingRuleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPricingRuleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockPricingRuleService {
+	mock := &MockPricingRuleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPricingRuleService is an autogenerated mock type for the PricingRuleService type
+type MockPricingRuleService struct {
+	mock.Mock
+}
+
+type MockPricingRuleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPricingRuleService) EXPECT() *MockPricingRuleService_Expecter {
+	return &MockPricingRuleService_Expecter{mock: &_m.Mock}
+}
+
+// CreatePricingRule provides a mock function for the type MockPricingRuleService
+func (_mock *MockPricingRuleService) CreatePricingRule(ctx context.Context, request *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePricingRule")
+	}
+
+	var r0 *dto.PricingRuleResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PricingRuleRequest) *dto.PricingRuleResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PricingRuleResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.PricingRuleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPricingRuleService_CreatePricingRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePricingRule'
+type MockPricingRuleService_CreatePricingRule_Call struct {
+	*mock.Call
+}
+
+// CreatePricingRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.PricingRuleRequest
+func (_e *MockPricingRuleService_Expecter) CreatePricingRule(ctx interface{}, request interface{}) *MockPricingRuleService_CreatePricingRule_Call {
+	return &MockPricingRuleService_CreatePricingRule_Call{Call: _e.mock.On("CreatePricingRule", ctx, request)}
+}
+
+func (_c *MockPricingRuleService_CreatePricingRule_Call) Run(run func(ctx context.Context, request *dto.PricingRuleRequest)) *MockPricingRuleService_CreatePricingRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.PricingRuleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.PricingRuleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPricingRuleService_CreatePricingRule_Call) Return(pricingRuleResponse *dto.PricingRuleResponse, err error) *MockPricingRuleService_CreatePricingRule_Call {
+	_c.Call.Return(pricingRuleResponse, err)
+	return _c
+}
+
+func (_c *MockPricingRuleService_CreatePricingRule_Call) RunAndReturn(run func(ctx context.Context, request *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)) *MockPricingRuleService_CreatePricingRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePricingRule provides a mock function for the type MockPricingRuleService
+func (_mock *MockPricingRuleService) DeletePricingRule(ctx context.Context, ruleID uuid.UUID) error {
+	ret := _mock.Called(ctx, ruleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePricingRule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, ruleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPricingRuleService_DeletePricingRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePricingRule'
+type MockPricingRuleService_DeletePricingRule_Call struct {
+	*mock.Call
+}
+
+// DeletePricingRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID uuid.UUID
+func (_e *MockPricingRuleService_Expecter) DeletePricingRule(ctx interface{}, ruleID interface{}) *MockPricingRuleService_DeletePricingRule_Call {
+	return &MockPricingRuleService_DeletePricingRule_Call{Call: _e.mock.On("DeletePricingRule", ctx, ruleID)}
+}
+
+func (_c *MockPricingRuleService_DeletePricingRule_Call) Run(run func(ctx context.Context, ruleID uuid.UUID)) *MockPricingRuleService_DeletePricingRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPricingRuleService_DeletePricingRule_Call) Return(err error) *MockPricingRuleService_DeletePricingRule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPricingRuleService_DeletePricingRule_Call) RunAndReturn(run func(ctx context.Context, ruleID uuid.UUID) error) *MockPricingRuleService_DeletePricingRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPricingRule provides a mock function for the type MockPricingRuleService
+func (_mock *MockPricingRuleService) GetPricingRule(ctx context.Context, ruleID uuid.UUID) (*dto.PricingRuleResponse, error) {
+	ret := _mock.Called(ctx, ruleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPricingRule")
+	}
+
+	var r0 *dto.PricingRuleResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PricingRuleResponse, error)); ok {
+		return returnFunc(ctx, ruleID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PricingRuleResponse); ok {
+		r0 = returnFunc(ctx, ruleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PricingRuleResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ruleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPricingRuleService_GetPricingRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPricingRule'
+type MockPricingRuleService_GetPricingRule_Call struct {
+	*mock.Call
+}
+
+// GetPricingRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID uuid.UUID
+func (_e *MockPricingRuleService_Expecter) GetPricingRule(ctx interface{}, ruleID interface{}) *MockPricingRuleService_GetPricingRule_Call {
+	return &MockPricingRuleService_GetPricingRule_Call{Call: _e.mock.On("GetPricingRule", ctx, ruleID)}
+}
+
+func (_c *MockPricingRuleService_GetPricingRule_Call) Run(run func(ctx context.Context, ruleID uuid.UUID)) *MockPricingRuleService_GetPricingRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPricingRuleService_GetPricingRule_Call) Return(pricingRuleResponse *dto.PricingRuleResponse, err error) *MockPricingRuleService_GetPricingRule_Call {
+	_c.Call.Return(pricingRuleResponse, err)
+	return _c
+}
+
+func (_c *MockPricingRuleService_GetPricingRule_Call) RunAndReturn(run func(ctx context.Context, ruleID uuid.UUID) (*dto.PricingRuleResponse, error)) *MockPricingRuleService_GetPricingRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPricingRules provides a mock function for the type MockPricingRuleService
+func (_mock *MockPricingRuleService) GetPricingRules(ctx context.Context, filter *dto.PricingRuleFilter) (*dto.PricingRulesResponse, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPricingRules")
+	}
+
+	var r0 *dto.PricingRulesResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PricingRuleFilter) (*dto.PricingRulesResponse, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PricingRuleFilter) *dto.PricingRulesResponse); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PricingRulesResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.PricingRuleFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPricingRuleService_GetPricingRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPricingRules'
+type MockPricingRuleService_GetPricingRules_Call struct {
+	*mock.Call
+}
+
+// GetPricingRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *dto.PricingRuleFilter
+func (_e *MockPricingRuleService_Expecter) GetPricingRules(ctx interface{}, filter interface{}) *MockPricingRuleService_GetPricingRules_Call {
+	return &MockPricingRuleService_GetPricingRules_Call{Call: _e.mock.On("GetPricingRules", ctx, filter)}
+}
+
+func (_c *MockPricingRuleService_GetPricingRules_Call) Run(run func(ctx context.Context, filter *dto.PricingRuleFilter)) *MockPricingRuleService_GetPricingRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.PricingRuleFilter
+		if args[1] != nil {
+			arg1 = args[1].(*dto.PricingRuleFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPricingRuleService_GetPricingRules_Call) Return(pricingRulesResponse *dto.PricingRulesResponse, err error) *MockPricingRuleService_GetPricingRules_Call {
+	_c.Call.Return(pricingRulesResponse, err)
+	return _c
+}
+
+func (_c *MockPricingRuleService_GetPricingRules_Call) RunAndReturn(run func(ctx context.Context, filter *dto.PricingRuleFilter) (*dto.PricingRulesResponse, error)) *MockPricingRuleService_GetPricingRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePricingRule provides a mock function for the type MockPricingRuleService
+func (_mock *MockPricingRuleService) UpdatePricingRule(ctx context.Context, ruleID uuid.UUID, request *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error) {
+	ret := _mock.Called(ctx, ruleID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePricingRule")
+	}
+
+	var r0 *dto.PricingRuleResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)); ok {
+		return returnFunc(ctx, ruleID, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PricingRuleRequest) *dto.PricingRuleResponse); ok {
+		r0 = returnFunc(ctx, ruleID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PricingRuleResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.PricingRuleRequest) error); ok {
+		r1 = returnFunc(ctx, ruleID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPricingRuleService_UpdatePricingRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePricingRule'
+type MockPricingRuleService_UpdatePricingRule_Call struct {
+	*mock.Call
+}
+
+// UpdatePricingRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID uuid.UUID
+//   - request *dto.PricingRuleRequest
+func (_e *MockPricingRuleService_Expecter) UpdatePricingRule(ctx interface{}, ruleID interface{}, request interface{}) *MockPricingRuleService_UpdatePricingRule_Call {
+	return &MockPricingRuleService_UpdatePricingRule_Call{Call: _e.mock.On("UpdatePricingRule", ctx, ruleID, request)}
+}
+
+func (_c *MockPricingRuleService_UpdatePricingRule_Call) Run(run func(ctx context.Context, ruleID uuid.UUID, request *dto.PricingRuleRequest)) *MockPricingRuleService_UpdatePricingRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *dto.PricingRuleRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.PricingRuleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPricingRuleService_UpdatePricingRule_Call) Return(pricingRuleResponse *dto.PricingRuleResponse, err error) *MockPricingRuleService_UpdatePricingRule_Call {
+	_c.Call.Return(pricingRuleResponse, err)
+	return _c
+}
+
+func (_c *MockPricingRuleService_UpdatePricingRule_Call) RunAndReturn(run func(ctx context.Context, ruleID uuid.UUID, request *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)) *MockPricingRuleService_UpdatePricingRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProductService creates a new instance of MockProductService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockProductService {
+	mock := &MockProductService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProductService is an autogenerated mock type for the ProductService type
+type MockProductService struct {
+	mock.Mock
+}
+
+type MockProductService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductService) EXPECT() *MockProductService_Expecter {
+	return &MockProductService_Expecter{mock: &_m.Mock}
+}
+
+// AddProduct provides a mock function for the type MockProductService
+func (_mock *MockProductService) AddProduct(ctx context.Context, request *dto.ProductRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.ProductRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductService_AddProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProduct'
+type MockProductService_AddProduct_Call struct {
+	*mock.Call
+}
+
+// AddProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.ProductRequest
+func (_e *MockProductService_Expecter) AddProduct(ctx interface{}, request interface{}) *MockProductService_AddProduct_Call {
+	return &MockProductService_AddProduct_Call{Call: _e.mock.On("AddProduct", ctx, request)}
+}
+
+func (_c *MockProductService_AddProduct_Call) Run(run func(ctx context.Context, request *dto.ProductRequest)) *MockProductService_AddProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.ProductRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.ProductRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductService_AddProduct_Call) Return(err error) *MockProductService_AddProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductService_AddProduct_Call) RunAndReturn(run func(ctx context.Context, request *dto.ProductRequest) error) *MockProductService_AddProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ArchiveProduct provides a mock function for the type MockProductService
+func (_mock *MockProductService) ArchiveProduct(ctx context.Context, productID uuid.UUID) error {
+	ret := _mock.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductService_ArchiveProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveProduct'
+type MockProductService_ArchiveProduct_Call struct {
+	*mock.Call
+}
+
+// ArchiveProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID uuid.UUID
+func (_e *MockProductService_Expecter) ArchiveProduct(ctx interface{}, productID interface{}) *MockProductService_ArchiveProduct_Call {
+	return &MockProductService_ArchiveProduct_Call{Call: _e.mock.On("ArchiveProduct", ctx, productID)}
+}
+
+func (_c *MockProductService_ArchiveProduct_Call) Run(run func(ctx context.Context, productID uuid.UUID)) *MockProductService_ArchiveProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductService_ArchiveProduct_Call) Return(err error) *MockProductService_ArchiveProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductService_ArchiveProduct_Call) RunAndReturn(run func(ctx context.Context, productID uuid.UUID) error) *MockProductService_ArchiveProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProduct provides a mock function for the type MockProductService
+func (_mock *MockProductService) DeleteProduct(ctx context.Context, productID uuid.UUID) error {
+	ret := _mock.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductService_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type MockProductService_DeleteProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID uuid.UUID
+func (_e *MockProductService_Expecter) DeleteProduct(ctx interface{}, productID interface{}) *MockProductService_DeleteProduct_Call {
+	return &MockProductService_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, productID)}
+}
+
+func (_c *MockProductService_DeleteProduct_Call) Run(run func(ctx context.Context, productID uuid.UUID)) *MockProductService_DeleteProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductService_DeleteProduct_Call) Return(err error) *MockProductService_DeleteProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductService_DeleteProduct_Call) RunAndReturn(run func(ctx context.Context, productID uuid.UUID) error) *MockProductService_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProducts provides a mock function for the type MockProductService
+func (_mock *MockProductService) GetProducts(ctx context.Context, filter *dto.ProductFilter) (*dto.ProductListResponse, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProducts")
+	}
+
+	var r0 *dto.ProductListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.ProductFilter) (*dto.ProductListResponse, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.ProductFilter) *dto.ProductListResponse); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProductListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.ProductFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_GetProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProducts'
+type MockProductService_GetProducts_Call struct {
+	*mock.Call
+}
+
+// GetProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *dto.ProductFilter
+func (_e *MockProductService_Expecter) GetProducts(ctx interface{}, filter interface{}) *MockProductService_GetProducts_Call {
+	return &MockProductService_GetProducts_Call{Call: _e.mock.On("GetProducts", ctx, filter)}
+}
+
+func (_c *MockProductService_GetProducts_Call) Run(run func(ctx context.Context, filter *dto.ProductFilter)) *MockProductService_GetProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.ProductFilter
+		if args[1] != nil {
+			arg1 = args[1].(*dto.ProductFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductService_GetProducts_Call) Return(productListResponse *dto.ProductListResponse, err error) *MockProductService_GetProducts_Call {
+	_c.Call.Return(productListResponse, err)
+	return _c
+}
+
+func (_c *MockProductService_GetProducts_Call) RunAndReturn(run func(ctx context.Context, filter *dto.ProductFilter) (*dto.ProductListResponse, error)) *MockProductService_GetProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function for the type MockProductService
+func (_mock *MockProductService) RestoreProduct(ctx context.Context, productID uuid.UUID) error {
+	ret := _mock.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductService_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type MockProductService_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID uuid.UUID
+func (_e *MockProductService_Expecter) RestoreProduct(ctx interface{}, productID interface{}) *MockProductService_RestoreProduct_Call {
+	return &MockProductService_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, productID)}
+}
+
+func (_c *MockProductService_RestoreProduct_Call) Run(run func(ctx context.Context, productID uuid.UUID)) *MockProductService_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductService_RestoreProduct_Call) Return(err error) *MockProductService_RestoreProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductService_RestoreProduct_Call) RunAndReturn(run func(ctx context.Context, productID uuid.UUID) error) *MockProductService_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProduct provides a mock function for the type MockProductService
+func (_mock *MockProductService) UpdateProduct(ctx context.Context, productID uuid.UUID, request *dto.ProductRequest) error {
+	ret := _mock.Called(ctx, productID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.ProductRequest) error); ok {
+		r0 = returnFunc(ctx, productID, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductService_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
+type MockProductService_UpdateProduct_Call struct {
+	*mock.Call
+}
+
+// UpdateProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID uuid.UUID
+//   - request *dto.ProductRequest
+func (_e *MockProductService_Expecter) UpdateProduct(ctx interface{}, productID interface{}, request interface{}) *MockProductService_UpdateProduct_Call {
+	return &MockProductService_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, productID, request)}
+}
+
+func (_c *MockProductService_UpdateProduct_Call) Run(run func(ctx context.Context, productID uuid.UUID, request *dto.ProductRequest)) *MockProductService_UpdateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *dto.ProductRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.ProductRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductService_UpdateProduct_Call) Return(err error) *MockProductService_UpdateProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductService_UpdateProduct_Call) RunAndReturn(run func(ctx context.Context, productID uuid.UUID, request *dto.ProductRequest) error) *MockProductService_UpdateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPromoCodeService creates a new instance of MockPromoCodeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPromoCodeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockPromoCodeService {
+	mock := &MockPromoCodeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPromoCodeService is an autogenerated mock type for the PromoCodeService type
+type MockPromoCodeService struct {
+	mock.Mock
+}
+
+type MockPromoCodeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPromoCodeService) EXPECT() *MockPromoCodeService_Expecter {
+	return &MockPromoCodeService_Expecter{mock: &_m.Mock}
+}
+
+// CreatePromoCode provides a mock function for the type MockPromoCodeService
+func (_mock *MockPromoCodeService) CreatePromoCode(ctx context.Context, request *dto.PromoCodeRequest) (*dto.PromoCodeResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePromoCode")
+	}
+
+	var r0 *dto.PromoCodeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PromoCodeRequest) (*dto.PromoCodeResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PromoCodeRequest) *dto.PromoCodeResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PromoCodeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.PromoCodeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPromoCodeService_CreatePromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePromoCode'
+type MockPromoCodeService_CreatePromoCode_Call struct {
+	*mock.Call
+}
+
+// CreatePromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.PromoCodeRequest
+func (_e *MockPromoCodeService_Expecter) CreatePromoCode(ctx interface{}, request interface{}) *MockPromoCodeService_CreatePromoCode_Call {
+	return &MockPromoCodeService_CreatePromoCode_Call{Call: _e.mock.On("CreatePromoCode", ctx, request)}
+}
+
+func (_c *MockPromoCodeService_CreatePromoCode_Call) Run(run func(ctx context.Context, request *dto.PromoCodeRequest)) *MockPromoCodeService_CreatePromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.PromoCodeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.PromoCodeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPromoCodeService_CreatePromoCode_Call) Return(promoCodeResponse *dto.PromoCodeResponse, err error) *MockPromoCodeService_CreatePromoCode_Call {
+	_c.Call.Return(promoCodeResponse, err)
+	return _c
+}
+
+func (_c *MockPromoCodeService_CreatePromoCode_Call) RunAndReturn(run func(ctx context.Context, request *dto.PromoCodeRequest) (*dto.PromoCodeResponse, error)) *MockPromoCodeService_CreatePromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePromoCode provides a mock function for the type MockPromoCodeService
+func (_mock *MockPromoCodeService) DeletePromoCode(ctx context.Context, promoCodeID uuid.UUID) error {
+	ret := _mock.Called(ctx, promoCodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePromoCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, promoCodeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPromoCodeService_DeletePromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePromoCode'
+type MockPromoCodeService_DeletePromoCode_Call struct {
+	*mock.Call
+}
+
+// DeletePromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - promoCodeID uuid.UUID
+func (_e *MockPromoCodeService_Expecter) DeletePromoCode(ctx interface{}, promoCodeID interface{}) *MockPromoCodeService_DeletePromoCode_Call {
+	return &MockPromoCodeService_DeletePromoCode_Call{Call: _e.mock.On("DeletePromoCode", ctx, promoCodeID)}
+}
+
+func (_c *MockPromoCodeService_DeletePromoCode_Call) Run(run func(ctx context.Context, promoCodeID uuid.UUID)) *MockPromoCodeService_DeletePromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPromoCodeService_DeletePromoCode_Call) Return(err error) *MockPromoCodeService_DeletePromoCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPromoCodeService_DeletePromoCode_Call) RunAndReturn(run func(ctx context.Context, promoCodeID uuid.UUID) error) *MockPromoCodeService_DeletePromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPromoCode provides a mock function for the type MockPromoCodeService
+func (_mock *MockPromoCodeService) GetPromoCode(ctx context.Context, promoCodeID uuid.UUID) (*dto.PromoCodeResponse, error) {
+	ret := _mock.Called(ctx, promoCodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromoCode")
+	}
+
+	var r0 *dto.PromoCodeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PromoCodeResponse, error)); ok {
+		return returnFunc(ctx, promoCodeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PromoCodeResponse); ok {
+		r0 = returnFunc(ctx, promoCodeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PromoCodeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, promoCodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPromoCodeService_GetPromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromoCode'
+type MockPromoCodeService_GetPromoCode_Call struct {
+	*mock.Call
+}
+
+// GetPromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - promoCodeID uuid.UUID
+func (_e *MockPromoCodeService_Expecter) GetPromoCode(ctx interface{}, promoCodeID interface{}) *MockPromoCodeService_GetPromoCode_Call {
+	return &MockPromoCodeService_GetPromoCode_Call{Call: _e.mock.On("GetPromoCode", ctx, promoCodeID)}
+}
+
+func (_c *MockPromoCodeService_GetPromoCode_Call) Run(run func(ctx context.Context, promoCodeID uuid.UUID)) *MockPromoCodeService_GetPromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPromoCodeService_GetPromoCode_Call) Return(promoCodeResponse *dto.PromoCodeResponse, err error) *MockPromoCodeService_GetPromoCode_Call {
+	_c.Call.Return(promoCodeResponse, err)
+	return _c
+}
+
+func (_c *MockPromoCodeService_GetPromoCode_Call) RunAndReturn(run func(ctx context.Context, promoCodeID uuid.UUID) (*dto.PromoCodeResponse, error)) *MockPromoCodeService_GetPromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPromoCodes provides a mock function for the type MockPromoCodeService
+func (_mock *MockPromoCodeService) GetPromoCodes(ctx context.Context, filter *dto.PromoCodeFilter) (*dto.PromoCodesResponse, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromoCodes")
+	}
+
+	var r0 *dto.PromoCodesResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PromoCodeFilter) (*dto.PromoCodesResponse, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PromoCodeFilter) *dto.PromoCodesResponse); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PromoCodesResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.PromoCodeFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPromoCodeService_GetPromoCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromoCodes'
+type MockPromoCodeService_GetPromoCodes_Call struct {
+	*mock.Call
+}
+
+// GetPromoCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *dto.PromoCodeFilter
+func (_e *MockPromoCodeService_Expecter) GetPromoCodes(ctx interface{}, filter interface{}) *MockPromoCodeService_GetPromoCodes_Call {
+	return &MockPromoCodeService_GetPromoCodes_Call{Call: _e.mock.On("GetPromoCodes", ctx, filter)}
+}
+
+func (_c *MockPromoCodeService_GetPromoCodes_Call) Run(run func(ctx context.Context, filter *dto.PromoCodeFilter)) *MockPromoCodeService_GetPromoCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.PromoCodeFilter
+		if args[1] != nil {
+			arg1 = args[1].(*dto.PromoCodeFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPromoCodeService_GetPromoCodes_Call) Return(promoCodesResponse *dto.PromoCodesResponse, err error) *MockPromoCodeService_GetPromoCodes_Call {
+	_c.Call.Return(promoCodesResponse, err)
+	return _c
+}
+
+func (_c *MockPromoCodeService_GetPromoCodes_Call) RunAndReturn(run func(ctx context.Context, filter *dto.PromoCodeFilter) (*dto.PromoCodesResponse, error)) *MockPromoCodeService_GetPromoCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPurchaseOrderService creates a new instance of MockPurchaseOrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPurchaseOrderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockPurchaseOrderService {
+	mock := &MockPurchaseOrderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPurchaseOrderService is an autogenerated mock type for the PurchaseOrderService type
+type MockPurchaseOrderService struct {
+	mock.Mock
+}
+
+type MockPurchaseOrderService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPurchaseOrderService) EXPECT() *MockPurchaseOrderService_Expecter {
+	return &MockPurchaseOrderService_Expecter{mock: &_m.Mock}
+}
+
+// CancelPurchaseOrder provides a mock function for the type MockPurchaseOrderService
+func (_mock *MockPurchaseOrderService) CancelPurchaseOrder(ctx context.Context, orderID uuid.UUID) (*dto.PurchaseOrderResponse, error) {
+	ret := _mock.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelPurchaseOrder")
+	}
+
+	var r0 *dto.PurchaseOrderResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PurchaseOrderResponse, error)); ok {
+		return returnFunc(ctx, orderID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PurchaseOrderResponse); ok {
+		r0 = returnFunc(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PurchaseOrderResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPurchaseOrderService_CancelPurchaseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelPurchaseOrder'
+type MockPurchaseOrderService_CancelPurchaseOrder_Call struct {
+	*mock.Call
+}
+
+// CancelPurchaseOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+func (_e *MockPurchaseOrderService_Expecter) CancelPurchaseOrder(ctx interface{}, orderID interface{}) *MockPurchaseOrderService_CancelPurchaseOrder_Call {
+	return &MockPurchaseOrderService_CancelPurchaseOrder_Call{Call: _e.mock.On("CancelPurchaseOrder", ctx, orderID)}
+}
+
+func (_c *MockPurchaseOrderService_CancelPurchaseOrder_Call) Run(run func(ctx context.Context, orderID uuid.UUID)) *MockPurchaseOrderService_CancelPurchaseOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPurchaseOrderService_CancelPurchaseOrder_Call) Return(purchaseOrderResponse *dto.PurchaseOrderResponse, err error) *MockPurchaseOrderService_CancelPurchaseOrder_Call {
+	_c.Call.Return(purchaseOrderResponse, err)
+	return _c
+}
+
+func (_c *MockPurchaseOrderService_CancelPurchaseOrder_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID) (*dto.PurchaseOrderResponse, error)) *MockPurchaseOrderService_CancelPurchaseOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePurchaseOrder provides a mock function for the type MockPurchaseOrderService
+func (_mock *MockPurchaseOrderService) CreatePurchaseOrder(ctx context.Context, request *dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePurchaseOrder")
+	}
+
+	var r0 *dto.PurchaseOrderResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PurchaseOrderRequest) *dto.PurchaseOrderResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PurchaseOrderResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.PurchaseOrderRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPurchaseOrderService_CreatePurchaseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePurchaseOrder'
+type MockPurchaseOrderService_CreatePurchaseOrder_Call struct {
+	*mock.Call
+}
+
+// CreatePurchaseOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.PurchaseOrderRequest
+func (_e *MockPurchaseOrderService_Expecter) CreatePurchaseOrder(ctx interface{}, request interface{}) *MockPurchaseOrderService_CreatePurchaseOrder_Call {
+	return &MockPurchaseOrderService_CreatePurchaseOrder_Call{Call: _e.mock.On("CreatePurchaseOrder", ctx, request)}
+}
+
+func (_c *MockPurchaseOrderService_CreatePurchaseOrder_Call) Run(run func(ctx context.Context, request *dto.PurchaseOrderRequest)) *MockPurchaseOrderService_CreatePurchaseOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.PurchaseOrderRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.PurchaseOrderRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPurchaseOrderService_CreatePurchaseOrder_Call) Return(purchaseOrderResponse *dto.PurchaseOrderResponse, err error) *MockPurchaseOrderService_CreatePurchaseOrder_Call {
+	_c.Call.Return(purchaseOrderResponse, err)
+	return _c
+}
+
+func (_c *MockPurchaseOrderService_CreatePurchaseOrder_Call) RunAndReturn(run func(ctx context.Context, request *dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error)) *MockPurchaseOrderService_CreatePurchaseOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPurchaseOrder provides a mock function for the type MockPurchaseOrderService
+func (_mock *MockPurchaseOrderService) GetPurchaseOrder(ctx context.Context, orderID uuid.UUID) (*dto.PurchaseOrderResponse, error) {
+	ret := _mock.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPurchaseOrder")
+	}
+
+	var r0 *dto.PurchaseOrderResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PurchaseOrderResponse, error)); ok {
+		return returnFunc(ctx, orderID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PurchaseOrderResponse); ok {
+		r0 = returnFunc(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PurchaseOrderResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPurchaseOrderService_GetPurchaseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPurchaseOrder'
+type MockPurchaseOrderService_GetPurchaseOrder_Call struct {
+	*mock.Call
+}
+
+// GetPurchaseOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+func (_e *MockPurchaseOrderService_Expecter) GetPurchaseOrder(ctx interface{}, orderID interface{}) *MockPurchaseOrderService_GetPurchaseOrder_Call {
+	return &MockPurchaseOrderService_GetPurchaseOrder_Call{Call: _e.mock.On("GetPurchaseOrder", ctx, orderID)}
+}
+
+func (_c *MockPurchaseOrderService_GetPurchaseOrder_Call) Run(run func(ctx context.Context, orderID uuid.UUID)) *MockPurchaseOrderService_GetPurchaseOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPurchaseOrderService_GetPurchaseOrder_Call) Return(purchaseOrderResponse *dto.PurchaseOrderResponse, err error) *MockPurchaseOrderService_GetPurchaseOrder_Call {
+	_c.Call.Return(purchaseOrderResponse, err)
+	return _c
+}
+
+func (_c *MockPurchaseOrderService_GetPurchaseOrder_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID) (*dto.PurchaseOrderResponse, error)) *MockPurchaseOrderService_GetPurchaseOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPurchaseOrders provides a mock function for the type MockPurchaseOrderService
+func (_mock *MockPurchaseOrderService) GetPurchaseOrders(ctx context.Context, filter *dto.PurchaseOrderFilter) (*dto.PurchaseOrdersResponse, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPurchaseOrders")
+	}
+
+	var r0 *dto.PurchaseOrdersResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PurchaseOrderFilter) (*dto.PurchaseOrdersResponse, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.PurchaseOrderFilter) *dto.PurchaseOrdersResponse); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PurchaseOrdersResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.PurchaseOrderFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPurchaseOrderService_GetPurchaseOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPurchaseOrders'
+type MockPurchaseOrderService_GetPurchaseOrders_Call struct {
+	*mock.Call
+}
+
+// GetPurchaseOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *dto.PurchaseOrderFilter
+func (_e *MockPurchaseOrderService_Expecter) GetPurchaseOrders(ctx interface{}, filter interface{}) *MockPurchaseOrderService_GetPurchaseOrders_Call {
+	return &MockPurchaseOrderService_GetPurchaseOrders_Call{Call: _e.mock.On("GetPurchaseOrders", ctx, filter)}
+}
+
+func (_c *MockPurchaseOrderService_GetPurchaseOrders_Call) Run(run func(ctx context.Context, filter *dto.PurchaseOrderFilter)) *MockPurchaseOrderService_GetPurchaseOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.PurchaseOrderFilter
+		if args[1] != nil {
+			arg1 = args[1].(*dto.PurchaseOrderFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPurchaseOrderService_GetPurchaseOrders_Call) Return(purchaseOrdersResponse *dto.PurchaseOrdersResponse, err error) *MockPurchaseOrderService_GetPurchaseOrders_Call {
+	_c.Call.Return(purchaseOrdersResponse, err)
+	return _c
+}
+
+func (_c *MockPurchaseOrderService_GetPurchaseOrders_Call) RunAndReturn(run func(ctx context.Context, filter *dto.PurchaseOrderFilter) (*dto.PurchaseOrdersResponse, error)) *MockPurchaseOrderService_GetPurchaseOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceivePurchaseOrder provides a mock function for the type MockPurchaseOrderService
+func (_mock *MockPurchaseOrderService) ReceivePurchaseOrder(ctx context.Context, orderID uuid.UUID, request *dto.PurchaseOrderReceiptRequest) (*dto.PurchaseOrderResponse, error) {
+	ret := _mock.Called(ctx, orderID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceivePurchaseOrder")
+	}
+
+	var r0 *dto.PurchaseOrderResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PurchaseOrderReceiptRequest) (*dto.PurchaseOrderResponse, error)); ok {
+		return returnFunc(ctx, orderID, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PurchaseOrderReceiptRequest) *dto.PurchaseOrderResponse); ok {
+		r0 = returnFunc(ctx, orderID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PurchaseOrderResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.PurchaseOrderReceiptRequest) error); ok {
+		r1 = returnFunc(ctx, orderID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPurchaseOrderService_ReceivePurchaseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceivePurchaseOrder'
+type MockPurchaseOrderService_ReceivePurchaseOrder_Call struct {
+	*mock.Call
+}
+
+// ReceivePurchaseOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+//   - request *dto.PurchaseOrderReceiptRequest
+func (_e *MockPurchaseOrderService_Expecter) ReceivePurchaseOrder(ctx interface{}, orderID interface{}, request interface{}) *MockPurchaseOrderService_ReceivePurchaseOrder_Call {
+	return &MockPurchaseOrderService_ReceivePurchaseOrder_Call{Call: _e.mock.On("ReceivePurchaseOrder", ctx, orderID, request)}
+}
+
+func (_c *MockPurchaseOrderService_ReceivePurchaseOrder_Call) Run(run func(ctx context.Context, orderID uuid.UUID, request *dto.PurchaseOrderReceiptRequest)) *MockPurchaseOrderService_ReceivePurchaseOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *dto.PurchaseOrderReceiptRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.PurchaseOrderReceiptRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPurchaseOrderService_ReceivePurchaseOrder_Call) Return(purchaseOrderResponse *dto.PurchaseOrderResponse, err error) *MockPurchaseOrderService_ReceivePurchaseOrder_Call {
+	_c.Call.Return(purchaseOrderResponse, err)
+	return _c
+}
+
+func (_c *MockPurchaseOrderService_ReceivePurchaseOrder_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID, request *dto.PurchaseOrderReceiptRequest) (*dto.PurchaseOrderResponse, error)) *MockPurchaseOrderService_ReceivePurchaseOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSerialService creates a new instance of MockSerialService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSerialService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockSerialService {
+	mock := &MockSerialService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSerialService is an autogenerated mock type for the SerialService type
+type MockSerialService struct {
+	mock.Mock
+}
+
+type MockSerialService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSerialService) EXPECT() *MockSerialService_Expecter {
+	return &MockSerialService_Expecter{mock: &_m.Mock}
+}
+
+// GetSerial provides a mock function for the type MockSerialService
+func (_mock *MockSerialService) GetSerial(ctx context.Context, serialNumber string) (*dto.SerialLookupResponse, error) {
+	ret := _mock.Called(ctx, serialNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSerial")
+	}
+
+	var r0 *dto.SerialLookupResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*dto.SerialLookupResponse, error)); ok {
+		return returnFunc(ctx, serialNumber)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *dto.SerialLookupResponse); ok {
+		r0 = returnFunc(ctx, serialNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SerialLookupResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, serialNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSerialService_GetSerial_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSerial'
+type MockSerialService_GetSerial_Call struct {
+	*mock.Call
+}
+
+// GetSerial is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serialNumber string
+func (_e *MockSerialService_Expecter) GetSerial(ctx interface{}, serialNumber interface{}) *MockSerialService_GetSerial_Call {
+	return &MockSerialService_GetSerial_Call{Call: _e.mock.On("GetSerial", ctx, serialNumber)}
+}
+
+func (_c *MockSerialService_GetSerial_Call) Run(run func(ctx context.Context, serialNumber string)) *MockSerialService_GetSerial_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSerialService_GetSerial_Call) Return(serialLookupResponse *dto.SerialLookupResponse, err error) *MockSerialService_GetSerial_Call {
+	_c.Call.Return(serialLookupResponse, err)
+	return _c
+}
+
+func (_c *MockSerialService_GetSerial_Call) RunAndReturn(run func(ctx context.Context, serialNumber string) (*dto.SerialLookupResponse, error)) *MockSerialService_GetSerial_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStockMovementService creates a new instance of MockStockMovementService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStockMovementService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockStockMovementService {
+	mock := &MockStockMovementService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStockMovementService is an autogenerated mock type for the StockMovementService type
+type MockStockMovementService struct {
+	mock.Mock
+}
+
+type MockStockMovementService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStockMovementService) EXPECT() *MockStockMovementService_Expecter {
+	return &MockStockMovementService_Expecter{mock: &_m.Mock}
+}
+
+// GetStockMovements provides a mock function for the type MockStockMovementService
+func (_mock *MockStockMovementService) GetStockMovements(ctx context.Context, filter *dto.StockMovementFilter) (*dto.StockMovementsResponse, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockMovements")
+	}
+
+	var r0 *dto.StockMovementsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.StockMovementFilter) (*dto.StockMovementsResponse, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.StockMovementFilter) *dto.StockMovementsResponse); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StockMovementsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.StockMovementFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStockMovementService_GetStockMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStockMovements'
+type MockStockMovementService_GetStockMovements_Call struct {
+	*mock.Call
+}
+
+// GetStockMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *dto.StockMovementFilter
+func (_e *MockStockMovementService_Expecter) GetStockMovements(ctx interface{}, filter interface{}) *MockStockMovementService_GetStockMovements_Call {
+	return &MockStockMovementService_GetStockMovements_Call{Call: _e.mock.On("GetStockMovements", ctx, filter)}
+}
+
+func (_c *MockStockMovementService_GetStockMovements_Call) Run(run func(ctx context.Context, filter *dto.StockMovementFilter)) *MockStockMovementService_GetStockMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.StockMovementFilter
+		if args[1] != nil {
+			arg1 = args[1].(*dto.StockMovementFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStockMovementService_GetStockMovements_Call) Return(stockMovementsResponse *dto.StockMovementsResponse, err error) *MockStockMovementService_GetStockMovements_Call {
+	_c.Call.Return(stockMovementsResponse, err)
+	return _c
+}
+
+func (_c *MockStockMovementService_GetStockMovements_Call) RunAndReturn(run func(ctx context.Context, filter *dto.StockMovementFilter) (*dto.StockMovementsResponse, error)) *MockStockMovementService_GetStockMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStocktakeService creates a new instance of MockStocktakeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStocktakeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockStocktakeService {
+	mock := &MockStocktakeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStocktakeService is an autogenerated mock type for the StocktakeService type
+type MockStocktakeService struct {
+	mock.Mock
+}
+
+type MockStocktakeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStocktakeService) EXPECT() *MockStocktakeService_Expecter {
+	return &MockStocktakeService_Expecter{mock: &_m.Mock}
+}
+
+// CancelStocktake provides a mock function for the type MockStocktakeService
+func (_mock *MockStocktakeService) CancelStocktake(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeResponse, error) {
+	ret := _mock.Called(ctx, stocktakeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelStocktake")
+	}
+
+	var r0 *dto.StocktakeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.StocktakeResponse, error)); ok {
+		return returnFunc(ctx, stocktakeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.StocktakeResponse); ok {
+		r0 = returnFunc(ctx, stocktakeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StocktakeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, stocktakeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStocktakeService_CancelStocktake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelStocktake'
+type MockStocktakeService_CancelStocktake_Call struct {
+	*mock.Call
+}
+
+// CancelStocktake is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stocktakeID uuid.UUID
+func (_e *MockStocktakeService_Expecter) CancelStocktake(ctx interface{}, stocktakeID interface{}) *MockStocktakeService_CancelStocktake_Call {
+	return &MockStocktakeService_CancelStocktake_Call{Call: _e.mock.On("CancelStocktake", ctx, stocktakeID)}
+}
+
+func (_c *MockStocktakeService_CancelStocktake_Call) Run(run func(ctx context.Context, stocktakeID uuid.UUID)) *MockStocktakeService_CancelStocktake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStocktakeService_CancelStocktake_Call) Return(stocktakeResponse *dto.StocktakeResponse, err error) *MockStocktakeService_CancelStocktake_Call {
+	_c.Call.Return(stocktakeResponse, err)
+	return _c
+}
+
+func (_c *MockStocktakeService_CancelStocktake_Call) RunAndReturn(run func(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeResponse, error)) *MockStocktakeService_CancelStocktake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStocktake provides a mock function for the type MockStocktakeService
+func (_mock *MockStocktakeService) CreateStocktake(ctx context.Context, request *dto.StocktakeRequest) (*dto.StocktakeResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStocktake")
+	}
+
+	var r0 *dto.StocktakeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.StocktakeRequest) (*dto.StocktakeResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.StocktakeRequest) *dto.StocktakeResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StocktakeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.StocktakeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStocktakeService_CreateStocktake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStocktake'
+type MockStocktakeService_CreateStocktake_Call struct {
+	*mock.Call
+}
+
+// CreateStocktake is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.StocktakeRequest
+func (_e *MockStocktakeService_Expecter) CreateStocktake(ctx interface{}, request interface{}) *MockStocktakeService_CreateStocktake_Call {
+	return &MockStocktakeService_CreateStocktake_Call{Call: _e.mock.On("CreateStocktake", ctx, request)}
+}
+
+func (_c *MockStocktakeService_CreateStocktake_Call) Run(run func(ctx context.Context, request *dto.StocktakeRequest)) *MockStocktakeService_CreateStocktake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.StocktakeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.StocktakeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStocktakeService_CreateStocktake_Call) Return(stocktakeResponse *dto.StocktakeResponse, err error) *MockStocktakeService_CreateStocktake_Call {
+	_c.Call.Return(stocktakeResponse, err)
+	return _c
+}
+
+func (_c *MockStocktakeService_CreateStocktake_Call) RunAndReturn(run func(ctx context.Context, request *dto.StocktakeRequest) (*dto.StocktakeResponse, error)) *MockStocktakeService_CreateStocktake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStocktake provides a mock function for the type MockStocktakeService
+func (_mock *MockStocktakeService) GetStocktake(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeResponse, error) {
+	ret := _mock.Called(ctx, stocktakeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStocktake")
+	}
+
+	var r0 *dto.StocktakeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.StocktakeResponse, error)); ok {
+		return returnFunc(ctx, stocktakeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.StocktakeResponse); ok {
+		r0 = returnFunc(ctx, stocktakeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StocktakeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, stocktakeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStocktakeService_GetStocktake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStocktake'
+type MockStocktakeService_GetStocktake_Call struct {
+	*mock.Call
+}
+
+// GetStocktake is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stocktakeID uuid.UUID
+func (_e *MockStocktakeService_Expecter) GetStocktake(ctx interface{}, stocktakeID interface{}) *MockStocktakeService_GetStocktake_Call {
+	return &MockStocktakeService_GetStocktake_Call{Call: _e.mock.On("GetStocktake", ctx, stocktakeID)}
+}
+
+func (_c *MockStocktakeService_GetStocktake_Call) Run(run func(ctx context.Context, stocktakeID uuid.UUID)) *MockStocktakeService_GetStocktake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStocktakeService_GetStocktake_Call) Return(stocktakeResponse *dto.StocktakeResponse, err error) *MockStocktakeService_GetStocktake_Call {
+	_c.Call.Return(stocktakeResponse, err)
+	return _c
+}
+
+func (_c *MockStocktakeService_GetStocktake_Call) RunAndReturn(run func(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeResponse, error)) *MockStocktakeService_GetStocktake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStocktakeVariance provides a mock function for the type MockStocktakeService
+func (_mock *MockStocktakeService) GetStocktakeVariance(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeVarianceResponse, error) {
+	ret := _mock.Called(ctx, stocktakeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStocktakeVariance")
+	}
+
+	var r0 *dto.StocktakeVarianceResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.StocktakeVarianceResponse, error)); ok {
+		return returnFunc(ctx, stocktakeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.StocktakeVarianceResponse); ok {
+		r0 = returnFunc(ctx, stocktakeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StocktakeVarianceResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, stocktakeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStocktakeService_GetStocktakeVariance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStocktakeVariance'
+type MockStocktakeService_GetStocktakeVariance_Call struct {
+	*mock.Call
+}
+
+// GetStocktakeVariance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stocktakeID uuid.UUID
+func (_e *MockStocktakeService_Expecter) GetStocktakeVariance(ctx interface{}, stocktakeID interface{}) *MockStocktakeService_GetStocktakeVariance_Call {
+	return &MockStocktakeService_GetStocktakeVariance_Call{Call: _e.mock.On("GetStocktakeVariance", ctx, stocktakeID)}
+}
+
+func (_c *MockStocktakeService_GetStocktakeVariance_Call) Run(run func(ctx context.Context, stocktakeID uuid.UUID)) *MockStocktakeService_GetStocktakeVariance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStocktakeService_GetStocktakeVariance_Call) Return(stocktakeVarianceResponse *dto.StocktakeVarianceResponse, err error) *MockStocktakeService_GetStocktakeVariance_Call {
+	_c.Call.Return(stocktakeVarianceResponse, err)
+	return _c
+}
+
+func (_c *MockStocktakeService_GetStocktakeVariance_Call) RunAndReturn(run func(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeVarianceResponse, error)) *MockStocktakeService_GetStocktakeVariance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostStocktake provides a mock function for the type MockStocktakeService
+func (_mock *MockStocktakeService) PostStocktake(ctx context.Context, stocktakeID uuid.UUID, request *dto.StocktakePostRequest) (*dto.StocktakeResponse, error) {
+	ret := _mock.Called(ctx, stocktakeID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostStocktake")
+	}
+
+	var r0 *dto.StocktakeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.StocktakePostRequest) (*dto.StocktakeResponse, error)); ok {
+		return returnFunc(ctx, stocktakeID, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.StocktakePostRequest) *dto.StocktakeResponse); ok {
+		r0 = returnFunc(ctx, stocktakeID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StocktakeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.StocktakePostRequest) error); ok {
+		r1 = returnFunc(ctx, stocktakeID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStocktakeService_PostStocktake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostStocktake'
+type MockStocktakeService_PostStocktake_Call struct {
+	*mock.Call
+}
+
+// PostStocktake is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stocktakeID uuid.UUID
+//   - request *dto.StocktakePostRequest
+func (_e *MockStocktakeService_Expecter) PostStocktake(ctx interface{}, stocktakeID interface{}, request interface{}) *MockStocktakeService_PostStocktake_Call {
+	return &MockStocktakeService_PostStocktake_Call{Call: _e.mock.On("PostStocktake", ctx, stocktakeID, request)}
+}
+
+func (_c *MockStocktakeService_PostStocktake_Call) Run(run func(ctx context.Context, stocktakeID uuid.UUID, request *dto.StocktakePostRequest)) *MockStocktakeService_PostStocktake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *dto.StocktakePostRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.StocktakePostRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStocktakeService_PostStocktake_Call) Return(stocktakeResponse *dto.StocktakeResponse, err error) *MockStocktakeService_PostStocktake_Call {
+	_c.Call.Return(stocktakeResponse, err)
+	return _c
+}
+
+func (_c *MockStocktakeService_PostStocktake_Call) RunAndReturn(run func(ctx context.Context, stocktakeID uuid.UUID, request *dto.StocktakePostRequest) (*dto.StocktakeResponse, error)) *MockStocktakeService_PostStocktake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitStocktakeCounts provides a mock function for the type MockStocktakeService
+func (_mock *MockStocktakeService) SubmitStocktakeCounts(ctx context.Context, stocktakeID uuid.UUID, request *dto.StocktakeCountsRequest) (*dto.StocktakeResponse, error) {
+	ret := _mock.Called(ctx, stocktakeID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitStocktakeCounts")
+	}
+
+	var r0 *dto.StocktakeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.StocktakeCountsRequest) (*dto.StocktakeResponse, error)); ok {
+		return returnFunc(ctx, stocktakeID, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.StocktakeCountsRequest) *dto.StocktakeResponse); ok {
+		r0 = returnFunc(ctx, stocktakeID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StocktakeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.StocktakeCountsRequest) error); ok {
+		r1 = returnFunc(ctx, stocktakeID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStocktakeService_SubmitStocktakeCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitStocktakeCounts'
+type MockStocktakeService_SubmitStocktakeCounts_Call struct {
+	*mock.Call
+}
+
+// SubmitStocktakeCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stocktakeID uuid.UUID
+//   - request *dto.StocktakeCountsRequest
+func (_e *MockStocktakeService_Expecter) SubmitStocktakeCounts(ctx interface{}, stocktakeID interface{}, request interface{}) *MockStocktakeService_SubmitStocktakeCounts_Call {
+	return &MockStocktakeService_SubmitStocktakeCounts_Call{Call: _e.mock.On("SubmitStocktakeCounts", ctx, stocktakeID, request)}
+}
+
+func (_c *MockStocktakeService_SubmitStocktakeCounts_Call) Run(run func(ctx context.Context, stocktakeID uuid.UUID, request *dto.StocktakeCountsRequest)) *MockStocktakeService_SubmitStocktakeCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *dto.StocktakeCountsRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.StocktakeCountsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStocktakeService_SubmitStocktakeCounts_Call) Return(stocktakeResponse *dto.StocktakeResponse, err error) *MockStocktakeService_SubmitStocktakeCounts_Call {
+	_c.Call.Return(stocktakeResponse, err)
+	return _c
+}
+
+func (_c *MockStocktakeService_SubmitStocktakeCounts_Call) RunAndReturn(run func(ctx context.Context, stocktakeID uuid.UUID, request *dto.StocktakeCountsRequest) (*dto.StocktakeResponse, error)) *MockStocktakeService_SubmitStocktakeCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSupplierService creates a new instance of MockSupplierService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSupplierService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockSupplierService {
+	mock := &MockSupplierService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSupplierService is an autogenerated mock type for the SupplierService type
+type MockSupplierService struct {
+	mock.Mock
+}
+
+type MockSupplierService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSupplierService) EXPECT() *MockSupplierService_Expecter {
+	return &MockSupplierService_Expecter{mock: &_m.Mock}
+}
+
+// CreateSupplier provides a mock function for the type MockSupplierService
+func (_mock *MockSupplierService) CreateSupplier(ctx context.Context, request *dto.SupplierRequest) (*dto.SupplierResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSupplier")
+	}
+
+	var r0 *dto.SupplierResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.SupplierRequest) (*dto.SupplierResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.SupplierRequest) *dto.SupplierResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SupplierResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.SupplierRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSupplierService_CreateSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSupplier'
+type MockSupplierService_CreateSupplier_Call struct {
+	*mock.Call
+}
+
+// CreateSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.SupplierRequest
+func (_e *MockSupplierService_Expecter) CreateSupplier(ctx interface{}, request interface{}) *MockSupplierService_CreateSupplier_Call {
+	return &MockSupplierService_CreateSupplier_Call{Call: _e.mock.On("CreateSupplier", ctx, request)}
+}
+
+func (_c *MockSupplierService_CreateSupplier_Call) Run(run func(ctx context.Context, request *dto.SupplierRequest)) *MockSupplierService_CreateSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.SupplierRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.SupplierRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSupplierService_CreateSupplier_Call) Return(supplierResponse *dto.SupplierResponse, err error) *MockSupplierService_CreateSupplier_Call {
+	_c.Call.Return(supplierResponse, err)
+	return _c
+}
+
+func (_c *MockSupplierService_CreateSupplier_Call) RunAndReturn(run func(ctx context.Context, request *dto.SupplierRequest) (*dto.SupplierResponse, error)) *MockSupplierService_CreateSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSuppliers provides a mock function for the type MockSupplierService
+func (_mock *MockSupplierService) GetSuppliers(ctx context.Context) ([]*dto.SupplierResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSuppliers")
+	}
+
+	var r0 []*dto.SupplierResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*dto.SupplierResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*dto.SupplierResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.SupplierResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSupplierService_GetSuppliers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSuppliers'
+type MockSupplierService_GetSuppliers_Call struct {
+	*mock.Call
+}
+
+// GetSuppliers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSupplierService_Expecter) GetSuppliers(ctx interface{}) *MockSupplierService_GetSuppliers_Call {
+	return &MockSupplierService_GetSuppliers_Call{Call: _e.mock.On("GetSuppliers", ctx)}
+}
+
+func (_c *MockSupplierService_GetSuppliers_Call) Run(run func(ctx context.Context)) *MockSupplierService_GetSuppliers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSupplierService_GetSuppliers_Call) Return(supplierResponses []*dto.SupplierResponse, err error) *MockSupplierService_GetSuppliers_Call {
+	_c.Call.Return(supplierResponses, err)
+	return _c
+}
+
+func (_c *MockSupplierService_GetSuppliers_Call) RunAndReturn(run func(ctx context.Context) ([]*dto.SupplierResponse, error)) *MockSupplierService_GetSuppliers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTransferService creates a new instance of MockTransferService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransferService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockTransferService {
+	mock := &MockTransferService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTransferService is an autogenerated mock type for the TransferService type
+type MockTransferService struct {
+	mock.Mock
+}
+
+type MockTransferService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTransferService) EXPECT() *MockTransferService_Expecter {
+	return &MockTransferService_Expecter{mock: &_m.Mock}
+}
+
+// CreateTransfer provides a mock function for the type MockTransferService
+func (_mock *MockTransferService) CreateTransfer(ctx context.Context, request *dto.TransferRequest) (*dto.TransferResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTransfer")
+	}
+
+	var r0 *dto.TransferResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TransferRequest) (*dto.TransferResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TransferRequest) *dto.TransferResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TransferResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TransferRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransferService_CreateTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransfer'
+type MockTransferService_CreateTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.TransferRequest
+func (_e *MockTransferService_Expecter) CreateTransfer(ctx interface{}, request interface{}) *MockTransferService_CreateTransfer_Call {
+	return &MockTransferService_CreateTransfer_Call{Call: _e.mock.On("CreateTransfer", ctx, request)}
+}
+
+func (_c *MockTransferService_CreateTransfer_Call) Run(run func(ctx context.Context, request *dto.TransferRequest)) *MockTransferService_CreateTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TransferRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TransferRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransferService_CreateTransfer_Call) Return(transferResponse *dto.TransferResponse, err error) *MockTransferService_CreateTransfer_Call {
+	_c.Call.Return(transferResponse, err)
+	return _c
+}
+
+func (_c *MockTransferService_CreateTransfer_Call) RunAndReturn(run func(ctx context.Context, request *dto.TransferRequest) (*dto.TransferResponse, error)) *MockTransferService_CreateTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransfer provides a mock function for the type MockTransferService
+func (_mock *MockTransferService) GetTransfer(ctx context.Context, transferID uuid.UUID) (*dto.TransferResponse, error) {
+	ret := _mock.Called(ctx, transferID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransfer")
+	}
+
+	var r0 *dto.TransferResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.TransferResponse, error)); ok {
+		return returnFunc(ctx, transferID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.TransferResponse); ok {
+		r0 = returnFunc(ctx, transferID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TransferResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, transferID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransferService_GetTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransfer'
+type MockTransferService_GetTransfer_Call struct {
+	*mock.Call
+}
+
+// GetTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transferID uuid.UUID
+func (_e *MockTransferService_Expecter) GetTransfer(ctx interface{}, transferID interface{}) *MockTransferService_GetTransfer_Call {
+	return &MockTransferService_GetTransfer_Call{Call: _e.mock.On("GetTransfer", ctx, transferID)}
+}
+
+func (_c *MockTransferService_GetTransfer_Call) Run(run func(ctx context.Context, transferID uuid.UUID)) *MockTransferService_GetTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransferService_GetTransfer_Call) Return(transferResponse *dto.TransferResponse, err error) *MockTransferService_GetTransfer_Call {
+	_c.Call.Return(transferResponse, err)
+	return _c
+}
+
+func (_c *MockTransferService_GetTransfer_Call) RunAndReturn(run func(ctx context.Context, transferID uuid.UUID) (*dto.TransferResponse, error)) *MockTransferService_GetTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveTransfer provides a mock function for the type MockTransferService
+func (_mock *MockTransferService) ReceiveTransfer(ctx context.Context, transferID uuid.UUID) (*dto.TransferResponse, error) {
+	ret := _mock.Called(ctx, transferID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveTransfer")
+	}
+
+	var r0 *dto.TransferResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.TransferResponse, error)); ok {
+		return returnFunc(ctx, transferID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.TransferResponse); ok {
+		r0 = returnFunc(ctx, transferID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TransferResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, transferID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransferService_ReceiveTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveTransfer'
+type MockTransferService_ReceiveTransfer_Call struct {
+	*mock.Call
+}
+
+// ReceiveTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transferID uuid.UUID
+func (_e *MockTransferService_Expecter) ReceiveTransfer(ctx interface{}, transferID interface{}) *MockTransferService_ReceiveTransfer_Call {
+	return &MockTransferService_ReceiveTransfer_Call{Call: _e.mock.On("ReceiveTransfer", ctx, transferID)}
+}
+
+func (_c *MockTransferService_ReceiveTransfer_Call) Run(run func(ctx context.Context, transferID uuid.UUID)) *MockTransferService_ReceiveTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransferService_ReceiveTransfer_Call) Return(transferResponse *dto.TransferResponse, err error) *MockTransferService_ReceiveTransfer_Call {
+	_c.Call.Return(transferResponse, err)
+	return _c
+}
+
+func (_c *MockTransferService_ReceiveTransfer_Call) RunAndReturn(run func(ctx context.Context, transferID uuid.UUID) (*dto.TransferResponse, error)) *MockTransferService_ReceiveTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockValuationService creates a new instance of MockValuationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockValuationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockValuationService {
+	mock := &MockValuationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockValuationService is an autogenerated mock type for the ValuationService type
+type MockValuationService struct {
+	mock.Mock
+}
+
+type MockValuationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockValuationService) EXPECT() *MockValuationService_Expecter {
+	return &MockValuationService_Expecter{mock: &_m.Mock}
+}
+
+// GetStockValuation provides a mock function for the type MockValuationService
+func (_mock *MockValuationService) GetStockValuation(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.StockValuationResponse, error) {
+	ret := _mock.Called(ctx, params, warehouseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockValuation")
+	}
+
+	var r0 *dto.StockValuationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.Pagination, string) (*dto.StockValuationResponse, error)); ok {
+		return returnFunc(ctx, params, warehouseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.Pagination, string) *dto.StockValuationResponse); ok {
+		r0 = returnFunc(ctx, params, warehouseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StockValuationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.Pagination, string) error); ok {
+		r1 = returnFunc(ctx, params, warehouseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockValuationService_GetStockValuation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStockValuation'
+type MockValuationService_GetStockValuation_Call struct {
+	*mock.Call
+}
+
+// GetStockValuation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *dto.Pagination
+//   - warehouseID string
+func (_e *MockValuationService_Expecter) GetStockValuation(ctx interface{}, params interface{}, warehouseID interface{}) *MockValuationService_GetStockValuation_Call {
+	return &MockValuationService_GetStockValuation_Call{Call: _e.mock.On("GetStockValuation", ctx, params, warehouseID)}
+}
+
+func (_c *MockValuationService_GetStockValuation_Call) Run(run func(ctx context.Context, params *dto.Pagination, warehouseID string)) *MockValuationService_GetStockValuation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.Pagination
+		if args[1] != nil {
+			arg1 = args[1].(*dto.Pagination)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockValuationService_GetStockValuation_Call) Return(stockValuationResponse *dto.StockValuationResponse, err error) *MockValuationService_GetStockValuation_Call {
+	_c.Call.Return(stockValuationResponse, err)
+	return _c
+}
+
+func (_c *MockValuationService_GetStockValuation_Call) RunAndReturn(run func(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.StockValuationResponse, error)) *MockValuationService_GetStockValuation_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,377 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// OrderService определяет методы для работы с заказами.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type OrderService interface {
+	GetOrder(ctx context.Context, orderID uuid.UUID) (*dto.OrderResponse, error)
+	GetOrders(ctx context.Context, filter *dto.OrderFilter) (*dto.OrdersResponse, error)
+	UpdateOrderStatus(ctx context.Context, orderID uuid.UUID, request *dto.OrderStatusRequest) (*dto.OrderResponse, error)
+	CancelOrder(ctx context.Context, orderID uuid.UUID, request *dto.OrderCancelRequest) (*dto.OrderReturnResponse, error)
+	ReturnOrderProducts(ctx context.Context, orderID uuid.UUID, request *dto.OrderReturnRequest) (*dto.OrderReturnResponse, error)
+}
+
+// OrderHandler обрабатывает запросы, связанные с заказами.
+type OrderHandler struct {
+	service OrderService
+}
+
+// NewOrderHandler создает новый экземпляр OrderHandler с заданным сервисом заказов.
+func NewOrderHandler(service OrderService) *OrderHandler {
+	return &OrderHandler{
+		service: service,
+	}
+}
+
+// GetOrder обрабатывает запросы на получение заказа.
+func (h *OrderHandler) GetOrder(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.OrderHandler.GetOrder"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	orderID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong order ID")
+		return
+	}
+
+	response, err := h.service.GetOrder(r.Context(), orderID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrOrderNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while getting order", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting order")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// GetOrders обрабатывает запросы на получение списка заказов.
+func (h *OrderHandler) GetOrders(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.OrderHandler.GetOrders"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	filter, err := parseOrderFilter(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.service.GetOrders(r.Context(), filter)
+	if err != nil {
+		log.Error("error while getting orders", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting orders")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parseOrderFilter извлекает параметры выборки заказов из параметров запроса.
+func parseOrderFilter(r *http.Request) (*dto.OrderFilter, error) {
+	warehouseID := r.URL.Query().Get("warehouse_id")
+	if warehouseID != "" {
+		if err := uuid.Validate(warehouseID); err != nil {
+			return nil, fmt.Errorf("warehouse id is not valid")
+		}
+	}
+
+	from, err := parseTimeQuery(r, "from")
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := parseTimeQuery(r, "to")
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.OrderFilter{
+		WarehouseID: warehouseID,
+		From:        from,
+		To:          to,
+		Pagination:  parseParams(r),
+	}, nil
+}
+
+// UpdateOrderStatus обрабатывает запросы на изменение статуса заказа.
+func (h *OrderHandler) UpdateOrderStatus(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.OrderHandler.UpdateOrderStatus"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	orderID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong order ID")
+		return
+	}
+
+	statusReq, err := parseOrderStatusRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing order status", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	validErr := validateOrderStatusRequest(statusReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.UpdateOrderStatus(r.Context(), orderID, statusReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrOrderNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case custErr.Any(err, custErr.ErrInvalidOrderTransition, custErr.ErrOrderBackordered):
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+		default:
+			log.Error("error while updating order status", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while updating order status")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parseOrderStatusRequest извлекает новый статус заказа из запроса.
+func parseOrderStatusRequest(r io.Reader) (*dto.OrderStatusRequest, error) {
+	var req dto.OrderStatusRequest
+
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// validateOrderStatusRequest проверяет, что запрошен известный статус заказа.
+//
+// Отмена заказа возвращает товары на склад, поэтому выполняется отдельным запросом.
+func validateOrderStatusRequest(req *dto.OrderStatusRequest) map[string]any {
+	switch domain.OrderStatus(req.Status) {
+	case domain.OrderPaid, domain.OrderShipped:
+		return nil
+	case domain.OrderCancelled:
+		return map[string]any{"status": "use cancel request to cancel order"}
+	case "":
+		return map[string]any{"status": "this field cannot be empty"}
+	default:
+		return map[string]any{"status": "status must be one of: paid, shipped"}
+	}
+}
+
+// CancelOrder обрабатывает запросы на отмену заказа с возвратом товаров на склад.
+func (h *OrderHandler) CancelOrder(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.OrderHandler.CancelOrder"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	orderID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong order ID")
+		return
+	}
+
+	// тело запроса необязательно: без него заказ отменяется с причиной по умолчанию.
+	var cancelReq dto.OrderCancelRequest
+	err = json.NewDecoder(r.Body).Decode(&cancelReq)
+	if err != nil && !errors.Is(err, io.EOF) {
+		log.Error("error while parsing cancel request", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	response, err := h.service.CancelOrder(r.Context(), orderID, &cancelReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrOrderNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, custErr.ErrInvalidOrderTransition):
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+		default:
+			log.Error("error while cancelling order", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while cancelling order")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// ReturnOrderProducts обрабатывает запросы на возврат части товаров заказа.
+func (h *OrderHandler) ReturnOrderProducts(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.OrderHandler.ReturnOrderProducts"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	orderID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong order ID")
+		return
+	}
+
+	returnReq, err := parseOrderReturnRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing return request", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	validErr := validateOrderReturnRequest(returnReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.ReturnOrderProducts(r.Context(), orderID, returnReq)
+	if err != nil {
+		if writeSerialError(w, err) {
+			return
+		}
+
+		switch {
+		case errors.Is(err, custErr.ErrOrderNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, custErr.ErrOrderNotReturnable):
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+		case custErr.Any(err, custErr.ErrProductNotInOrder, custErr.ErrReturnExceedsOrder):
+			custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		default:
+			log.Error("error while returning products", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while returning products")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parseOrderReturnRequest извлекает данные возврата из запроса.
+func parseOrderReturnRequest(r io.Reader) (*dto.OrderReturnRequest, error) {
+	var req dto.OrderReturnRequest
+
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// validateOrderReturnRequest проверяет корректность данных возврата.
+func validateOrderReturnRequest(req *dto.OrderReturnRequest) map[string]any {
+	validErr := make(map[string]any)
+
+	if req.Reason == "" {
+		validErr["reason"] = "this field cannot be empty"
+	}
+
+	if len(req.Products) == 0 {
+		validErr["products"] = "there is no products to return"
+	} else {
+		var productsID []string
+		productsErr := make(map[int]any)
+		for idx, product := range req.Products {
+			if slices.Contains(productsID, product.ProductID) {
+				productsErr[idx] = map[string]string{"product_id": "product ID must be unique"}
+				continue
+			}
+			productsID = append(productsID, product.ProductID)
+			productErr := validateOrderReturnProduct(product)
+			if productErr != nil {
+				productsErr[idx] = productErr
+			}
+		}
+
+		if len(productsErr) != 0 {
+			validErr["products"] = productsErr
+		}
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
+
+// validateOrderReturnProduct проверяет корректность данных возвращаемого товара.
+func validateOrderReturnProduct(product *dto.OrderReturnProductRequest) map[string]string {
+	productErr := make(map[string]string)
+	if product.ProductID == "" {
+		productErr["product_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(product.ProductID); err != nil {
+		productErr["product_id"] = "invalid product ID"
+	}
+
+	if product.Count == nil {
+		productErr["product_count"] = "this field cannot be empty"
+	} else if *product.Count <= 0 {
+		productErr["product_count"] = "product count must be greater than 0"
+	}
+
+	if msg := validateSerials(product.Serials, product.Count); msg != "" {
+		productErr["serials"] = msg
+	}
+
+	if len(productErr) != 0 {
+		return productErr
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testOrderID = "3f2e1d0c-9b8a-4765-8432-10fedcba9876"
+
+func TestUpdateOrderStatus(t *testing.T) {
+	cases := []struct {
+		Name         string
+		Method       string
+		OrderID      string
+		Body         string
+		CallService  bool
+		ReturnStatus string
+		ReturnError  error
+		StatusCode   int
+		ResponseBody string
+	}{
+		{
+			Name:         "Paid",
+			Method:       http.MethodPost,
+			OrderID:      testOrderID,
+			Body:         `{"status":"paid"}`,
+			CallService:  true,
+			ReturnStatus: "paid",
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"order_id":"` + testOrderID + `","warehouse_id":"","status":"paid","lines":null,"total_price":0,"total_price_with_discount":0,"promo_discount":0,"total_to_pay":0,"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			Name:         "Shipped",
+			Method:       http.MethodPost,
+			OrderID:      testOrderID,
+			Body:         `{"status":"shipped"}`,
+			CallService:  true,
+			ReturnStatus: "shipped",
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"order_id":"` + testOrderID + `","warehouse_id":"","status":"shipped","lines":null,"total_price":0,"total_price_with_discount":0,"promo_discount":0,"total_to_pay":0,"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			Name:         "Wrong method",
+			Method:       http.MethodGet,
+			OrderID:      testOrderID,
+			StatusCode:   http.StatusMethodNotAllowed,
+			ResponseBody: ``,
+		},
+		{
+			Name:         "Wrong order ID",
+			Method:       http.MethodPost,
+			OrderID:      "order",
+			Body:         `{"status":"paid"}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"wrong order ID"}`,
+		},
+		{
+			Name:         "Wrong body",
+			Method:       http.MethodPost,
+			OrderID:      testOrderID,
+			Body:         `{"status":`,
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"error":"wrong request body"}`,
+		},
+		{
+			Name:         "Cancelled is rejected",
+			Method:       http.MethodPost,
+			OrderID:      testOrderID,
+			Body:         `{"status":"cancelled"}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"status":"use cancel request to cancel order"}`,
+		},
+		{
+			Name:         "Created is rejected",
+			Method:       http.MethodPost,
+			OrderID:      testOrderID,
+			Body:         `{"status":"created"}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"status":"status must be one of: paid, shipped"}`,
+		},
+		{
+			Name:         "Empty status",
+			Method:       http.MethodPost,
+			OrderID:      testOrderID,
+			Body:         `{}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"status":"this field cannot be empty"}`,
+		},
+		{
+			Name:         "Not found",
+			Method:       http.MethodPost,
+			OrderID:      testOrderID,
+			Body:         `{"status":"paid"}`,
+			CallService:  true,
+			ReturnError:  custErr.ErrOrderNotFound,
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"error":"order not found"}`,
+		},
+		{
+			Name:         "Invalid transition",
+			Method:       http.MethodPost,
+			OrderID:      testOrderID,
+			Body:         `{"status":"shipped"}`,
+			CallService:  true,
+			ReturnError:  custErr.ErrInvalidOrderTransition,
+			StatusCode:   http.StatusConflict,
+			ResponseBody: `{"error":"order cannot be moved to this status"}`,
+		},
+		{
+			Name:         "Backordered",
+			Method:       http.MethodPost,
+			OrderID:      testOrderID,
+			Body:         `{"status":"shipped"}`,
+			CallService:  true,
+			ReturnError:  custErr.ErrOrderBackordered,
+			StatusCode:   http.StatusConflict,
+			ResponseBody: `{"error":"` + custErr.ErrOrderBackordered.Error() + `"}`,
+		},
+		{
+			Name:         "Service error",
+			Method:       http.MethodPost,
+			OrderID:      testOrderID,
+			Body:         `{"status":"paid"}`,
+			CallService:  true,
+			ReturnError:  errors.New("internal server error"),
+			StatusCode:   http.StatusInternalServerError,
+			ResponseBody: `{"error":"error while updating order status"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockService := NewMockOrderService(t)
+			if tc.CallService {
+				var response *dto.OrderResponse
+				if tc.ReturnError == nil {
+					response = &dto.OrderResponse{OrderID: testOrderID, Status: tc.ReturnStatus}
+				}
+				mockService.On("UpdateOrderStatus", mock.Anything, uuid.MustParse(tc.OrderID), mock.AnythingOfType("*dto.OrderStatusRequest")).
+					Return(response, tc.ReturnError).
+					Once()
+			}
+
+			logger.CreateNOPLogger()
+
+			handler := NewOrderHandler(mockService)
+			req := httptest.NewRequest(tc.Method, "/api/orders/"+tc.OrderID+"/status", strings.NewReader(tc.Body))
+			req.SetPathValue("id", tc.OrderID)
+
+			rr := httptest.NewRecorder()
+
+			handler.UpdateOrderStatus(rr, req)
+			require.Equal(t, tc.StatusCode, rr.Code)
+
+			if tc.ResponseBody == "" {
+				assert.Empty(t, rr.Body.String())
+			} else {
+				assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PriceHistoryService определяет методы для работы с историей цен товаров.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type PriceHistoryService interface {
+	GetPriceHistory(ctx context.Context, filter *dto.PriceHistoryFilter) (*dto.PriceHistoryResponse, error)
+}
+
+// PriceHistoryHandler обрабатывает запросы, связанные с историей цен товаров.
+type PriceHistoryHandler struct {
+	service PriceHistoryService
+}
+
+// NewPriceHistoryHandler создает новый экземпляр PriceHistoryHandler с заданным сервисом.
+func NewPriceHistoryHandler(service PriceHistoryService) *PriceHistoryHandler {
+	return &PriceHistoryHandler{
+		service: service,
+	}
+}
+
+// GetPriceHistory обрабатывает запросы на получение истории цен товаров на складе.
+func (h *PriceHistoryHandler) GetPriceHistory(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PriceHistoryHandler.GetPriceHistory"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	filter, err := parsePriceHistoryFilter(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.service.GetPriceHistory(r.Context(), filter)
+	if err != nil {
+		log.Error("error while getting price history", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting price history")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parsePriceHistoryFilter извлекает параметры выборки истории цен из пути и параметров запроса.
+func parsePriceHistoryFilter(r *http.Request) (*dto.PriceHistoryFilter, error) {
+	warehouseID, err := parsePathUUID(r, "id")
+	if err != nil {
+		return nil, fmt.Errorf("warehouse id is not valid")
+	}
+
+	productID := r.URL.Query().Get("product_id")
+	if productID != "" {
+		if err := uuid.Validate(productID); err != nil {
+			return nil, fmt.Errorf("product id is not valid")
+		}
+	}
+
+	from, err := parseTimeQuery(r, "from")
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := parseTimeQuery(r, "to")
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PriceHistoryFilter{
+		WarehouseID: warehouseID.String(),
+		ProductID:   productID,
+		From:        from,
+		To:          to,
+		Pagination:  parseParams(r),
+	}, nil
+}
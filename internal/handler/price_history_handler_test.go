@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPriceHistory(t *testing.T) {
+	const (
+		warehouseID = "17b79680-4657-4ef4-9c3d-554a83c31828"
+		productID   = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+	)
+
+	cases := []struct {
+		Name         string
+		Method       string
+		WarehouseID  string
+		Query        string
+		WantFilter   *dto.PriceHistoryFilter
+		ReturnError  error
+		StatusCode   int
+		ResponseBody string
+	}{
+		{
+			Name:        "Success with defaults",
+			Method:      http.MethodGet,
+			WarehouseID: warehouseID,
+			WantFilter: &dto.PriceHistoryFilter{
+				WarehouseID: warehouseID,
+				Pagination:  &dto.Pagination{Page: 1, Offset: 0, Limit: 10},
+			},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"page":1,"limit":10,"changes":[{"history_id":"1f0c3f4e-8a52-4c1b-9d3e-6b7a8c9d0e1f","product_id":"` + productID + `","old_price":100.00,"new_price":120.50,"old_discount":0,"new_discount":5,"changed_at":"2025-03-01T10:00:00Z"}]}`,
+		},
+		{
+			Name:        "Success with filters",
+			Method:      http.MethodGet,
+			WarehouseID: warehouseID,
+			Query:       "product_id=" + productID + "&from=2025-03-01&to=2025-03-31T23:59:59Z&page=2&limit=5",
+			WantFilter: &dto.PriceHistoryFilter{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				From:        ptr(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+				To:          ptr(time.Date(2025, 3, 31, 23, 59, 59, 0, time.UTC)),
+				Pagination:  &dto.Pagination{Page: 2, Offset: 5, Limit: 5},
+			},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"page":1,"limit":10,"changes":[{"history_id":"1f0c3f4e-8a52-4c1b-9d3e-6b7a8c9d0e1f","product_id":"` + productID + `","old_price":100.00,"new_price":120.50,"old_discount":0,"new_discount":5,"changed_at":"2025-03-01T10:00:00Z"}]}`,
+		},
+		{
+			Name:         "Wrong method",
+			Method:       http.MethodPost,
+			WarehouseID:  warehouseID,
+			StatusCode:   http.StatusMethodNotAllowed,
+			ResponseBody: ``,
+		},
+		{
+			Name:         "Wrong warehouse ID",
+			Method:       http.MethodGet,
+			WarehouseID:  "warehouse",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"warehouse id is not valid"}`,
+		},
+		{
+			Name:         "Wrong product ID",
+			Method:       http.MethodGet,
+			WarehouseID:  warehouseID,
+			Query:        "product_id=product",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"product id is not valid"}`,
+		},
+		{
+			Name:         "Wrong from",
+			Method:       http.MethodGet,
+			WarehouseID:  warehouseID,
+			Query:        "from=yesterday",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"from must be in RFC 3339 or YYYY-MM-DD format"}`,
+		},
+		{
+			Name:        "Service error",
+			Method:      http.MethodGet,
+			WarehouseID: warehouseID,
+			WantFilter: &dto.PriceHistoryFilter{
+				WarehouseID: warehouseID,
+				Pagination:  &dto.Pagination{Page: 1, Offset: 0, Limit: 10},
+			},
+			ReturnError:  errors.New("internal server error"),
+			StatusCode:   http.StatusInternalServerError,
+			ResponseBody: `{"error":"error while getting price history"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockService := NewMockPriceHistoryService(t)
+			if tc.WantFilter != nil {
+				var response *dto.PriceHistoryResponse
+				if tc.ReturnError == nil {
+					response = &dto.PriceHistoryResponse{
+						Page:  1,
+						Limit: 10,
+						Changes: []*dto.PriceChangeResponse{{
+							HistoryID: "1f0c3f4e-8a52-4c1b-9d3e-6b7a8c9d0e1f",
+							ProductID: productID,
+							OldPrice:  10000,
+							NewPrice:  12050,
+							NewSale:   5,
+							ChangedAt: ptr(time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)),
+						}},
+					}
+				}
+				mockService.On("GetPriceHistory", mock.Anything, tc.WantFilter).
+					Return(response, tc.ReturnError).
+					Once()
+			}
+
+			logger.CreateNOPLogger()
+
+			handler := NewPriceHistoryHandler(mockService)
+			req := httptest.NewRequest(tc.Method, "/api/warehouse/"+tc.WarehouseID+"/price_history?"+tc.Query, nil)
+			req.SetPathValue("id", tc.WarehouseID)
+
+			rr := httptest.NewRecorder()
+
+			handler.GetPriceHistory(rr, req)
+			require.Equal(t, tc.StatusCode, rr.Code)
+
+			if tc.ResponseBody == "" {
+				assert.Empty(t, rr.Body.String())
+			} else {
+				assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PricingRuleService определяет методы для работы с правилами ценообразования.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type PricingRuleService interface {
+	CreatePricingRule(ctx context.Context, request *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)
+	GetPricingRule(ctx context.Context, ruleID uuid.UUID) (*dto.PricingRuleResponse, error)
+	GetPricingRules(ctx context.Context, filter *dto.PricingRuleFilter) (*dto.PricingRulesResponse, error)
+	UpdatePricingRule(ctx context.Context, ruleID uuid.UUID, request *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)
+	DeletePricingRule(ctx context.Context, ruleID uuid.UUID) error
+}
+
+// PricingRuleHandler обрабатывает запросы, связанные с правилами ценообразования.
+type PricingRuleHandler struct {
+	service PricingRuleService
+}
+
+// NewPricingRuleHandler создает новый экземпляр PricingRuleHandler с заданным сервисом правил ценообразования.
+func NewPricingRuleHandler(service PricingRuleService) *PricingRuleHandler {
+	return &PricingRuleHandler{
+		service: service,
+	}
+}
+
+// PricingRulesHandler обрабатывает запросы к списку правил ценообразования.
+func (h *PricingRuleHandler) PricingRulesHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetPricingRules(w, r)
+	case http.MethodPost:
+		h.CreatePricingRule(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// PricingRuleHandler обрабатывает запросы к одному правилу ценообразования.
+func (h *PricingRuleHandler) PricingRuleHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetPricingRule(w, r)
+	case http.MethodPut:
+		h.UpdatePricingRule(w, r)
+	case http.MethodDelete:
+		h.DeletePricingRule(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// CreatePricingRule обрабатывает запросы на создание правила ценообразования.
+func (h *PricingRuleHandler) CreatePricingRule(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PricingRuleHandler.CreatePricingRule"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	ruleReq, err := parsePricingRuleRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing pricing rule", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	validErr := validatePricingRuleRequest(ruleReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.CreatePricingRule(r.Context(), ruleReq)
+	if err != nil {
+		if errors.Is(err, custErr.ErrForeignKey) {
+			custErr.UnnamedError(w, http.StatusBadRequest, "wrong warehouse ID")
+			return
+		}
+		log.Error("error while creating pricing rule", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while creating pricing rule")
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, response)
+}
+
+// GetPricingRules обрабатывает запросы на получение списка правил ценообразования.
+func (h *PricingRuleHandler) GetPricingRules(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PricingRuleHandler.GetPricingRules"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	warehouseID := r.URL.Query().Get("warehouse_id")
+	if warehouseID != "" {
+		if err := uuid.Validate(warehouseID); err != nil {
+			custErr.UnnamedError(w, http.StatusBadRequest, "warehouse id is not valid")
+			return
+		}
+	}
+
+	filter := &dto.PricingRuleFilter{
+		WarehouseID: warehouseID,
+		Pagination:  parseParams(r),
+	}
+
+	response, err := h.service.GetPricingRules(r.Context(), filter)
+	if err != nil {
+		log.Error("error while getting pricing rules", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting pricing rules")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// GetPricingRule обрабатывает запросы на получение правила ценообразования.
+func (h *PricingRuleHandler) GetPricingRule(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PricingRuleHandler.GetPricingRule"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	ruleID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong pricing rule ID")
+		return
+	}
+
+	response, err := h.service.GetPricingRule(r.Context(), ruleID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrPricingRuleNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while getting pricing rule", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting pricing rule")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// UpdatePricingRule обрабатывает запросы на изменение правила ценообразования.
+func (h *PricingRuleHandler) UpdatePricingRule(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PricingRuleHandler.UpdatePricingRule"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	ruleID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong pricing rule ID")
+		return
+	}
+
+	ruleReq, err := parsePricingRuleRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing pricing rule", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	validErr := validatePricingRuleRequest(ruleReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.UpdatePricingRule(r.Context(), ruleID, ruleReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrPricingRuleNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, custErr.ErrForeignKey):
+			custErr.UnnamedError(w, http.StatusBadRequest, "wrong warehouse ID")
+		default:
+			log.Error("error while updating pricing rule", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while updating pricing rule")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// DeletePricingRule обрабатывает запросы на удаление правила ценообразования.
+func (h *PricingRuleHandler) DeletePricingRule(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PricingRuleHandler.DeletePricingRule"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	ruleID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong pricing rule ID")
+		return
+	}
+
+	err = h.service.DeletePricingRule(r.Context(), ruleID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrPricingRuleNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while deleting pricing rule", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while deleting pricing rule")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parsePricingRuleRequest извлекает правило ценообразования из запроса.
+func parsePricingRuleRequest(r io.Reader) (*dto.PricingRuleRequest, error) {
+	var req dto.PricingRuleRequest
+
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// validatePricingRuleRequest проверяет корректность правила ценообразования.
+func validatePricingRuleRequest(req *dto.PricingRuleRequest) map[string]any {
+	validErr := make(map[string]any)
+
+	if req.Name == "" {
+		validErr["name"] = "this field cannot be empty"
+	}
+
+	if req.WarehouseID != "" {
+		if err := uuid.Validate(req.WarehouseID); err != nil {
+			validErr["warehouse_id"] = "invalid warehouse ID"
+		}
+	}
+
+	switch domain.PricingRuleType(req.Type) {
+	case domain.PricingTier:
+		validateRuleProductID(validErr, req.ProductID)
+		if req.MinCount == nil {
+			validErr["min_count"] = "this field cannot be empty"
+		} else if *req.MinCount < 2 {
+			validErr["min_count"] = "min count must be at least 2"
+		}
+		if req.UnitPrice == nil {
+			validErr["unit_price"] = "this field cannot be empty"
+		} else if *req.UnitPrice <= 0 {
+			validErr["unit_price"] = "unit price must be greater than 0"
+		}
+	case domain.PricingNForM:
+		validateRuleProductID(validErr, req.ProductID)
+		switch {
+		case req.Buy == nil:
+			validErr["buy"] = "this field cannot be empty"
+		case *req.Buy < 2:
+			validErr["buy"] = "buy must be at least 2"
+		case req.Pay == nil:
+			validErr["pay"] = "this field cannot be empty"
+		case *req.Pay < 1 || *req.Pay >= *req.Buy:
+			validErr["pay"] = "pay must be at least 1 and less than buy"
+		}
+	case domain.PricingBundle:
+		validateRuleProductIDs(validErr, req.ProductIDs)
+		if req.Percent == nil {
+			validErr["percent"] = "this field cannot be empty"
+		} else if *req.Percent <= 0 || *req.Percent > 100 {
+			validErr["percent"] = "percent must be between 1 and 100"
+		}
+	case "":
+		validErr["rule_type"] = "this field cannot be empty"
+	default:
+		if !domain.KnownPricingRuleType(domain.PricingRuleType(req.Type)) {
+			validErr["rule_type"] = "unknown pricing rule type"
+		}
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
+
+// validateRuleProductID проверяет товар правила ценообразования и записывает ошибку в validErr.
+func validateRuleProductID(validErr map[string]any, productID string) {
+	if productID == "" {
+		validErr["product_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(productID); err != nil {
+		validErr["product_id"] = "invalid product ID"
+	}
+}
+
+// validateRuleProductIDs проверяет товары набора и записывает ошибку в validErr.
+//
+// Набор должен состоять хотя бы из двух разных товаров.
+func validateRuleProductIDs(validErr map[string]any, productIDs []string) {
+	unique := make(map[uuid.UUID]struct{}, len(productIDs))
+	for _, productID := range productIDs {
+		id, err := uuid.Parse(productID)
+		if err != nil {
+			validErr["product_ids"] = "invalid product ID"
+			return
+		}
+		if _, ok := unique[id]; ok {
+			validErr["product_ids"] = "product IDs must be unique"
+			return
+		}
+		unique[id] = struct{}{}
+	}
+
+	if len(unique) < 2 {
+		validErr["product_ids"] = "bundle must contain at least 2 products"
+	}
+}
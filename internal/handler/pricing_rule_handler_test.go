@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePricingRuleRequest(t *testing.T) {
+	const (
+		productA = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+		productB = "0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	)
+
+	cases := []struct {
+		Name    string
+		Request *dto.PricingRuleRequest
+		WantErr map[string]any
+	}{
+		{
+			Name: "Tier",
+			Request: &dto.PricingRuleRequest{
+				Name: "wholesale", Type: "tier", ProductID: productA,
+				MinCount: ptr(10), UnitPrice: ptr(domain.Money(900)),
+			},
+		},
+		{
+			Name: "3 for 2",
+			Request: &dto.PricingRuleRequest{
+				Name: "3 for 2", Type: "n_for_m", ProductID: productA,
+				Buy: ptr(3), Pay: ptr(2),
+			},
+		},
+		{
+			Name: "Bundle",
+			Request: &dto.PricingRuleRequest{
+				Name: "set", Type: "bundle", ProductIDs: []string{productA, productB},
+				Percent: ptr(10),
+			},
+		},
+		{
+			Name:    "Empty request",
+			Request: &dto.PricingRuleRequest{},
+			WantErr: map[string]any{
+				"name":      "this field cannot be empty",
+				"rule_type": "this field cannot be empty",
+			},
+		},
+		{
+			Name:    "Unknown type",
+			Request: &dto.PricingRuleRequest{Name: "rule", Type: "gift", WarehouseID: "warehouse"},
+			WantErr: map[string]any{
+				"rule_type":    "unknown pricing rule type",
+				"warehouse_id": "invalid warehouse ID",
+			},
+		},
+		{
+			Name: "Tier without threshold",
+			Request: &dto.PricingRuleRequest{
+				Name: "wholesale", Type: "tier", ProductID: "product",
+				MinCount: ptr(1), UnitPrice: ptr(domain.Money(0)),
+			},
+			WantErr: map[string]any{
+				"product_id": "invalid product ID",
+				"min_count":  "min count must be at least 2",
+				"unit_price": "unit price must be greater than 0",
+			},
+		},
+		{
+			Name: "Pay not less than buy",
+			Request: &dto.PricingRuleRequest{
+				Name: "3 for 3", Type: "n_for_m", ProductID: productA,
+				Buy: ptr(3), Pay: ptr(3),
+			},
+			WantErr: map[string]any{
+				"pay": "pay must be at least 1 and less than buy",
+			},
+		},
+		{
+			Name: "Bundle of one product",
+			Request: &dto.PricingRuleRequest{
+				Name: "set", Type: "bundle", ProductIDs: []string{productA},
+				Percent: ptr(10),
+			},
+			WantErr: map[string]any{
+				"product_ids": "bundle must contain at least 2 products",
+			},
+		},
+		{
+			Name: "Bundle with same product in other case",
+			Request: &dto.PricingRuleRequest{
+				Name: "set", Type: "bundle", ProductIDs: []string{productA, strings.ToUpper(productA)},
+				Percent: ptr(10),
+			},
+			WantErr: map[string]any{
+				"product_ids": "product IDs must be unique",
+			},
+		},
+		{
+			Name: "Bundle percent out of range",
+			Request: &dto.PricingRuleRequest{
+				Name: "set", Type: "bundle", ProductIDs: []string{productA, productB},
+				Percent: ptr(0),
+			},
+			WantErr: map[string]any{
+				"percent": "percent must be between 1 and 100",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			require.Equal(t, tc.WantErr, validatePricingRuleRequest(tc.Request))
+		})
+	}
+}
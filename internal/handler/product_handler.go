@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -22,9 +23,12 @@ import (
 //
 //go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
 type ProductService interface {
-	GetProducts(ctx context.Context) ([]*dto.ProductAtListResponse, error)
+	GetProducts(ctx context.Context, filter *dto.ProductFilter) (*dto.ProductListResponse, error)
 	AddProduct(ctx context.Context, request *dto.ProductRequest) error
 	UpdateProduct(ctx context.Context, productID uuid.UUID, request *dto.ProductRequest) error
+	ArchiveProduct(ctx context.Context, productID uuid.UUID) error
+	RestoreProduct(ctx context.Context, productID uuid.UUID) error
+	DeleteProduct(ctx context.Context, productID uuid.UUID) error
 }
 
 type ProductHandler struct {
@@ -54,7 +58,13 @@ func (h *ProductHandler) GetProducts(w http.ResponseWriter, r *http.Request) {
 		zap.String("request-id", middleware.GetRequestID(r.Context())),
 	)
 
-	productResponse, err := h.service.GetProducts(r.Context())
+	filter, err := parseProductFilter(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	productResponse, err := h.service.GetProducts(r.Context(), filter)
 	if err != nil {
 		log.Error("error while getting products", zap.String("err", err.Error()))
 		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting products")
@@ -69,6 +79,66 @@ func (h *ProductHandler) GetProducts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// productSorts - поля, по которым можно отсортировать каталог продуктов.
+var productSorts = []string{"name", "weight"}
+
+// productParamPrefix - префикс параметров запроса, которые фильтруют продукты по их параметрам.
+const productParamPrefix = "params."
+
+// parseProductFilter извлекает из параметров запроса фильтры, сортировку и пагинацию каталога.
+//
+// Поддерживаются параметры name (подстрока названия), q (поиск по названию и описанию),
+// sort (name или weight), order (asc или desc), archived и params.<ключ>=<значение>.
+// Если поле сортировки не указано, то продукты сортируются по названию.
+func parseProductFilter(r *http.Request) (*dto.ProductFilter, error) {
+	query := r.URL.Query()
+
+	filter := &dto.ProductFilter{
+		Name:       strings.TrimSpace(query.Get("name")),
+		Search:     strings.TrimSpace(query.Get("q")),
+		SortBy:     query.Get("sort"),
+		Pagination: parseParams(r),
+	}
+
+	if filter.SortBy == "" {
+		filter.SortBy = productSorts[0]
+	} else if !slices.Contains(productSorts, filter.SortBy) {
+		return nil, fmt.Errorf("sort must be one of: %s", strings.Join(productSorts, ", "))
+	}
+
+	switch query.Get("order") {
+	case "", "asc":
+	case "desc":
+		filter.Desc = true
+	default:
+		return nil, fmt.Errorf("order must be one of: asc, desc")
+	}
+
+	archived, err := parseBoolQuery(query.Get("archived"), "archived")
+	if err != nil {
+		return nil, err
+	}
+	filter.Archived = archived
+
+	for key, values := range query {
+		param, ok := strings.CutPrefix(key, productParamPrefix)
+		if !ok {
+			continue
+		}
+
+		if param == "" {
+			return nil, fmt.Errorf("product param name cannot be empty")
+		}
+
+		if filter.Params == nil {
+			filter.Params = make(map[string]string)
+		}
+		filter.Params[param] = values[0]
+	}
+
+	return filter, nil
+}
+
 func (h *ProductHandler) AddProduct(w http.ResponseWriter, r *http.Request) {
 	log := logger.GetLogger().With(
 		zap.String("op", "handler.ProductHandler.AddProduct"),
@@ -119,6 +189,13 @@ func parseProduct(r *http.Request) (*dto.ProductRequest, error) {
 		}
 		product.Weight = &weight
 	}
+	serialized := r.FormValue("serialized")
+	if serialized != "" {
+		product.Serialized, err = strconv.ParseBool(serialized)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing serialized: %w", err)
+		}
+	}
 	params := r.FormValue("params")
 	if params != "" {
 		err = json.NewDecoder(strings.NewReader(r.FormValue("params"))).Decode(&product.Params)
@@ -170,6 +247,18 @@ func validateCreateProduct(product *dto.ProductRequest) map[string]string {
 	return validErr
 }
 
+// ProductByIDHandler обрабатывает запросы к продукту по его идентификатору.
+func (h *ProductHandler) ProductByIDHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPut, http.MethodPatch:
+		h.UpdateProduct(w, r)
+	case http.MethodDelete:
+		h.DeleteProduct(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
 func (h *ProductHandler) UpdateProduct(w http.ResponseWriter, r *http.Request) {
 	log := logger.GetLogger().With(zap.String("op", "handler.ProductHandler.UpdateProduct"))
 
@@ -236,3 +325,110 @@ func validateUpdateProduct(product *dto.ProductRequest) map[string]string {
 
 	return validErr
 }
+
+// DeleteProduct обрабатывает запросы на удаление продукта.
+//
+// Продукт, который еще есть на складах или уже попал в заказы и историю, удалить нельзя.
+func (h *ProductHandler) DeleteProduct(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.ProductHandler.DeleteProduct"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodDelete {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	productID, err := parseProductID(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong product ID")
+		return
+	}
+
+	err = h.service.DeleteProduct(r.Context(), productID)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrProductNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case custErr.Any(err, custErr.ErrProductHasStock, custErr.ErrProductInUse):
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+		default:
+			log.Error("error while deleting product", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while deleting product")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ArchiveProduct обрабатывает запросы на перенос продукта в архив.
+func (h *ProductHandler) ArchiveProduct(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.ProductHandler.ArchiveProduct"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	productID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong product ID")
+		return
+	}
+
+	err = h.service.ArchiveProduct(r.Context(), productID)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrProductNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, custErr.ErrProductArchived):
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+		default:
+			log.Error("error while archiving product", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while archiving product")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreProduct обрабатывает запросы на возврат продукта из архива.
+func (h *ProductHandler) RestoreProduct(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.ProductHandler.RestoreProduct"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	productID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong product ID")
+		return
+	}
+
+	err = h.service.RestoreProduct(r.Context(), productID)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrProductNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, custErr.ErrProductNotArchived):
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+		default:
+			log.Error("error while restoring product", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while restoring product")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
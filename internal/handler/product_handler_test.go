@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProductFilter(t *testing.T) {
+	defaultPage := &dto.Pagination{Page: 1, Offset: 0, Limit: 10}
+
+	cases := []struct {
+		Name    string
+		Query   string
+		Want    *dto.ProductFilter
+		WantErr string
+	}{
+		{
+			Name: "Defaults",
+			Want: &dto.ProductFilter{SortBy: "name", Pagination: defaultPage},
+		},
+		{
+			Name:  "All filters",
+			Query: "name=+milk+&q=fresh%20cheese&sort=weight&order=desc&archived=true&params.color=red&params.size=XL&page=2&limit=5",
+			Want: &dto.ProductFilter{
+				Name:       "milk",
+				Search:     "fresh cheese",
+				Params:     map[string]string{"color": "red", "size": "XL"},
+				Archived:   true,
+				SortBy:     "weight",
+				Desc:       true,
+				Pagination: &dto.Pagination{Page: 2, Offset: 5, Limit: 5},
+			},
+		},
+		{
+			Name:  "Ascending order",
+			Query: "order=asc",
+			Want:  &dto.ProductFilter{SortBy: "name", Pagination: defaultPage},
+		},
+		{
+			Name:    "Wrong sort",
+			Query:   "sort=price",
+			WantErr: "sort must be one of: name, weight",
+		},
+		{
+			Name:    "Wrong order",
+			Query:   "order=up",
+			WantErr: "order must be one of: asc, desc",
+		},
+		{
+			Name:    "Empty param name",
+			Query:   "params.=red",
+			WantErr: "product param name cannot be empty",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/products?"+tc.Query, nil)
+
+			got, err := parseProductFilter(req)
+			if tc.WantErr != "" {
+				require.EqualError(t, err, tc.WantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.Want, got)
+		})
+	}
+}
+
+func TestDeleteProduct(t *testing.T) {
+	const productID = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+
+	cases := []struct {
+		Name         string
+		Method       string
+		ProductID    string
+		CallService  bool
+		ReturnError  error
+		StatusCode   int
+		ResponseBody string
+	}{
+		{Name: "Success", Method: http.MethodDelete, ProductID: productID, CallService: true, StatusCode: http.StatusNoContent},
+		{Name: "Wrong method", Method: http.MethodGet, ProductID: productID, StatusCode: http.StatusMethodNotAllowed},
+		{Name: "Wrong product ID", Method: http.MethodDelete, ProductID: "product", StatusCode: http.StatusBadRequest, ResponseBody: `{"error":"wrong product ID"}`},
+		{
+			Name: "Not found", Method: http.MethodDelete, ProductID: productID, CallService: true,
+			ReturnError: custErr.ErrProductNotFound, StatusCode: http.StatusNotFound, ResponseBody: `{"error":"product not found"}`,
+		},
+		{
+			Name: "Still in stock", Method: http.MethodDelete, ProductID: productID, CallService: true,
+			ReturnError: custErr.ErrProductHasStock, StatusCode: http.StatusConflict, ResponseBody: `{"error":"product is still in stock at some warehouses"}`,
+		},
+		{
+			Name: "Referenced by orders", Method: http.MethodDelete, ProductID: productID, CallService: true,
+			ReturnError: custErr.ErrProductInUse, StatusCode: http.StatusConflict, ResponseBody: `{"error":"product is referenced by orders or history, archive it instead"}`,
+		},
+		{
+			Name: "Service error", Method: http.MethodDelete, ProductID: productID, CallService: true,
+			ReturnError: errors.New("internal server error"), StatusCode: http.StatusInternalServerError, ResponseBody: `{"error":"error while deleting product"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockService := NewMockProductService(t)
+			if tc.CallService {
+				mockService.On("DeleteProduct", mock.Anything, uuid.MustParse(tc.ProductID)).
+					Return(tc.ReturnError).
+					Once()
+			}
+
+			logger.CreateNOPLogger()
+
+			handler := NewProductHandler(mockService)
+			req := httptest.NewRequest(tc.Method, "/api/product/"+tc.ProductID, nil)
+			rr := httptest.NewRecorder()
+
+			handler.DeleteProduct(rr, req)
+			require.Equal(t, tc.StatusCode, rr.Code)
+
+			if tc.ResponseBody == "" {
+				assert.Empty(t, rr.Body.String())
+			} else {
+				assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestArchiveAndRestoreProduct(t *testing.T) {
+	const productID = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+
+	cases := []struct {
+		Name         string
+		Restore      bool
+		ReturnError  error
+		StatusCode   int
+		ResponseBody string
+	}{
+		{Name: "Archive", StatusCode: http.StatusNoContent},
+		{Name: "Archive archived", ReturnError: custErr.ErrProductArchived, StatusCode: http.StatusConflict, ResponseBody: `{"error":"product is archived"}`},
+		{Name: "Archive missing", ReturnError: custErr.ErrProductNotFound, StatusCode: http.StatusNotFound, ResponseBody: `{"error":"product not found"}`},
+		{Name: "Restore", Restore: true, StatusCode: http.StatusNoContent},
+		{Name: "Restore active", Restore: true, ReturnError: custErr.ErrProductNotArchived, StatusCode: http.StatusConflict, ResponseBody: `{"error":"product is not archived"}`},
+		{
+			Name: "Restore service error", Restore: true, ReturnError: errors.New("internal server error"),
+			StatusCode: http.StatusInternalServerError, ResponseBody: `{"error":"error while restoring product"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			method, action := "ArchiveProduct", "archive"
+			if tc.Restore {
+				method, action = "RestoreProduct", "restore"
+			}
+
+			mockService := NewMockProductService(t)
+			mockService.On(method, mock.Anything, uuid.MustParse(productID)).
+				Return(tc.ReturnError).
+				Once()
+
+			logger.CreateNOPLogger()
+
+			handler := NewProductHandler(mockService)
+			req := httptest.NewRequest(http.MethodPost, "/api/product/"+productID+"/"+action, nil)
+			req.SetPathValue("id", productID)
+			rr := httptest.NewRecorder()
+
+			if tc.Restore {
+				handler.RestoreProduct(rr, req)
+			} else {
+				handler.ArchiveProduct(rr, req)
+			}
+			require.Equal(t, tc.StatusCode, rr.Code)
+
+			if tc.ResponseBody == "" {
+				assert.Empty(t, rr.Body.String())
+			} else {
+				assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PromoCodeService определяет методы для работы с промокодами.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type PromoCodeService interface {
+	CreatePromoCode(ctx context.Context, request *dto.PromoCodeRequest) (*dto.PromoCodeResponse, error)
+	GetPromoCode(ctx context.Context, promoCodeID uuid.UUID) (*dto.PromoCodeResponse, error)
+	GetPromoCodes(ctx context.Context, filter *dto.PromoCodeFilter) (*dto.PromoCodesResponse, error)
+	DeletePromoCode(ctx context.Context, promoCodeID uuid.UUID) error
+}
+
+// PromoCodeHandler обрабатывает запросы, связанные с промокодами.
+type PromoCodeHandler struct {
+	service PromoCodeService
+}
+
+// NewPromoCodeHandler создает новый экземпляр PromoCodeHandler с заданным сервисом промокодов.
+func NewPromoCodeHandler(service PromoCodeService) *PromoCodeHandler {
+	return &PromoCodeHandler{
+		service: service,
+	}
+}
+
+// maxPromoCodeLength - максимальная длина промокода.
+const maxPromoCodeLength = 64
+
+// isPromoCodeError сообщает, что промокод корзины не найден или не может быть применен.
+func isPromoCodeError(err error) bool {
+	return custErr.Any(err,
+		custErr.ErrPromoCodeNotFound,
+		custErr.ErrPromoCodeExpired,
+		custErr.ErrPromoCodeExhausted,
+		custErr.ErrPromoCodeNotApplicable,
+		custErr.ErrPromoCodeMinTotal,
+	)
+}
+
+// PromoCodesHandler обрабатывает запросы к списку промокодов.
+func (h *PromoCodeHandler) PromoCodesHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetPromoCodes(w, r)
+	case http.MethodPost:
+		h.CreatePromoCode(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// PromoCodeHandler обрабатывает запросы к одному промокоду.
+func (h *PromoCodeHandler) PromoCodeHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetPromoCode(w, r)
+	case http.MethodDelete:
+		h.DeletePromoCode(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// CreatePromoCode обрабатывает запросы на создание промокода.
+func (h *PromoCodeHandler) CreatePromoCode(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PromoCodeHandler.CreatePromoCode"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	promoReq, err := parsePromoCodeRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing promo code", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	validErr := validatePromoCodeRequest(promoReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.CreatePromoCode(r.Context(), promoReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrPromoCodeAlreadyExists):
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+		case errors.Is(err, custErr.ErrForeignKey):
+			custErr.UnnamedError(w, http.StatusBadRequest, "wrong warehouse ID")
+		default:
+			log.Error("error while creating promo code", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while creating promo code")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, response)
+}
+
+// GetPromoCodes обрабатывает запросы на получение списка промокодов.
+func (h *PromoCodeHandler) GetPromoCodes(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PromoCodeHandler.GetPromoCodes"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	warehouseID := r.URL.Query().Get("warehouse_id")
+	if warehouseID != "" {
+		if err := uuid.Validate(warehouseID); err != nil {
+			custErr.UnnamedError(w, http.StatusBadRequest, "warehouse id is not valid")
+			return
+		}
+	}
+
+	filter := &dto.PromoCodeFilter{
+		WarehouseID: warehouseID,
+		Pagination:  parseParams(r),
+	}
+
+	response, err := h.service.GetPromoCodes(r.Context(), filter)
+	if err != nil {
+		log.Error("error while getting promo codes", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting promo codes")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// GetPromoCode обрабатывает запросы на получение промокода.
+func (h *PromoCodeHandler) GetPromoCode(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PromoCodeHandler.GetPromoCode"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	promoCodeID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong promo code ID")
+		return
+	}
+
+	response, err := h.service.GetPromoCode(r.Context(), promoCodeID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrPromoCodeNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while getting promo code", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting promo code")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// DeletePromoCode обрабатывает запросы на удаление промокода.
+func (h *PromoCodeHandler) DeletePromoCode(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PromoCodeHandler.DeletePromoCode"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	promoCodeID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong promo code ID")
+		return
+	}
+
+	err = h.service.DeletePromoCode(r.Context(), promoCodeID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrPromoCodeNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while deleting promo code", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while deleting promo code")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parsePromoCodeRequest извлекает промокод из запроса.
+func parsePromoCodeRequest(r io.Reader) (*dto.PromoCodeRequest, error) {
+	var req dto.PromoCodeRequest
+
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// validatePromoCodeRequest проверяет корректность промокода.
+func validatePromoCodeRequest(req *dto.PromoCodeRequest) map[string]any {
+	validErr := make(map[string]any)
+
+	switch {
+	case req.Code == "":
+		validErr["promo_code"] = "this field cannot be empty"
+	case len(req.Code) > maxPromoCodeLength:
+		validErr["promo_code"] = fmt.Sprintf("promo code must be at most %d characters", maxPromoCodeLength)
+	case strings.ContainsAny(req.Code, " \t\n"):
+		validErr["promo_code"] = "promo code cannot contain spaces"
+	}
+
+	validateDiscountValue(validErr, req.Type, req.Percent, req.Amount)
+
+	if req.MinTotal != nil && *req.MinTotal < 0 {
+		validErr["min_total"] = "min total cannot be negative"
+	}
+
+	if req.WarehouseID != "" {
+		if err := uuid.Validate(req.WarehouseID); err != nil {
+			validErr["warehouse_id"] = "invalid warehouse ID"
+		}
+	}
+
+	if req.UsageLimit != nil && *req.UsageLimit <= 0 {
+		validErr["usage_limit"] = "usage limit must be greater than 0"
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
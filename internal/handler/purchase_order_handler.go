@@ -0,0 +1,395 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PurchaseOrderService определяет методы для работы с заказами поставщикам.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type PurchaseOrderService interface {
+	CreatePurchaseOrder(ctx context.Context, request *dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error)
+	GetPurchaseOrder(ctx context.Context, orderID uuid.UUID) (*dto.PurchaseOrderResponse, error)
+	GetPurchaseOrders(ctx context.Context, filter *dto.PurchaseOrderFilter) (*dto.PurchaseOrdersResponse, error)
+	ReceivePurchaseOrder(ctx context.Context, orderID uuid.UUID, request *dto.PurchaseOrderReceiptRequest) (*dto.PurchaseOrderResponse, error)
+	CancelPurchaseOrder(ctx context.Context, orderID uuid.UUID) (*dto.PurchaseOrderResponse, error)
+}
+
+// PurchaseOrderHandler обрабатывает запросы, связанные с заказами поставщикам.
+type PurchaseOrderHandler struct {
+	service PurchaseOrderService
+}
+
+// NewPurchaseOrderHandler создает новый экземпляр PurchaseOrderHandler с заданным сервисом.
+func NewPurchaseOrderHandler(service PurchaseOrderService) *PurchaseOrderHandler {
+	return &PurchaseOrderHandler{
+		service: service,
+	}
+}
+
+// PurchaseOrdersHandler обрабатывает запросы на получение списка заказов поставщикам или создание нового заказа.
+func (h *PurchaseOrderHandler) PurchaseOrdersHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetPurchaseOrders(w, r)
+	case http.MethodPost:
+		h.CreatePurchaseOrder(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// CreatePurchaseOrder обрабатывает запросы на создание заказа поставщику.
+func (h *PurchaseOrderHandler) CreatePurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PurchaseOrderHandler.CreatePurchaseOrder"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	var request dto.PurchaseOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		log.Error("error while parsing JSON", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "cannot parse JSON")
+		return
+	}
+
+	validErr := validatePurchaseOrderRequest(&request)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.CreatePurchaseOrder(r.Context(), &request)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrForeignKey):
+			custErr.UnnamedError(w, http.StatusBadRequest, "wrong supplier or warehouse ID")
+		case errors.Is(err, custErr.ErrNotFoundProductAtWarehouse):
+			custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		default:
+			log.Error("error while creating purchase order", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while creating purchase order")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, response)
+}
+
+// validatePurchaseOrderRequest проверяет корректность данных заказа поставщику.
+func validatePurchaseOrderRequest(req *dto.PurchaseOrderRequest) map[string]any {
+	validErr := make(map[string]any)
+
+	if req.SupplierID == "" {
+		validErr["supplier_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.SupplierID); err != nil {
+		validErr["supplier_id"] = "invalid supplier ID"
+	}
+
+	if req.WarehouseID == "" {
+		validErr["warehouse_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.WarehouseID); err != nil {
+		validErr["warehouse_id"] = "invalid warehouse ID"
+	}
+
+	if len(req.Lines) == 0 {
+		validErr["lines"] = "there is no products to order"
+	} else {
+		unique := make(map[string]struct{}, len(req.Lines))
+		linesErr := make(map[int]any)
+		for idx, line := range req.Lines {
+			lineErr := make(map[string]string)
+
+			if msg := validatePurchaseOrderProductID(line.ProductID, unique); msg != "" {
+				lineErr["product_id"] = msg
+			}
+
+			if line.Count == nil {
+				lineErr["product_count"] = "this field cannot be empty"
+			} else if *line.Count <= 0 {
+				lineErr["product_count"] = "product count must be greater than 0"
+			}
+
+			if line.UnitCost == nil {
+				lineErr["unit_cost"] = "this field cannot be empty"
+			} else if *line.UnitCost < 0 {
+				lineErr["unit_cost"] = "invalid unit cost"
+			}
+
+			if len(lineErr) != 0 {
+				linesErr[idx] = lineErr
+			}
+		}
+
+		if len(linesErr) != 0 {
+			validErr["lines"] = linesErr
+		}
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
+
+// validatePurchaseOrderProductID проверяет идентификатор товара в строке заказа поставщику
+// и запоминает его в unique для проверки повторов.
+//
+// Возвращает текст ошибки или пустую строку, если идентификатор корректен.
+func validatePurchaseOrderProductID(productID string, unique map[string]struct{}) string {
+	if productID == "" {
+		return "this field cannot be empty"
+	}
+	if err := uuid.Validate(productID); err != nil {
+		return "invalid product ID"
+	}
+	if _, ok := unique[productID]; ok {
+		return "product ID must be unique"
+	}
+	unique[productID] = struct{}{}
+
+	return ""
+}
+
+// GetPurchaseOrders обрабатывает запросы на получение списка заказов поставщикам.
+func (h *PurchaseOrderHandler) GetPurchaseOrders(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PurchaseOrderHandler.GetPurchaseOrders"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	filter, err := parsePurchaseOrderFilter(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.service.GetPurchaseOrders(r.Context(), filter)
+	if err != nil {
+		log.Error("error while getting purchase orders", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting purchase orders")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parsePurchaseOrderFilter извлекает параметры выборки заказов поставщикам из параметров запроса.
+func parsePurchaseOrderFilter(r *http.Request) (*dto.PurchaseOrderFilter, error) {
+	query := r.URL.Query()
+
+	filter := &dto.PurchaseOrderFilter{
+		SupplierID:  query.Get("supplier_id"),
+		WarehouseID: query.Get("warehouse_id"),
+		Status:      query.Get("status"),
+		Pagination:  parseParams(r),
+	}
+
+	if filter.SupplierID != "" {
+		if err := uuid.Validate(filter.SupplierID); err != nil {
+			return nil, fmt.Errorf("supplier id is not valid")
+		}
+	}
+
+	if filter.WarehouseID != "" {
+		if err := uuid.Validate(filter.WarehouseID); err != nil {
+			return nil, fmt.Errorf("warehouse id is not valid")
+		}
+	}
+
+	switch domain.PurchaseOrderStatus(filter.Status) {
+	case "", domain.PurchaseOrderOrdered, domain.PurchaseOrderPartiallyReceived, domain.PurchaseOrderReceived, domain.PurchaseOrderCancelled:
+	default:
+		return nil, fmt.Errorf("unknown purchase order status")
+	}
+
+	return filter, nil
+}
+
+// GetPurchaseOrder обрабатывает запросы на получение заказа поставщику.
+func (h *PurchaseOrderHandler) GetPurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PurchaseOrderHandler.GetPurchaseOrder"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	orderID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong purchase order ID")
+		return
+	}
+
+	response, err := h.service.GetPurchaseOrder(r.Context(), orderID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrPurchaseOrderNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while getting purchase order", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting purchase order")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// ReceivePurchaseOrder обрабатывает запросы на приемку товаров по заказу поставщику.
+func (h *PurchaseOrderHandler) ReceivePurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PurchaseOrderHandler.ReceivePurchaseOrder"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	orderID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong purchase order ID")
+		return
+	}
+
+	var request dto.PurchaseOrderReceiptRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		log.Error("error while parsing JSON", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "cannot parse JSON")
+		return
+	}
+
+	validErr := validatePurchaseOrderReceiptRequest(&request)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.ReceivePurchaseOrder(r.Context(), orderID, &request)
+	if err != nil {
+		if writeSerialError(w, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, custErr.ErrPurchaseOrderNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case custErr.Any(err, custErr.ErrPurchaseOrderNotOpen, custErr.ErrBatchAlreadyExists):
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+		case custErr.Any(err, custErr.ErrProductNotInPurchaseOrder, custErr.ErrPurchaseOrderOverReceipt):
+			custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, custErr.ErrInventoryNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, "there is no information about this product on warehouse")
+		default:
+			log.Error("error while receiving purchase order", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while receiving purchase order")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// validatePurchaseOrderReceiptRequest проверяет корректность данных приемки товаров.
+func validatePurchaseOrderReceiptRequest(req *dto.PurchaseOrderReceiptRequest) map[string]any {
+	validErr := make(map[string]any)
+
+	if len(req.Lines) == 0 {
+		validErr["lines"] = "there is no products to receive"
+		return validErr
+	}
+
+	unique := make(map[string]struct{}, len(req.Lines))
+	linesErr := make(map[int]any)
+	for idx, line := range req.Lines {
+		lineErr := make(map[string]string)
+
+		if msg := validatePurchaseOrderProductID(line.ProductID, unique); msg != "" {
+			lineErr["product_id"] = msg
+		}
+
+		if line.Count == nil {
+			lineErr["product_count"] = "this field cannot be empty"
+		} else if *line.Count <= 0 {
+			lineErr["product_count"] = "product count must be greater than 0"
+		}
+
+		if len(line.LotNumber) > maxLotNumberLength {
+			lineErr["lot_number"] = fmt.Sprintf("lot number must be at most %d characters", maxLotNumberLength)
+		} else if line.LotNumber != "" && strings.TrimSpace(line.LotNumber) == "" {
+			lineErr["lot_number"] = "lot number cannot be blank"
+		}
+
+		if line.ExpiresAt != nil && line.LotNumber == "" {
+			lineErr["expires_at"] = "expiry date can be set only for batch with lot number"
+		}
+
+		if msg := validateSerials(line.Serials, line.Count); msg != "" {
+			lineErr["serials"] = msg
+		}
+
+		if len(lineErr) != 0 {
+			linesErr[idx] = lineErr
+		}
+	}
+
+	if len(linesErr) != 0 {
+		validErr["lines"] = linesErr
+		return validErr
+	}
+
+	return nil
+}
+
+// CancelPurchaseOrder обрабатывает запросы на отмену заказа поставщику.
+func (h *PurchaseOrderHandler) CancelPurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.PurchaseOrderHandler.CancelPurchaseOrder"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	orderID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong purchase order ID")
+		return
+	}
+
+	response, err := h.service.CancelPurchaseOrder(r.Context(), orderID)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrPurchaseOrderNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, custErr.ErrPurchaseOrderNotOpen):
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+		default:
+			log.Error("error while cancelling purchase order", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while cancelling purchase order")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePurchaseOrderReceiptRequest(t *testing.T) {
+	const productID = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+
+	expiresAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Name    string
+		Lines   []*dto.PurchaseOrderReceiptLineRequest
+		WantErr map[string]any
+	}{
+		{
+			Name:  "Plain receipt",
+			Lines: []*dto.PurchaseOrderReceiptLineRequest{{ProductID: productID, Count: ptr(5)}},
+		},
+		{
+			Name: "Batch with serials",
+			Lines: []*dto.PurchaseOrderReceiptLineRequest{{
+				ProductID: productID, Count: ptr(2), LotNumber: "L-1", ExpiresAt: &expiresAt,
+				Serials: []string{"SN-1", "SN-2"},
+			}},
+		},
+		{
+			Name:    "No lines",
+			WantErr: map[string]any{"lines": "there is no products to receive"},
+		},
+		{
+			Name: "Wrong lines",
+			Lines: []*dto.PurchaseOrderReceiptLineRequest{
+				{ProductID: productID, Count: ptr(1)},
+				{ProductID: productID, Count: ptr(0), ExpiresAt: &expiresAt},
+				{ProductID: "product", LotNumber: "  "},
+				{ProductID: "", Count: ptr(2), LotNumber: strings.Repeat("L", maxLotNumberLength+1), Serials: []string{"SN-1"}},
+			},
+			WantErr: map[string]any{"lines": map[int]any{
+				1: map[string]string{
+					"product_id":    "product ID must be unique",
+					"product_count": "product count must be greater than 0",
+					"expires_at":    "expiry date can be set only for batch with lot number",
+				},
+				2: map[string]string{
+					"product_id":    "invalid product ID",
+					"product_count": "this field cannot be empty",
+					"lot_number":    "lot number cannot be blank",
+				},
+				3: map[string]string{
+					"product_id": "this field cannot be empty",
+					"lot_number": "lot number must be at most 64 characters",
+					"serials":    "there must be one serial number for each unit",
+				},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := &dto.PurchaseOrderReceiptRequest{Lines: tc.Lines}
+			require.Equal(t, tc.WantErr, validatePurchaseOrderReceiptRequest(req))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"go.uber.org/zap"
+)
+
+// SerialService определяет методы для работы с серийными номерами товаров.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type SerialService interface {
+	GetSerial(ctx context.Context, serialNumber string) (*dto.SerialLookupResponse, error)
+}
+
+// SerialHandler обрабатывает запросы, связанные с серийными номерами товаров.
+type SerialHandler struct {
+	service SerialService
+}
+
+// NewSerialHandler создает новый экземпляр SerialHandler с заданным сервисом.
+func NewSerialHandler(service SerialService) *SerialHandler {
+	return &SerialHandler{
+		service: service,
+	}
+}
+
+// maxSerialLength - максимальная длина серийного номера.
+const maxSerialLength = 64
+
+// GetSerial обрабатывает запросы на поиск единицы товара по серийному номеру.
+func (h *SerialHandler) GetSerial(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.SerialHandler.GetSerial"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	serialNumber := r.PathValue("serial")
+	if strings.TrimSpace(serialNumber) == "" || len(serialNumber) > maxSerialLength {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong serial number")
+		return
+	}
+
+	response, err := h.service.GetSerial(r.Context(), serialNumber)
+	if err != nil {
+		if errors.Is(err, custErr.ErrSerialNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while getting serial", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting serial")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// validateSerials проверяет серийные номера, переданные для count единиц товара.
+//
+// Возвращает текст ошибки или пустую строку, если номера корректны или не переданы.
+func validateSerials(serials []string, count *int) string {
+	if len(serials) == 0 {
+		return ""
+	}
+
+	if count != nil && len(serials) != *count {
+		return "there must be one serial number for each unit"
+	}
+
+	seen := make(map[string]struct{}, len(serials))
+	for _, serial := range serials {
+		if strings.TrimSpace(serial) == "" {
+			return "serial number cannot be empty"
+		}
+		if len(serial) > maxSerialLength {
+			return "serial number is too long"
+		}
+		if _, ok := seen[serial]; ok {
+			return "serial numbers must be unique"
+		}
+		seen[serial] = struct{}{}
+	}
+
+	return ""
+}
+
+// writeSerialError отправляет ответ на ошибку учета серийных номеров.
+//
+// Возвращает false, если err не относится к серийным номерам и ответ не отправлен.
+func writeSerialError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, custErr.ErrSerialAlreadyExists):
+		custErr.UnnamedError(w, http.StatusConflict, err.Error())
+	case custErr.Any(err, custErr.ErrSerialsRequired, custErr.ErrProductNotSerialized, custErr.ErrSerialNotInOrder):
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+	default:
+		return false
+	}
+
+	return true
+}
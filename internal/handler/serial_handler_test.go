@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSerials(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Serials []string
+		Count   *int
+		Want    string
+	}{
+		{Name: "No serials", Count: ptr(3)},
+		{Name: "One per unit", Serials: []string{"SN-1", "SN-2"}, Count: ptr(2)},
+		{Name: "Count not given", Serials: []string{"SN-1"}},
+		{Name: "Fewer than units", Serials: []string{"SN-1"}, Count: ptr(2), Want: "there must be one serial number for each unit"},
+		{Name: "Empty serial", Serials: []string{"SN-1", " "}, Want: "serial number cannot be empty"},
+		{Name: "Too long", Serials: []string{strings.Repeat("9", maxSerialLength+1)}, Want: "serial number is too long"},
+		{Name: "Duplicate", Serials: []string{"SN-1", "SN-1"}, Want: "serial numbers must be unique"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			require.Equal(t, tc.Want, validateSerials(tc.Serials, tc.Count))
+		})
+	}
+}
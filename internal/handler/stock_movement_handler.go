@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// StockMovementService определяет методы для работы с журналом движения товаров.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type StockMovementService interface {
+	GetStockMovements(ctx context.Context, filter *dto.StockMovementFilter) (*dto.StockMovementsResponse, error)
+}
+
+// StockMovementHandler обрабатывает запросы, связанные с журналом движения товаров.
+type StockMovementHandler struct {
+	service StockMovementService
+}
+
+// NewStockMovementHandler создает новый экземпляр StockMovementHandler с заданным сервисом.
+func NewStockMovementHandler(service StockMovementService) *StockMovementHandler {
+	return &StockMovementHandler{
+		service: service,
+	}
+}
+
+// GetStockMovements обрабатывает запросы на получение журнала движения товаров на складе.
+func (h *StockMovementHandler) GetStockMovements(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.StockMovementHandler.GetStockMovements"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	filter, err := parseStockMovementFilter(r)
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.service.GetStockMovements(r.Context(), filter)
+	if err != nil {
+		log.Error("error while getting stock movements", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting stock movements")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parseStockMovementFilter извлекает параметры выборки журнала из пути и параметров запроса.
+func parseStockMovementFilter(r *http.Request) (*dto.StockMovementFilter, error) {
+	warehouseID, err := parsePathUUID(r, "id")
+	if err != nil {
+		return nil, fmt.Errorf("warehouse id is not valid")
+	}
+
+	productID := r.URL.Query().Get("product_id")
+	if productID != "" {
+		if err := uuid.Validate(productID); err != nil {
+			return nil, fmt.Errorf("product id is not valid")
+		}
+	}
+
+	from, err := parseTimeQuery(r, "from")
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := parseTimeQuery(r, "to")
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.StockMovementFilter{
+		WarehouseID: warehouseID.String(),
+		ProductID:   productID,
+		From:        from,
+		To:          to,
+		Pagination:  parseParams(r),
+	}, nil
+}
+
+// parseTimeQuery извлекает момент времени из параметра запроса.
+//
+// Поддерживаются форматы RFC 3339 и YYYY-MM-DD. Если параметр не задан, возвращает nil.
+func parseTimeQuery(r *http.Request, key string) (*time.Time, error) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s must be in RFC 3339 or YYYY-MM-DD format", key)
+}
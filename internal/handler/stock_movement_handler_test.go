@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeQuery(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Query   string
+		Want    *time.Time
+		WantErr bool
+	}{
+		{
+			Name:  "Empty",
+			Query: "",
+		},
+		{
+			Name:  "RFC 3339",
+			Query: "from=2025-03-01T10:20:30Z",
+			Want:  ptr(time.Date(2025, 3, 1, 10, 20, 30, 0, time.UTC)),
+		},
+		{
+			Name:  "RFC 3339 with offset",
+			Query: "from=2025-03-01T10:20:30%2B03:00",
+			Want:  ptr(time.Date(2025, 3, 1, 7, 20, 30, 0, time.UTC)),
+		},
+		{
+			Name:  "Date only",
+			Query: "from=2025-03-01",
+			Want:  ptr(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			Name:    "Wrong date",
+			Query:   "from=2025-13-01",
+			WantErr: true,
+		},
+		{
+			Name:    "Wrong format",
+			Query:   "from=01.03.2025",
+			WantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/?"+tc.Query, nil)
+
+			got, err := parseTimeQuery(req, "from")
+			if tc.WantErr {
+				require.EqualError(t, err, "from must be in RFC 3339 or YYYY-MM-DD format")
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.Want == nil {
+				require.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			require.True(t, tc.Want.Equal(*got), "want %s, got %s", tc.Want, got)
+		})
+	}
+}
+
+func TestGetStockMovements(t *testing.T) {
+	const (
+		warehouseID = "17b79680-4657-4ef4-9c3d-554a83c31828"
+		productID   = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+	)
+
+	cases := []struct {
+		Name         string
+		Method       string
+		WarehouseID  string
+		Query        string
+		WantFilter   *dto.StockMovementFilter
+		ReturnError  error
+		StatusCode   int
+		ResponseBody string
+	}{
+		{
+			Name:        "Success with defaults",
+			Method:      http.MethodGet,
+			WarehouseID: warehouseID,
+			WantFilter: &dto.StockMovementFilter{
+				WarehouseID: warehouseID,
+				Pagination:  &dto.Pagination{Page: 1, Offset: 0, Limit: 10},
+			},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"page":1,"limit":10,"movements":[]}`,
+		},
+		{
+			Name:        "Success with filters",
+			Method:      http.MethodGet,
+			WarehouseID: warehouseID,
+			Query:       "product_id=" + productID + "&from=2025-03-01&to=2025-03-31T23:59:59Z&page=3&limit=20",
+			WantFilter: &dto.StockMovementFilter{
+				WarehouseID: warehouseID,
+				ProductID:   productID,
+				From:        ptr(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+				To:          ptr(time.Date(2025, 3, 31, 23, 59, 59, 0, time.UTC)),
+				Pagination:  &dto.Pagination{Page: 3, Offset: 40, Limit: 20},
+			},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"page":1,"limit":10,"movements":[]}`,
+		},
+		{
+			Name:        "Wrong paging falls back to defaults",
+			Method:      http.MethodGet,
+			WarehouseID: warehouseID,
+			Query:       "page=-1&limit=abc",
+			WantFilter: &dto.StockMovementFilter{
+				WarehouseID: warehouseID,
+				Pagination:  &dto.Pagination{Page: 1, Offset: 0, Limit: 10},
+			},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"page":1,"limit":10,"movements":[]}`,
+		},
+		{
+			Name:         "Wrong method",
+			Method:       http.MethodPost,
+			WarehouseID:  warehouseID,
+			StatusCode:   http.StatusMethodNotAllowed,
+			ResponseBody: ``,
+		},
+		{
+			Name:         "Wrong warehouse ID",
+			Method:       http.MethodGet,
+			WarehouseID:  "warehouse",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"warehouse id is not valid"}`,
+		},
+		{
+			Name:         "Wrong product ID",
+			Method:       http.MethodGet,
+			WarehouseID:  warehouseID,
+			Query:        "product_id=product",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"product id is not valid"}`,
+		},
+		{
+			Name:         "Wrong from",
+			Method:       http.MethodGet,
+			WarehouseID:  warehouseID,
+			Query:        "from=yesterday",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"from must be in RFC 3339 or YYYY-MM-DD format"}`,
+		},
+		{
+			Name:         "Wrong to",
+			Method:       http.MethodGet,
+			WarehouseID:  warehouseID,
+			Query:        "to=2025-02-30",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"to must be in RFC 3339 or YYYY-MM-DD format"}`,
+		},
+		{
+			Name:        "Service error",
+			Method:      http.MethodGet,
+			WarehouseID: warehouseID,
+			WantFilter: &dto.StockMovementFilter{
+				WarehouseID: warehouseID,
+				Pagination:  &dto.Pagination{Page: 1, Offset: 0, Limit: 10},
+			},
+			ReturnError:  errors.New("internal server error"),
+			StatusCode:   http.StatusInternalServerError,
+			ResponseBody: `{"error":"error while getting stock movements"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockService := NewMockStockMovementService(t)
+			if tc.WantFilter != nil {
+				var response *dto.StockMovementsResponse
+				if tc.ReturnError == nil {
+					response = &dto.StockMovementsResponse{Page: 1, Limit: 10, Movements: []*dto.StockMovementResponse{}}
+				}
+				mockService.On("GetStockMovements", mock.Anything, tc.WantFilter).
+					Return(response, tc.ReturnError).
+					Once()
+			}
+
+			logger.CreateNOPLogger()
+
+			handler := NewStockMovementHandler(mockService)
+			req := httptest.NewRequest(tc.Method, "/api/warehouses/"+tc.WarehouseID+"/movements?"+tc.Query, nil)
+			req.SetPathValue("id", tc.WarehouseID)
+
+			rr := httptest.NewRecorder()
+
+			handler.GetStockMovements(rr, req)
+			require.Equal(t, tc.StatusCode, rr.Code)
+
+			if tc.ResponseBody == "" {
+				assert.Empty(t, rr.Body.String())
+			} else {
+				assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
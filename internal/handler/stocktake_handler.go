@@ -0,0 +1,354 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// StocktakeService определяет методы для работы с пересчетами товаров на складах.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type StocktakeService interface {
+	CreateStocktake(ctx context.Context, request *dto.StocktakeRequest) (*dto.StocktakeResponse, error)
+	GetStocktake(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeResponse, error)
+	SubmitStocktakeCounts(ctx context.Context, stocktakeID uuid.UUID, request *dto.StocktakeCountsRequest) (*dto.StocktakeResponse, error)
+	GetStocktakeVariance(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeVarianceResponse, error)
+	PostStocktake(ctx context.Context, stocktakeID uuid.UUID, request *dto.StocktakePostRequest) (*dto.StocktakeResponse, error)
+	CancelStocktake(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeResponse, error)
+}
+
+// StocktakeHandler обрабатывает запросы, связанные с пересчетами товаров на складах.
+type StocktakeHandler struct {
+	service StocktakeService
+}
+
+// NewStocktakeHandler создает новый экземпляр StocktakeHandler с заданным сервисом.
+func NewStocktakeHandler(service StocktakeService) *StocktakeHandler {
+	return &StocktakeHandler{
+		service: service,
+	}
+}
+
+// CreateStocktake обрабатывает запросы на открытие пересчета товаров на складе.
+func (h *StocktakeHandler) CreateStocktake(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.StocktakeHandler.CreateStocktake"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var request dto.StocktakeRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		log.Error("error while parsing JSON", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "cannot parse JSON")
+		return
+	}
+
+	validErr := validateStocktakeRequest(&request)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.CreateStocktake(r.Context(), &request)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrForeignKey):
+			custErr.UnnamedError(w, http.StatusBadRequest, "wrong warehouse ID")
+		case custErr.Any(err, custErr.ErrNotFoundProductAtWarehouse, custErr.ErrStocktakeEmpty):
+			custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+		default:
+			log.Error("error while creating stocktake", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while creating stocktake")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, response)
+}
+
+// validateStocktakeRequest проверяет корректность данных запроса на открытие пересчета.
+func validateStocktakeRequest(req *dto.StocktakeRequest) map[string]string {
+	validErr := make(map[string]string)
+
+	if req.WarehouseID == "" {
+		validErr["warehouse_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(req.WarehouseID); err != nil {
+		validErr["warehouse_id"] = "invalid warehouse ID"
+	}
+
+	if msg := validateStocktakeProductIDs(req.ProductIDs); msg != "" {
+		validErr["product_ids"] = msg
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
+
+// validateStocktakeProductIDs проверяет список товаров пересчета.
+//
+// Возвращает текст ошибки или пустую строку, если товары корректны.
+func validateStocktakeProductIDs(productIDs []string) string {
+	unique := make(map[string]struct{}, len(productIDs))
+	for _, productID := range productIDs {
+		if err := uuid.Validate(productID); err != nil {
+			return "invalid product ID"
+		}
+		if _, ok := unique[productID]; ok {
+			return "product IDs must be unique"
+		}
+		unique[productID] = struct{}{}
+	}
+
+	return ""
+}
+
+// GetStocktake обрабатывает запросы на получение пересчета.
+func (h *StocktakeHandler) GetStocktake(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.StocktakeHandler.GetStocktake"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	stocktakeID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong stocktake ID")
+		return
+	}
+
+	response, err := h.service.GetStocktake(r.Context(), stocktakeID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrStocktakeNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while getting stocktake", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting stocktake")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// SubmitStocktakeCounts обрабатывает запросы на запись посчитанного количества товаров.
+func (h *StocktakeHandler) SubmitStocktakeCounts(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.StocktakeHandler.SubmitStocktakeCounts"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	stocktakeID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong stocktake ID")
+		return
+	}
+
+	var request dto.StocktakeCountsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		log.Error("error while parsing JSON", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "cannot parse JSON")
+		return
+	}
+
+	validErr := validateStocktakeCountsRequest(&request)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.SubmitStocktakeCounts(r.Context(), stocktakeID, &request)
+	if err != nil {
+		writeStocktakeError(w, log, err, "error while submitting stocktake counts")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// validateStocktakeCountsRequest проверяет корректность посчитанного количества товаров.
+func validateStocktakeCountsRequest(req *dto.StocktakeCountsRequest) map[string]any {
+	validErr := make(map[string]any)
+
+	if len(req.Counts) == 0 {
+		validErr["counts"] = "there is no counted products"
+		return validErr
+	}
+
+	unique := make(map[string]struct{}, len(req.Counts))
+	countsErr := make(map[int]any)
+	for idx, count := range req.Counts {
+		countErr := make(map[string]string)
+
+		if count.ProductID == "" {
+			countErr["product_id"] = "this field cannot be empty"
+		} else if err := uuid.Validate(count.ProductID); err != nil {
+			countErr["product_id"] = "invalid product ID"
+		} else if _, ok := unique[count.ProductID]; ok {
+			countErr["product_id"] = "product ID must be unique"
+		}
+		unique[count.ProductID] = struct{}{}
+
+		if count.CountedCount == nil {
+			countErr["counted_count"] = "this field cannot be empty"
+		} else if *count.CountedCount < 0 {
+			countErr["counted_count"] = "counted count cannot be negative"
+		}
+
+		if len(countErr) != 0 {
+			countsErr[idx] = countErr
+		}
+	}
+
+	if len(countsErr) != 0 {
+		validErr["counts"] = countsErr
+		return validErr
+	}
+
+	return nil
+}
+
+// GetStocktakeVariance обрабатывает запросы на получение отчета о расхождениях пересчета.
+func (h *StocktakeHandler) GetStocktakeVariance(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.StocktakeHandler.GetStocktakeVariance"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	stocktakeID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong stocktake ID")
+		return
+	}
+
+	response, err := h.service.GetStocktakeVariance(r.Context(), stocktakeID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrStocktakeNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while getting stocktake variance", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting stocktake variance")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// PostStocktake обрабатывает запросы на проведение расхождений пересчета.
+func (h *StocktakeHandler) PostStocktake(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.StocktakeHandler.PostStocktake"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	stocktakeID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong stocktake ID")
+		return
+	}
+
+	// тело запроса необязательно: без него проводятся все посчитанные товары.
+	var request dto.StocktakePostRequest
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil && !errors.Is(err, io.EOF) {
+		log.Error("error while parsing JSON", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "cannot parse JSON")
+		return
+	}
+
+	if msg := validateStocktakeProductIDs(request.ProductIDs); msg != "" {
+		render.JSON(w, http.StatusBadRequest, map[string]string{"product_ids": msg})
+		return
+	}
+
+	response, err := h.service.PostStocktake(r.Context(), stocktakeID, &request)
+	if err != nil {
+		writeStocktakeError(w, log, err, "error while posting stocktake")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// CancelStocktake обрабатывает запросы на отмену пересчета.
+func (h *StocktakeHandler) CancelStocktake(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.StocktakeHandler.CancelStocktake"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	stocktakeID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong stocktake ID")
+		return
+	}
+
+	response, err := h.service.CancelStocktake(r.Context(), stocktakeID)
+	if err != nil {
+		writeStocktakeError(w, log, err, "error while cancelling stocktake")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// writeStocktakeError отправляет ответ на ошибку изменения пересчета.
+// Неизвестные ошибки записываются в лог и отправляются с текстом message.
+func writeStocktakeError(w http.ResponseWriter, log *zap.Logger, err error, message string) {
+	switch {
+	case errors.Is(err, custErr.ErrStocktakeNotFound):
+		custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+	case custErr.Any(err, custErr.ErrStocktakeNotOpen, custErr.ErrStocktakeSerialized):
+		custErr.UnnamedError(w, http.StatusConflict, err.Error())
+	case custErr.Any(err, custErr.ErrProductNotInStocktake, custErr.ErrStocktakeLineNotCounted):
+		custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, custErr.ErrInventoryNotFound):
+		custErr.UnnamedError(w, http.StatusNotFound, "there is no information about this product on warehouse")
+	default:
+		log.Error(message, zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, message)
+	}
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStocktakeRequest(t *testing.T) {
+	const (
+		warehouseID = "17b79680-4657-4ef4-9c3d-554a83c31828"
+		productID   = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+	)
+
+	cases := []struct {
+		Name    string
+		Request *dto.StocktakeRequest
+		WantErr map[string]string
+	}{
+		{Name: "Whole warehouse", Request: &dto.StocktakeRequest{WarehouseID: warehouseID}},
+		{Name: "Subset of products", Request: &dto.StocktakeRequest{WarehouseID: warehouseID, ProductIDs: []string{productID}}},
+		{
+			Name:    "Empty warehouse",
+			Request: &dto.StocktakeRequest{},
+			WantErr: map[string]string{"warehouse_id": "this field cannot be empty"},
+		},
+		{
+			Name:    "Wrong product",
+			Request: &dto.StocktakeRequest{WarehouseID: "warehouse", ProductIDs: []string{"product"}},
+			WantErr: map[string]string{"warehouse_id": "invalid warehouse ID", "product_ids": "invalid product ID"},
+		},
+		{
+			Name:    "Repeated product",
+			Request: &dto.StocktakeRequest{WarehouseID: warehouseID, ProductIDs: []string{productID, productID}},
+			WantErr: map[string]string{"product_ids": "product IDs must be unique"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			require.Equal(t, tc.WantErr, validateStocktakeRequest(tc.Request))
+		})
+	}
+}
+
+func TestValidateStocktakeCountsRequest(t *testing.T) {
+	const productID = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+
+	cases := []struct {
+		Name    string
+		Request *dto.StocktakeCountsRequest
+		WantErr map[string]any
+	}{
+		{
+			Name:    "Counted product",
+			Request: &dto.StocktakeCountsRequest{Counts: []*dto.StocktakeCountRequest{{ProductID: productID, CountedCount: ptr(0)}}},
+		},
+		{
+			Name:    "No counts",
+			Request: &dto.StocktakeCountsRequest{},
+			WantErr: map[string]any{"counts": "there is no counted products"},
+		},
+		{
+			Name: "Wrong counts",
+			Request: &dto.StocktakeCountsRequest{Counts: []*dto.StocktakeCountRequest{
+				{ProductID: productID, CountedCount: ptr(1)},
+				{ProductID: productID, CountedCount: ptr(-1)},
+				{ProductID: "product"},
+			}},
+			WantErr: map[string]any{"counts": map[int]any{
+				1: map[string]string{"product_id": "product ID must be unique", "counted_count": "counted count cannot be negative"},
+				2: map[string]string{"product_id": "invalid product ID", "counted_count": "this field cannot be empty"},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			require.Equal(t, tc.WantErr, validateStocktakeCountsRequest(tc.Request))
+		})
+	}
+}
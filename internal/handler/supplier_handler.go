@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"go.uber.org/zap"
+)
+
+// maxSupplierFieldLength - максимальная длина названия и контактов поставщика.
+const maxSupplierFieldLength = 255
+
+// SupplierService определяет методы для работы с поставщиками.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type SupplierService interface {
+	GetSuppliers(ctx context.Context) ([]*dto.SupplierResponse, error)
+	CreateSupplier(ctx context.Context, request *dto.SupplierRequest) (*dto.SupplierResponse, error)
+}
+
+// SupplierHandler обрабатывает запросы, связанные с поставщиками.
+type SupplierHandler struct {
+	service SupplierService
+}
+
+// NewSupplierHandler создает новый экземпляр SupplierHandler с заданным сервисом.
+func NewSupplierHandler(service SupplierService) *SupplierHandler {
+	return &SupplierHandler{
+		service: service,
+	}
+}
+
+// SuppliersHandler обрабатывает запросы на получение списка поставщиков или создание нового поставщика.
+func (h *SupplierHandler) SuppliersHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetSuppliers(w, r)
+	case http.MethodPost:
+		h.CreateSupplier(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// GetSuppliers обрабатывает запросы на получение списка поставщиков.
+func (h *SupplierHandler) GetSuppliers(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.SupplierHandler.GetSuppliers"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	response, err := h.service.GetSuppliers(r.Context())
+	if err != nil {
+		log.Error("error while getting suppliers", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting suppliers")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// CreateSupplier обрабатывает запросы на создание нового поставщика.
+func (h *SupplierHandler) CreateSupplier(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.SupplierHandler.CreateSupplier"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	var request dto.SupplierRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		log.Error("error while parsing JSON", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "cannot parse JSON")
+		return
+	}
+
+	validErr := validateSupplierRequest(&request)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.CreateSupplier(r.Context(), &request)
+	if err != nil {
+		if errors.Is(err, custErr.ErrSupplierAlreadyExists) {
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+			return
+		}
+		log.Error("error while creating supplier", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while creating supplier")
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, response)
+}
+
+// validateSupplierRequest проверяет корректность данных поставщика.
+func validateSupplierRequest(req *dto.SupplierRequest) map[string]string {
+	validErr := make(map[string]string)
+
+	if strings.TrimSpace(req.Name) == "" {
+		validErr["name"] = "this field cannot be empty"
+	} else if len(req.Name) > maxSupplierFieldLength {
+		validErr["name"] = fmt.Sprintf("name must be at most %d characters", maxSupplierFieldLength)
+	}
+
+	if len(req.Email) > maxSupplierFieldLength {
+		validErr["email"] = fmt.Sprintf("email must be at most %d characters", maxSupplierFieldLength)
+	} else if req.Email != "" && !strings.Contains(req.Email, "@") {
+		validErr["email"] = "invalid email"
+	}
+
+	if len(req.Phone) > maxSupplierFieldLength {
+		validErr["phone"] = fmt.Sprintf("phone must be at most %d characters", maxSupplierFieldLength)
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
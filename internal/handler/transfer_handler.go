@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// TransferService определяет методы для перемещения товаров между складами.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type TransferService interface {
+	CreateTransfer(ctx context.Context, request *dto.TransferRequest) (*dto.TransferResponse, error)
+	ReceiveTransfer(ctx context.Context, transferID uuid.UUID) (*dto.TransferResponse, error)
+	GetTransfer(ctx context.Context, transferID uuid.UUID) (*dto.TransferResponse, error)
+}
+
+// TransferHandler обрабатывает запросы, связанные с перемещением товаров между складами.
+type TransferHandler struct {
+	service TransferService
+}
+
+// NewTransferHandler создает новый экземпляр TransferHandler с заданным сервисом перемещений.
+func NewTransferHandler(service TransferService) *TransferHandler {
+	return &TransferHandler{
+		service: service,
+	}
+}
+
+// CreateTransfer обрабатывает запросы на перемещение товаров между складами.
+func (h *TransferHandler) CreateTransfer(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.TransferHandler.CreateTransfer"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	transferReq, err := parseTransferRequest(r.Body)
+	if err != nil {
+		log.Error("error while parsing transfer", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "wrong request body")
+		return
+	}
+
+	validErr := validateTransferRequest(transferReq)
+	if validErr != nil {
+		render.JSON(w, http.StatusBadRequest, validErr)
+		return
+	}
+
+	response, err := h.service.CreateTransfer(r.Context(), transferReq)
+	if err != nil {
+		if custErr.Any(err, custErr.ErrNotEnoughProductCount, custErr.ErrNotFoundProductAtWarehouse, custErr.ErrForeignKey) {
+			custErr.UnnamedError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error("error while creating transfer", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while creating transfer")
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, response)
+}
+
+// parseTransferRequest извлекает данные перемещения из запроса и возвращает их в виде dto.TransferRequest.
+func parseTransferRequest(r io.Reader) (*dto.TransferRequest, error) {
+	var transfer dto.TransferRequest
+
+	if err := json.NewDecoder(r).Decode(&transfer); err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// validateTransferRequest проверяет корректность данных перемещения.
+func validateTransferRequest(req *dto.TransferRequest) map[string]any {
+	validErr := make(map[string]any)
+
+	source, sourceErr := uuid.Parse(req.SourceWarehouseID)
+	if req.SourceWarehouseID == "" {
+		validErr["source_warehouse_id"] = "this field cannot be empty"
+	} else if sourceErr != nil {
+		validErr["source_warehouse_id"] = "invalid warehouse ID"
+	}
+
+	// ID сравниваются после разбора, так как один и тот же UUID может быть записан по-разному.
+	if req.DestinationWarehouseID == "" {
+		validErr["destination_warehouse_id"] = "this field cannot be empty"
+	} else if destination, err := uuid.Parse(req.DestinationWarehouseID); err != nil {
+		validErr["destination_warehouse_id"] = "invalid warehouse ID"
+	} else if sourceErr == nil && destination == source {
+		validErr["destination_warehouse_id"] = "destination must differ from source"
+	}
+
+	if len(req.Products) == 0 {
+		validErr["products"] = "there is no products to transfer"
+	} else {
+		productsID := make(map[uuid.UUID]struct{}, len(req.Products))
+		productsErr := make(map[int]any)
+		for idx, product := range req.Products {
+			if id, err := uuid.Parse(product.ProductID); err == nil {
+				if _, ok := productsID[id]; ok {
+					productsErr[idx] = map[string]string{"product_id": "product ID must be unique"}
+					continue
+				}
+				productsID[id] = struct{}{}
+			}
+			productErr := validateTransferProduct(product)
+			if productErr != nil {
+				productsErr[idx] = productErr
+			}
+		}
+
+		if len(productsErr) != 0 {
+			validErr["products"] = productsErr
+		}
+	}
+
+	if len(validErr) != 0 {
+		return validErr
+	}
+
+	return nil
+}
+
+// validateTransferProduct проверяет корректность данных продукта в перемещении.
+func validateTransferProduct(product *dto.TransferProductRequest) map[string]string {
+	productErr := make(map[string]string)
+	if product.ProductID == "" {
+		productErr["product_id"] = "this field cannot be empty"
+	} else if err := uuid.Validate(product.ProductID); err != nil {
+		productErr["product_id"] = "invalid product ID"
+	}
+
+	if product.Count == nil {
+		productErr["product_count"] = "this field cannot be empty"
+	} else if *product.Count <= 0 {
+		productErr["product_count"] = "product count must be greater than 0"
+	}
+
+	if len(productErr) != 0 {
+		return productErr
+	}
+
+	return nil
+}
+
+// GetTransfer обрабатывает запросы на получение информации о перемещении.
+func (h *TransferHandler) GetTransfer(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.TransferHandler.GetTransfer"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	transferID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong transfer ID")
+		return
+	}
+
+	response, err := h.service.GetTransfer(r.Context(), transferID)
+	if err != nil {
+		if errors.Is(err, custErr.ErrTransferNotFound) {
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error("error while getting transfer", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting transfer")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// ReceiveTransfer обрабатывает запросы на приемку перемещения на складе-получателе.
+func (h *TransferHandler) ReceiveTransfer(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.TransferHandler.ReceiveTransfer"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	transferID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong transfer ID")
+		return
+	}
+
+	response, err := h.service.ReceiveTransfer(r.Context(), transferID)
+	if err != nil {
+		switch {
+		case errors.Is(err, custErr.ErrTransferNotFound):
+			custErr.UnnamedError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, custErr.ErrTransferNotInTransit):
+			custErr.UnnamedError(w, http.StatusConflict, err.Error())
+		default:
+			log.Error("error while receiving transfer", zap.Error(err))
+			custErr.UnnamedError(w, http.StatusInternalServerError, "error while receiving transfer")
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
+
+// parsePathUUID извлекает идентификатор из именованного сегмента пути запроса.
+func parsePathUUID(r *http.Request, name string) (uuid.UUID, error) {
+	id, err := uuid.Parse(r.PathValue(name))
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s is not valid: %w", name, err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSourceID      = "17b79680-4657-4ef4-9c3d-554a83c31828"
+	testDestinationID = "5c0f1e2a-8b3d-4f6a-9e7c-1d2b3a4c5e6f"
+	testProductID     = "7a9b1e4c-2f0d-4d8e-9a51-3c6f2b8d0e14"
+	testTransferID    = "0e6d5c4b-3a29-4817-9605-f4e3d2c1b0a9"
+)
+
+func TestCreateTransfer(t *testing.T) {
+	cases := []struct {
+		Name         string
+		Method       string
+		Body         string
+		CallService  bool
+		ReturnError  error
+		StatusCode   int
+		ResponseBody string
+	}{
+		{
+			Name:         "Success",
+			Method:       http.MethodPost,
+			Body:         `{"source_warehouse_id":"` + testSourceID + `","destination_warehouse_id":"` + testDestinationID + `","products":[{"product_id":"` + testProductID + `","product_count":2}],"in_transit":true}`,
+			CallService:  true,
+			StatusCode:   http.StatusCreated,
+			ResponseBody: `{"transfer_id":"` + testTransferID + `","source_warehouse_id":"","destination_warehouse_id":"","status":"in_transit","products":null,"created_at":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			Name:         "Wrong method",
+			Method:       http.MethodGet,
+			StatusCode:   http.StatusMethodNotAllowed,
+			ResponseBody: ``,
+		},
+		{
+			Name:         "Wrong body",
+			Method:       http.MethodPost,
+			Body:         `{"products":`,
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"error":"wrong request body"}`,
+		},
+		{
+			Name:         "Same source and destination",
+			Method:       http.MethodPost,
+			Body:         `{"source_warehouse_id":"` + testSourceID + `","destination_warehouse_id":"` + testSourceID + `","products":[{"product_id":"` + testProductID + `","product_count":2}]}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"destination_warehouse_id":"destination must differ from source"}`,
+		},
+		{
+			Name:         "Same source and destination in different case",
+			Method:       http.MethodPost,
+			Body:         `{"source_warehouse_id":"` + testSourceID + `","destination_warehouse_id":"` + strings.ToUpper(testSourceID) + `","products":[{"product_id":"` + testProductID + `","product_count":2}]}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"destination_warehouse_id":"destination must differ from source"}`,
+		},
+		{
+			Name:         "Empty request",
+			Method:       http.MethodPost,
+			Body:         `{}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"source_warehouse_id":"this field cannot be empty","destination_warehouse_id":"this field cannot be empty","products":"there is no products to transfer"}`,
+		},
+		{
+			Name:         "Invalid IDs",
+			Method:       http.MethodPost,
+			Body:         `{"source_warehouse_id":"source","destination_warehouse_id":"destination","products":[]}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"source_warehouse_id":"invalid warehouse ID","destination_warehouse_id":"invalid warehouse ID","products":"there is no products to transfer"}`,
+		},
+		{
+			Name:   "Wrong products",
+			Method: http.MethodPost,
+			Body: `{"source_warehouse_id":"` + testSourceID + `","destination_warehouse_id":"` + testDestinationID + `","products":[` +
+				`{"product_id":"` + testProductID + `","product_count":2},` +
+				`{"product_id":"` + testProductID + `","product_count":1},` +
+				`{"product_id":"product","product_count":0},` +
+				`{}]}`,
+			StatusCode: http.StatusBadRequest,
+			ResponseBody: `{"products":{` +
+				`"1":{"product_id":"product ID must be unique"},` +
+				`"2":{"product_id":"invalid product ID","product_count":"product count must be greater than 0"},` +
+				`"3":{"product_id":"this field cannot be empty","product_count":"this field cannot be empty"}}}`,
+		},
+		{
+			Name:   "Same product in different case",
+			Method: http.MethodPost,
+			Body: `{"source_warehouse_id":"` + testSourceID + `","destination_warehouse_id":"` + testDestinationID + `","products":[` +
+				`{"product_id":"` + testProductID + `","product_count":2},` +
+				`{"product_id":"` + strings.ToUpper(testProductID) + `","product_count":1}]}`,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"products":{"1":{"product_id":"product ID must be unique"}}}`,
+		},
+		{
+			Name:         "Not enough product",
+			Method:       http.MethodPost,
+			Body:         `{"source_warehouse_id":"` + testSourceID + `","destination_warehouse_id":"` + testDestinationID + `","products":[{"product_id":"` + testProductID + `","product_count":2}]}`,
+			CallService:  true,
+			ReturnError:  custErr.ErrNotEnoughProductCount,
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"` + custErr.ErrNotEnoughProductCount.Error() + `"}`,
+		},
+		{
+			Name:         "Service error",
+			Method:       http.MethodPost,
+			Body:         `{"source_warehouse_id":"` + testSourceID + `","destination_warehouse_id":"` + testDestinationID + `","products":[{"product_id":"` + testProductID + `","product_count":2}]}`,
+			CallService:  true,
+			ReturnError:  errors.New("internal server error"),
+			StatusCode:   http.StatusInternalServerError,
+			ResponseBody: `{"error":"error while creating transfer"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockService := NewMockTransferService(t)
+			if tc.CallService {
+				var response *dto.TransferResponse
+				if tc.ReturnError == nil {
+					response = &dto.TransferResponse{TransferID: testTransferID, Status: "in_transit"}
+				}
+				mockService.On("CreateTransfer", mock.Anything, mock.AnythingOfType("*dto.TransferRequest")).
+					Return(response, tc.ReturnError).
+					Once()
+			}
+
+			logger.CreateNOPLogger()
+
+			handler := NewTransferHandler(mockService)
+			req := httptest.NewRequest(tc.Method, "/api/transfers", strings.NewReader(tc.Body))
+
+			rr := httptest.NewRecorder()
+
+			handler.CreateTransfer(rr, req)
+			require.Equal(t, tc.StatusCode, rr.Code)
+
+			if tc.ResponseBody == "" {
+				assert.Empty(t, rr.Body.String())
+			} else {
+				assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestReceiveTransfer(t *testing.T) {
+	cases := []struct {
+		Name         string
+		Method       string
+		TransferID   string
+		CallService  bool
+		ReturnError  error
+		StatusCode   int
+		ResponseBody string
+	}{
+		{
+			Name:         "Success",
+			Method:       http.MethodPost,
+			TransferID:   testTransferID,
+			CallService:  true,
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"transfer_id":"` + testTransferID + `","source_warehouse_id":"","destination_warehouse_id":"","status":"received","products":null,"created_at":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			Name:         "Wrong method",
+			Method:       http.MethodGet,
+			TransferID:   testTransferID,
+			StatusCode:   http.StatusMethodNotAllowed,
+			ResponseBody: ``,
+		},
+		{
+			Name:         "Wrong transfer ID",
+			Method:       http.MethodPost,
+			TransferID:   "transfer",
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"error":"wrong transfer ID"}`,
+		},
+		{
+			Name:         "Not found",
+			Method:       http.MethodPost,
+			TransferID:   testTransferID,
+			CallService:  true,
+			ReturnError:  custErr.ErrTransferNotFound,
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"error":"transfer not found"}`,
+		},
+		{
+			Name:         "Already received",
+			Method:       http.MethodPost,
+			TransferID:   testTransferID,
+			CallService:  true,
+			ReturnError:  custErr.ErrTransferNotInTransit,
+			StatusCode:   http.StatusConflict,
+			ResponseBody: `{"error":"transfer is not in transit"}`,
+		},
+		{
+			Name:         "Service error",
+			Method:       http.MethodPost,
+			TransferID:   testTransferID,
+			CallService:  true,
+			ReturnError:  errors.New("internal server error"),
+			StatusCode:   http.StatusInternalServerError,
+			ResponseBody: `{"error":"error while receiving transfer"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockService := NewMockTransferService(t)
+			if tc.CallService {
+				var response *dto.TransferResponse
+				if tc.ReturnError == nil {
+					response = &dto.TransferResponse{TransferID: testTransferID, Status: "received"}
+				}
+				mockService.On("ReceiveTransfer", mock.Anything, uuid.MustParse(tc.TransferID)).
+					Return(response, tc.ReturnError).
+					Once()
+			}
+
+			logger.CreateNOPLogger()
+
+			handler := NewTransferHandler(mockService)
+			req := httptest.NewRequest(tc.Method, "/api/transfers/"+tc.TransferID+"/receive", nil)
+			req.SetPathValue("id", tc.TransferID)
+
+			rr := httptest.NewRecorder()
+
+			handler.ReceiveTransfer(rr, req)
+			require.Equal(t, tc.StatusCode, rr.Code)
+
+			if tc.ResponseBody == "" {
+				assert.Empty(t, rr.Body.String())
+			} else {
+				assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+			}
+		})
+	}
+}
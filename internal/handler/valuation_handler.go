@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/render"
+	"go.uber.org/zap"
+)
+
+// ValuationService определяет методы для оценки себестоимости остатков товаров.
+//
+//go:generate mockery init github.com/PIRSON21/mediasoft-intership2025/internal/handler
+type ValuationService interface {
+	GetStockValuation(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.StockValuationResponse, error)
+}
+
+// ValuationHandler обрабатывает запросы, связанные с оценкой себестоимости остатков.
+type ValuationHandler struct {
+	service ValuationService
+}
+
+// NewValuationHandler создает новый экземпляр ValuationHandler с заданным сервисом.
+func NewValuationHandler(service ValuationService) *ValuationHandler {
+	return &ValuationHandler{
+		service: service,
+	}
+}
+
+// GetStockValuation обрабатывает запросы на получение оценки себестоимости остатков склада.
+func (h *ValuationHandler) GetStockValuation(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger().With(
+		zap.String("op", "handler.ValuationHandler.GetStockValuation"),
+		zap.String("request-id", middleware.GetRequestID(r.Context())),
+	)
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	warehouseID, err := parsePathUUID(r, "id")
+	if err != nil {
+		custErr.UnnamedError(w, http.StatusBadRequest, "wrong warehouseID")
+		return
+	}
+
+	response, err := h.service.GetStockValuation(r.Context(), parseParams(r), warehouseID.String())
+	if err != nil {
+		log.Error("error while getting stock valuation", zap.Error(err))
+		custErr.UnnamedError(w, http.StatusInternalServerError, "error while getting stock valuation")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, response)
+}
@@ -114,8 +114,26 @@ func validateWarehouse(warehouse *dto.WarehouseRequest) map[string]string {
 		validErr["address"] = "address cannot be empty"
 	}
 
+	validateLocation(validErr, warehouse.Latitude, warehouse.Longitude)
+
 	if len(validErr) != 0 {
 		return validErr
 	}
 	return nil
 }
+
+// validateLocation проверяет, что координаты заданы парой и лежат в допустимых пределах.
+func validateLocation(validErr map[string]string, latitude, longitude *float64) {
+	if (latitude == nil) != (longitude == nil) {
+		validErr["location"] = "latitude and longitude must be set together"
+		return
+	}
+
+	if latitude != nil && (*latitude < -90 || *latitude > 90) {
+		validErr["latitude"] = "invalid latitude"
+	}
+
+	if longitude != nil && (*longitude < -180 || *longitude > 180) {
+		validErr["longitude"] = "invalid longitude"
+	}
+}
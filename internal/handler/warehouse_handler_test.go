@@ -85,3 +85,40 @@ func TestGetWarehouses(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLocation(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Latitude  *float64
+		Longitude *float64
+		WantErr   map[string]string
+	}{
+		{Name: "No location"},
+		{Name: "Valid location", Latitude: ptr(55.75), Longitude: ptr(37.62)},
+		{Name: "Edge values", Latitude: ptr(-90.0), Longitude: ptr(180.0)},
+		{
+			Name:     "Only latitude",
+			Latitude: ptr(55.75),
+			WantErr:  map[string]string{"location": "latitude and longitude must be set together"},
+		},
+		{
+			Name:      "Out of range",
+			Latitude:  ptr(91.0),
+			Longitude: ptr(-181.0),
+			WantErr:   map[string]string{"latitude": "invalid latitude", "longitude": "invalid longitude"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			validErr := make(map[string]string)
+			validateLocation(validErr, tc.Latitude, tc.Longitude)
+
+			if tc.WantErr == nil {
+				require.Empty(t, validErr)
+				return
+			}
+			require.Equal(t, tc.WantErr, validErr)
+		})
+	}
+}
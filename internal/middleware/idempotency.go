@@ -0,0 +1,162 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// IdempotencyKeyHeader - заголовок, в котором клиент передает ключ идемпотентности.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotencyStore - хранилище ответов на запросы с ключом идемпотентности.
+type IdempotencyStore interface {
+	LockIdempotencyKey(ctx context.Context, key, requestHash string, lockTTL time.Duration) (*domain.IdempotencyRecord, bool, error)
+	SaveIdempotencyResponse(ctx context.Context, key string, status int, body []byte) error
+	DeleteIdempotencyKey(ctx context.Context, key string) error
+}
+
+// Idempotency повторяет сохраненный ответ для запросов с уже использованным ключом идемпотентности.
+//
+// Первый ответ на запрос с заголовком Idempotency-Key сохраняется в store.
+// Повторный запрос с тем же ключом и телом получает сохраненный ответ без вызова обработчика.
+// Если с тем же ключом пришел другой запрос, то возвращается 422.
+// Если первый запрос еще обрабатывается, то возвращается 409. Если ответ на первый
+// запрос не сохранен дольше lockTTL, то ключ считается брошенным и повторный запрос
+// обрабатывается заново.
+//
+// Ответы с кодом 5xx не сохраняются, чтобы запрос можно было повторить.
+func Idempotency(store IdempotencyStore, lockTTL time.Duration) func(http.Handler) http.HandlerFunc {
+	return func(next http.Handler) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			log := logger.GetLogger().With(
+				zap.String("op", "middleware.Idempotency"),
+				zap.String("request-id", GetRequestID(r.Context())),
+			)
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				custErr.UnnamedError(w, http.StatusBadRequest, "cannot read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			requestHash := hashRequest(r, body)
+
+			record, locked, err := store.LockIdempotencyKey(r.Context(), key, requestHash, lockTTL)
+			if err != nil {
+				log.Error("error while locking idempotency key", zap.Error(err))
+				custErr.UnnamedError(w, http.StatusInternalServerError, "error while checking idempotency key")
+				return
+			}
+
+			if !locked {
+				replayResponse(w, record, requestHash)
+				return
+			}
+
+			// ответ сохраняется, даже если клиент уже отключился.
+			storeCtx := context.WithoutCancel(r.Context())
+			recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			defer func() {
+				if rec := recover(); rec != nil {
+					store.DeleteIdempotencyKey(storeCtx, key)
+					panic(rec)
+				}
+			}()
+
+			next.ServeHTTP(recorder, r)
+
+			if recorder.status >= http.StatusInternalServerError {
+				err = store.DeleteIdempotencyKey(storeCtx, key)
+			} else {
+				err = store.SaveIdempotencyResponse(storeCtx, key, recorder.status, recorder.body.Bytes())
+			}
+			if err != nil {
+				log.Error("error while saving idempotency response", zap.Error(err))
+			}
+		}
+	}
+}
+
+// replayResponse отвечает на повторный запрос с уже использованным ключом идемпотентности.
+func replayResponse(w http.ResponseWriter, record *domain.IdempotencyRecord, requestHash string) {
+	if record.RequestHash != requestHash {
+		custErr.UnnamedError(w, http.StatusUnprocessableEntity, "idempotency key was already used for another request")
+		return
+	}
+
+	if !record.Completed() {
+		custErr.UnnamedError(w, http.StatusConflict, "request with this idempotency key is still in progress")
+		return
+	}
+
+	if len(record.ResponseBody) != 0 {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(record.ResponseStatus)
+	w.Write(record.ResponseBody)
+}
+
+// hashRequest вычисляет хеш метода, пути и тела запроса.
+//
+// JSON-тело приводится к каноническому виду, чтобы порядок полей и пробелы не влияли на хеш.
+func hashRequest(r *http.Request, body []byte) string {
+	var payload any
+	if err := json.Unmarshal(body, &payload); err == nil {
+		if canonical, err := json.Marshal(payload); err == nil {
+			body = canonical
+		}
+	}
+
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{0})
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder запоминает статус и тело ответа, одновременно передавая их клиенту.
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+// WriteHeader запоминает статус ответа.
+func (r *responseRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write запоминает тело ответа.
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,177 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryIdempotencyStore - хранилище ключей идемпотентности в памяти для тестов.
+type memoryIdempotencyStore struct {
+	records map[string]*domain.IdempotencyRecord
+}
+
+func (s *memoryIdempotencyStore) LockIdempotencyKey(_ context.Context, key, requestHash string, lockTTL time.Duration) (*domain.IdempotencyRecord, bool, error) {
+	record, ok := s.records[key]
+	if ok && (record.Completed() || record.RequestHash != requestHash || time.Since(record.CreatedAt) < lockTTL) {
+		return record, false, nil
+	}
+	s.records[key] = &domain.IdempotencyRecord{Key: key, RequestHash: requestHash, CreatedAt: time.Now()}
+	return nil, true, nil
+}
+
+func (s *memoryIdempotencyStore) SaveIdempotencyResponse(_ context.Context, key string, status int, body []byte) error {
+	s.records[key].ResponseStatus = status
+	s.records[key].ResponseBody = body
+	return nil
+}
+
+func (s *memoryIdempotencyStore) DeleteIdempotencyKey(_ context.Context, key string) error {
+	delete(s.records, key)
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	cases := []struct {
+		Name          string
+		FirstBody     string
+		SecondBody    string
+		HandlerStatus int
+		StatusCode    int
+		ResponseBody  string
+		Calls         int
+	}{
+		{
+			Name:          "Replay",
+			FirstBody:     `{"warehouse_id":"1","products":[]}`,
+			SecondBody:    `{ "products": [], "warehouse_id": "1" }`,
+			HandlerStatus: http.StatusOK,
+			StatusCode:    http.StatusOK,
+			ResponseBody:  `{"ok":true}`,
+			Calls:         1,
+		},
+		{
+			Name:          "Conflicting payload",
+			FirstBody:     `{"warehouse_id":"1"}`,
+			SecondBody:    `{"warehouse_id":"2"}`,
+			HandlerStatus: http.StatusOK,
+			StatusCode:    http.StatusUnprocessableEntity,
+			ResponseBody:  `{"error":"idempotency key was already used for another request"}`,
+			Calls:         1,
+		},
+		{
+			Name:          "Server error is not stored",
+			FirstBody:     `{"warehouse_id":"1"}`,
+			SecondBody:    `{"warehouse_id":"1"}`,
+			HandlerStatus: http.StatusInternalServerError,
+			StatusCode:    http.StatusInternalServerError,
+			ResponseBody:  `{"ok":true}`,
+			Calls:         2,
+		},
+	}
+
+	logger.CreateNOPLogger()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			store := &memoryIdempotencyStore{records: make(map[string]*domain.IdempotencyRecord)}
+			calls := 0
+			handler := Idempotency(store, time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.HandlerStatus)
+				w.Write([]byte(`{"ok":true}`))
+			}))
+
+			for _, body := range []string{tc.FirstBody, tc.SecondBody} {
+				req := httptest.NewRequest(http.MethodPost, "/api/inventory/buy", strings.NewReader(body))
+				req.Header.Set(IdempotencyKeyHeader, "key")
+				rr := httptest.NewRecorder()
+
+				handler(rr, req)
+
+				if body == tc.SecondBody {
+					require.Equal(t, tc.StatusCode, rr.Code)
+					assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+				}
+			}
+
+			require.Equal(t, tc.Calls, calls)
+		})
+	}
+}
+
+func TestIdempotencyInProgress(t *testing.T) {
+	cases := []struct {
+		Name         string
+		LockedAgo    time.Duration
+		RequestHash  string
+		StatusCode   int
+		ResponseBody string
+		Calls        int
+	}{
+		{
+			Name:         "Still in progress",
+			LockedAgo:    time.Second,
+			StatusCode:   http.StatusConflict,
+			ResponseBody: `{"error":"request with this idempotency key is still in progress"}`,
+			Calls:        0,
+		},
+		{
+			Name:         "Abandoned key is reclaimed",
+			LockedAgo:    2 * time.Minute,
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"ok":true}`,
+			Calls:        1,
+		},
+		{
+			Name:         "Abandoned key of another request",
+			LockedAgo:    2 * time.Minute,
+			RequestHash:  "another",
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"error":"idempotency key was already used for another request"}`,
+			Calls:        0,
+		},
+	}
+
+	logger.CreateNOPLogger()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			body := `{"warehouse_id":"1"}`
+			req := httptest.NewRequest(http.MethodPost, "/api/inventory/buy", strings.NewReader(body))
+			req.Header.Set(IdempotencyKeyHeader, "key")
+
+			requestHash := tc.RequestHash
+			if requestHash == "" {
+				requestHash = hashRequest(req, []byte(body))
+			}
+
+			store := &memoryIdempotencyStore{records: map[string]*domain.IdempotencyRecord{
+				"key": {Key: "key", RequestHash: requestHash, CreatedAt: time.Now().Add(-tc.LockedAgo)},
+			}}
+			calls := 0
+			handler := Idempotency(store, time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"ok":true}`))
+			}))
+
+			rr := httptest.NewRecorder()
+			handler(rr, req)
+
+			require.Equal(t, tc.StatusCode, rr.Code)
+			assert.JSONEq(t, tc.ResponseBody, rr.Body.String())
+			require.Equal(t, tc.Calls, calls)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// LogNotifier записывает оповещения об остатках в лог приложения.
+type LogNotifier struct{}
+
+// NewLogNotifier создает новый экземпляр LogNotifier.
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+// Notify записывает оповещение в лог.
+func (n *LogNotifier) Notify(ctx context.Context, alert *domain.StockAlert) error {
+	fields := []zap.Field{
+		zap.String("op", "notifier.LogNotifier.Notify"),
+		zap.String("request-id", middleware.GetRequestID(ctx)),
+		zap.String("kind", string(alert.Kind)),
+		zap.String("warehouse_id", alert.Warehouse.ID.String()),
+		zap.String("product_id", alert.Product.ID.String()),
+		zap.Int("product_count", alert.ProductCount),
+		zap.Int("min_quantity", alert.MinQuantity),
+		zap.Int("reorder_quantity", alert.ReorderQuantity),
+		zap.String("reason", string(alert.Reason)),
+	}
+
+	if alert.Backorder != nil {
+		fields = append(fields,
+			zap.String("order_id", alert.Backorder.OrderID.String()),
+			zap.String("backorder_id", alert.Backorder.ID.String()),
+			zap.Int("backorder_count", alert.Backorder.Count),
+		)
+	}
+
+	logger.GetLogger().Warn("stock alert", fields...)
+
+	return nil
+}
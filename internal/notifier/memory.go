@@ -0,0 +1,40 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// MemoryNotifier сохраняет оповещения об остатках в памяти. Используется в тестах.
+type MemoryNotifier struct {
+	mu     sync.Mutex
+	alerts []*domain.StockAlert
+}
+
+// NewMemoryNotifier создает новый экземпляр MemoryNotifier.
+func NewMemoryNotifier() *MemoryNotifier {
+	return &MemoryNotifier{}
+}
+
+// Notify сохраняет оповещение.
+func (n *MemoryNotifier) Notify(_ context.Context, alert *domain.StockAlert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.alerts = append(n.alerts, alert)
+
+	return nil
+}
+
+// Alerts возвращает сохраненные оповещения в порядке получения.
+func (n *MemoryNotifier) Alerts() []*domain.StockAlert {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	alerts := make([]*domain.StockAlert, len(n.alerts))
+	copy(alerts, n.alerts)
+
+	return alerts
+}
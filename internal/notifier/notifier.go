@@ -0,0 +1,51 @@
+// Package notifier доставляет оповещения об остатках товаров на складах.
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// Notifier доставляет оповещения об остатках товаров.
+type Notifier interface {
+	Notify(ctx context.Context, alert *domain.StockAlert) error
+}
+
+// stockAlertPayload - оповещение об остатке в том виде, в котором оно отправляется получателям.
+type stockAlertPayload struct {
+	Kind            string    `json:"kind"`
+	WarehouseID     string    `json:"warehouse_id"`
+	ProductID       string    `json:"product_id"`
+	ProductCount    int       `json:"product_count"`
+	MinQuantity     int       `json:"min_quantity"`
+	ReorderQuantity int       `json:"reorder_quantity"`
+	Reason          string    `json:"reason"`
+	OrderID         string    `json:"order_id,omitempty"`        // Заказ обеспеченного предзаказа.
+	BackorderID     string    `json:"backorder_id,omitempty"`    // Обеспеченный предзаказ.
+	BackorderCount  int       `json:"backorder_count,omitempty"` // Количество товара в обеспеченном предзаказе.
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// newStockAlertPayload преобразует оповещение для отправки.
+func newStockAlertPayload(alert *domain.StockAlert) *stockAlertPayload {
+	payload := &stockAlertPayload{
+		Kind:            string(alert.Kind),
+		WarehouseID:     alert.Warehouse.ID.String(),
+		ProductID:       alert.Product.ID.String(),
+		ProductCount:    alert.ProductCount,
+		MinQuantity:     alert.MinQuantity,
+		ReorderQuantity: alert.ReorderQuantity,
+		Reason:          string(alert.Reason),
+		CreatedAt:       alert.CreatedAt,
+	}
+
+	if alert.Backorder != nil {
+		payload.OrderID = alert.Backorder.OrderID.String()
+		payload.BackorderID = alert.Backorder.ID.String()
+		payload.BackorderCount = alert.Backorder.Count
+	}
+
+	return payload
+}
@@ -0,0 +1,90 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAlert() *domain.StockAlert {
+	return &domain.StockAlert{
+		Kind:            domain.StockAlertLow,
+		Warehouse:       &domain.Warehouse{ID: uuid.New()},
+		Product:         &domain.Product{ID: uuid.New()},
+		ProductCount:    3,
+		MinQuantity:     5,
+		ReorderQuantity: 20,
+		Reason:          domain.MovementSale,
+		CreatedAt:       time.Now(),
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	alert := newTestAlert()
+
+	var got stockAlertPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	err := NewWebhookNotifier(srv.URL, time.Second).Notify(context.Background(), alert)
+	require.NoError(t, err)
+	require.Equal(t, "low_stock", got.Kind)
+	require.Equal(t, alert.Product.ID.String(), got.ProductID)
+	require.Equal(t, 20, got.ReorderQuantity)
+}
+
+func TestWebhookNotifierErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	err := NewWebhookNotifier(srv.URL, time.Second).Notify(context.Background(), newTestAlert())
+	require.Error(t, err)
+}
+
+func TestMemoryNotifier(t *testing.T) {
+	n := NewMemoryNotifier()
+	alert := newTestAlert()
+
+	require.NoError(t, n.Notify(context.Background(), alert))
+	require.Equal(t, []*domain.StockAlert{alert}, n.Alerts())
+}
+
+func TestWebhookNotifierBackorderFulfilled(t *testing.T) {
+	backorder := &domain.Backorder{
+		ID:        uuid.New(),
+		OrderID:   uuid.New(),
+		Warehouse: &domain.Warehouse{ID: uuid.New()},
+		Product:   &domain.Product{ID: uuid.New()},
+		Count:     4,
+		Allocated: 4,
+	}
+	alert := backorder.FulfilledAlert(domain.MovementReceipt)
+
+	var got stockAlertPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	err := NewWebhookNotifier(srv.URL, time.Second).Notify(context.Background(), alert)
+	require.NoError(t, err)
+	require.Equal(t, "backorder_fulfilled", got.Kind)
+	require.Equal(t, backorder.ID.String(), got.BackorderID)
+	require.Equal(t, backorder.OrderID.String(), got.OrderID)
+	require.Equal(t, 4, got.BackorderCount)
+	require.Equal(t, string(domain.MovementReceipt), got.Reason)
+}
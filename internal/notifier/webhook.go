@@ -0,0 +1,62 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+)
+
+// WebhookNotifier отправляет оповещения об остатках POST-запросом в формате JSON.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier создает новый экземпляр WebhookNotifier.
+//
+// timeout ограничивает время отправки одного оповещения.
+func NewWebhookNotifier(url string, timeout time.Duration) *WebhookNotifier {
+	return &WebhookNotifier{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// Notify отправляет оповещение на адрес вебхука.
+//
+// Если получатель ответил статусом не из диапазона 2xx, то возвращает ошибку.
+func (n *WebhookNotifier) Notify(ctx context.Context, alert *domain.StockAlert) error {
+	body, err := json.Marshal(newStockAlertPayload(alert))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if requestID := middleware.GetRequestID(ctx); requestID != "" {
+		req.Header.Set("x-request-id", requestID)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// AnalyticsOutboxRepository - интерфейс для переноса событий продаж из outbox в аналитику.
+type AnalyticsOutboxRepository interface {
+	DispatchAnalyticsEvents(ctx context.Context, batchSize int, retryDelay time.Duration) (int, error)
+}
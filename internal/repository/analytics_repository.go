@@ -9,7 +9,9 @@ import (
 
 // AnalyticsRepository - интерфейс для работы с аналитикой продуктов.
 type AnalyticsRepository interface {
-	AddProductSell([]*domain.Inventory) error
-	GetWarehouseAnalytics(context.Context, string) ([]*domain.Analytics, error)
-	GetTopWarehouses(context.Context, int) ([]*dto.WarehouseAnalyticsAtListResponse, error)
+	GetWarehouseAnalytics(context.Context, string, *dto.AnalyticsPeriod) ([]*domain.Analytics, error)
+	GetTopWarehouses(context.Context, int, *dto.AnalyticsPeriod) ([]*dto.WarehouseAnalyticsAtListResponse, error)
+	GetSalesTimeSeries(context.Context, *dto.SalesTimeSeriesFilter) ([]*domain.SalesPoint, error)
+	GetTopProducts(context.Context, *dto.TopProductsFilter) ([]*domain.SalesTotal, error)
+	GetProductAnalytics(context.Context, string, *dto.AnalyticsPeriod) (*domain.Product, []*domain.SalesTotal, error)
 }
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+)
+
+// BackorderRepository - интерфейс для работы с предзаказами товаров.
+type BackorderRepository interface {
+	SetBackorderLimit(context.Context, *domain.Inventory) error
+	GetBackorders(context.Context, string, *dto.Pagination) ([]*domain.Backorder, error)
+}
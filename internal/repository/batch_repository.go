@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+)
+
+// BatchRepository - интерфейс для работы с партиями товаров.
+type BatchRepository interface {
+	GetBatches(context.Context, *dto.BatchFilter) ([]*domain.Batch, error)
+	GetExpiringBatches(context.Context, string, time.Time, *dto.Pagination) ([]*domain.Batch, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+)
+
+// DiscountRepository - интерфейс для работы с правилами скидок.
+type DiscountRepository interface {
+	CreateDiscountRule(context.Context, *domain.DiscountRule) error
+	GetDiscountRule(context.Context, string) (*domain.DiscountRule, error)
+	GetDiscountRules(context.Context, *dto.DiscountRuleFilter) ([]*domain.DiscountRule, error)
+	UpdateDiscountRule(context.Context, *domain.DiscountRule) error
+	DeleteDiscountRule(context.Context, string) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// FulfillmentRepository - интерфейс для сборки заказа с нескольких складов.
+type FulfillmentRepository interface {
+	PricingRuleRepository
+
+	PlanFulfillment(context.Context, *domain.Fulfillment) error
+	Fulfill(context.Context, *domain.Fulfillment) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// IdempotencyRepository - интерфейс для хранения ответов на запросы с ключом идемпотентности.
+type IdempotencyRepository interface {
+	LockIdempotencyKey(ctx context.Context, key, requestHash string, lockTTL time.Duration) (*domain.IdempotencyRecord, bool, error)
+	SaveIdempotencyResponse(ctx context.Context, key string, status int, body []byte) error
+	DeleteIdempotencyKey(ctx context.Context, key string) error
+	PurgeIdempotencyKeys(ctx context.Context, keyTTL time.Duration) (int, error)
+}
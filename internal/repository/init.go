@@ -21,8 +21,28 @@ type Repository interface {
 	WarehouseRepository
 	ProductRepository
 	InventoryRepository
+	DiscountRepository
+	PromoCodeRepository
+	PricingRuleRepository
+	StockMovementRepository
+	PriceHistoryRepository
+	TransferRepository
+	BatchRepository
+	SerialRepository
+	StocktakeRepository
+	SupplierRepository
+	PurchaseOrderRepository
+	ValuationRepository
+	ReservationRepository
+	OrderRepository
+	FulfillmentRepository
+	BackorderRepository
 
 	AnalyticsRepository
+	AnalyticsOutboxRepository
+	StockAlertOutboxRepository
+
+	IdempotencyRepository
 }
 
 // CloserRepository - интерфейс для репозиториев, которые нужно закрывать.
@@ -31,11 +51,11 @@ type CloserRepository interface {
 }
 
 // MustInitRepository инициализирует репозитории приложения.
-func MustInitRepository(ctx context.Context, dbCfg config.DBConfig) Repository {
+func MustInitRepository(ctx context.Context, dbCfg config.DBConfig, valuationCfg config.ValuationConfig) Repository {
 	const op = "repository.NewRepository"
 	log := logger.GetLogger().With(zap.String("op", op))
 
-	repo, err := postgresql.NewPostgres(ctx, dbCfg)
+	repo, err := postgresql.NewPostgres(ctx, dbCfg, valuationCfg)
 	if err != nil {
 		log.Error("error while creating postgres repo", zap.String("err", err.Error()))
 		os.Exit(1)
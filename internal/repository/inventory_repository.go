@@ -9,11 +9,19 @@ import (
 
 // InventoryRepository - интерфейс для работы с инвентарем продуктов.
 type InventoryRepository interface {
+	ReservationRepository
+	PromoCodeRepository
+	PricingRuleRepository
+
 	CreateInventory(context.Context, *domain.Inventory) error
 	ChangeProductCount(context.Context, *domain.Inventory) error
+	SetStockThresholds(context.Context, *domain.Inventory) error
+	GetLowStockProducts(context.Context, *dto.Pagination, string) ([]*domain.Inventory, error)
+	ChangeProductPrice(context.Context, *domain.PriceChange, *int) error
+	ReceiveBatch(context.Context, *domain.Batch) error
 	AddDiscountToProducts(context.Context, []*domain.Inventory) error
 	GetProductFromWarehouse(context.Context, *domain.Inventory) error
 	GetPriceAndDiscount(context.Context, []*domain.Inventory) error
-	GetProductsAtWarehouse(context.Context, *dto.Pagination, string) ([]*domain.Inventory, error)
-	BuyProducts(context.Context, []*domain.Inventory) error
+	GetProductsAtWarehouse(context.Context, string, *domain.StockListing) (*domain.StockPage, error)
+	BuyProducts(context.Context, *domain.Cart) error
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+)
+
+// OrderRepository - интерфейс для работы с заказами.
+type OrderRepository interface {
+	GetOrder(context.Context, string) (*domain.Order, error)
+	GetOrders(context.Context, *dto.OrderFilter) ([]*domain.Order, error)
+	UpdateOrderStatus(context.Context, *domain.Order) error
+	CancelOrder(context.Context, *domain.OrderReturn) error
+	ReturnOrderProducts(context.Context, *domain.OrderReturn) error
+}
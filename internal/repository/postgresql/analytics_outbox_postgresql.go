@@ -0,0 +1,217 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// analyticsEventType - тип события в outbox аналитики.
+type analyticsEventType string
+
+const (
+	analyticsEventSale   analyticsEventType = "sale"   // продажа товаров.
+	analyticsEventReturn analyticsEventType = "return" // возврат товаров, записывается с отрицательным количеством.
+)
+
+// analyticsOutboxItem - строка аналитики, сохраненная в событии outbox.
+//
+// ProductPrice - цена продажи единицы товара. Скидка ProductSale применяется к ней
+// при переносе в аналитику; новые события записываются с уже примененными скидками.
+// ProductRevenue - выручка всей строки со всеми скидками. В событиях до ее учета равна nil,
+// и выручка считается по цене единицы товара.
+// ProductCost - себестоимость всего товара строки, в событиях до учета себестоимости равна нулю.
+type analyticsOutboxItem struct {
+	WarehouseID    uuid.UUID     `json:"warehouse_id"`
+	ProductID      uuid.UUID     `json:"product_id"`
+	ProductCount   int           `json:"product_count"`
+	ProductPrice   domain.Money  `json:"product_price"`
+	ProductSale    int           `json:"product_sale"`
+	ProductRevenue *domain.Money `json:"product_revenue,omitempty"`
+	ProductCost    domain.Money  `json:"product_cost"`
+}
+
+// analyticsOutboxEvent - событие outbox, ожидающее переноса в аналитику.
+type analyticsOutboxEvent struct {
+	ID        uuid.UUID
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// addAnalyticsEvent записывает событие для аналитики в outbox в рамках транзакции tx.
+//
+// Событие переносится в аналитику диспетчером, поэтому продажа не теряется,
+// даже если запись в аналитику не удалась или приложение было остановлено.
+// Выручкой строки аналитики становится inv.Revenue.
+func addAnalyticsEvent(ctx context.Context, tx pgx.Tx, eventType analyticsEventType, invs []*domain.Inventory) error {
+	items := make([]*analyticsOutboxItem, 0, len(invs))
+	for _, inv := range invs {
+		revenue := inv.Revenue
+		items = append(items, &analyticsOutboxItem{
+			WarehouseID:    inv.Warehouse.ID,
+			ProductID:      inv.Product.ID,
+			ProductCount:   inv.ProductCount,
+			ProductPrice:   inv.PriceWithDiscount(),
+			ProductRevenue: &revenue,
+			ProductCost:    inv.Cost,
+		})
+	}
+
+	payload, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO analytics_outbox(event_type, event_payload) VALUES ($1, $2)`, eventType, payload)
+
+	return err
+}
+
+// DispatchAnalyticsEvents переносит до batchSize событий из outbox в аналитику.
+//
+// События блокируются через SKIP LOCKED, поэтому несколько диспетчеров не обработают
+// одно событие дважды. Если событие перенести не удалось, то оно откладывается
+// на retryDelay, умноженное на число попыток.
+//
+// Возвращает количество перенесенных событий.
+func (db *Postgres) DispatchAnalyticsEvents(ctx context.Context, batchSize int, retryDelay time.Duration) (int, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.DispatchAnalyticsEvents"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	events, err := getAnalyticsEvents(ctx, tx, batchSize)
+	if err != nil {
+		log.Error("error while getting analytics events", zap.Error(err))
+		return 0, err
+	}
+
+	stmt := `
+	UPDATE analytics_outbox
+	SET attempts = attempts + 1,
+		last_error = $2,
+		available_at = now() + make_interval(secs => $3::float8 * (attempts + 1))
+	WHERE event_id = $1
+	`
+
+	dispatched := 0
+	for _, event := range events {
+		err = dispatchAnalyticsEvent(ctx, tx, event)
+		if err != nil {
+			log.Warn("error while dispatching analytics event", zap.String("event-id", event.ID.String()), zap.Error(err))
+
+			_, err = tx.Exec(ctx, stmt, event.ID, err.Error(), retryDelay.Seconds())
+			if err != nil {
+				log.Error("error while postponing analytics event", zap.Error(err))
+				return 0, err
+			}
+			continue
+		}
+
+		dispatched++
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return 0, err
+	}
+
+	return dispatched, nil
+}
+
+// getAnalyticsEvents получает и блокирует события, готовые к переносу в аналитику.
+func getAnalyticsEvents(ctx context.Context, tx pgx.Tx, limit int) ([]*analyticsOutboxEvent, error) {
+	stmt := `
+	SELECT event_id, event_payload, created_at
+	FROM analytics_outbox
+	WHERE available_at <= now()
+	ORDER BY created_at
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := tx.Query(ctx, stmt, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*analyticsOutboxEvent
+	for rows.Next() {
+		event := &analyticsOutboxEvent{}
+
+		err = rows.Scan(&event.ID, &event.Payload, &event.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// dispatchAnalyticsEvent переносит событие в аналитику и удаляет его из outbox.
+// Временем продажи считается время записи события, а не время переноса.
+//
+// Перенос выполняется во вложенной транзакции, чтобы ошибка одного события
+// не отменяла перенос остальных.
+func dispatchAnalyticsEvent(ctx context.Context, tx pgx.Tx, event *analyticsOutboxEvent) error {
+	var items []*analyticsOutboxItem
+	err := json.Unmarshal(event.Payload, &items)
+	if err != nil {
+		return err
+	}
+
+	invs := make([]*domain.Inventory, 0, len(items))
+	for _, item := range items {
+		inv := &domain.Inventory{
+			Warehouse:    &domain.Warehouse{ID: item.WarehouseID},
+			Product:      &domain.Product{ID: item.ProductID},
+			ProductCount: item.ProductCount,
+			ProductPrice: item.ProductPrice,
+			ProductSale:  item.ProductSale,
+			Cost:         item.ProductCost,
+		}
+
+		inv.Revenue = inv.PriceWithDiscount().Mul(inv.ProductCount)
+		if item.ProductRevenue != nil {
+			inv.Revenue = *item.ProductRevenue
+		}
+
+		invs = append(invs, inv)
+	}
+
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer savepoint.Rollback(ctx)
+
+	if len(invs) != 0 {
+		err = addProductSell(ctx, savepoint, invs, event.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = savepoint.Exec(ctx, `DELETE FROM analytics_outbox WHERE event_id = $1`, event.ID)
+	if err != nil {
+		return err
+	}
+
+	return savepoint.Commit(ctx)
+}
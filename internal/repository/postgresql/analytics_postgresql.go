@@ -2,30 +2,31 @@ package postgresql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
 	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 )
 
-// AddProductSell добавляет информацию о продаже продуктов в аналитику.
-func (db *Postgres) AddProductSell(invs []*domain.Inventory) error {
-	log := logger.GetLogger().With(
-		zap.String("op", "repository.Postgres.AddProductSell"),
-	)
+// addProductSell добавляет информацию о продаже продуктов в аналитику.
+// soldAt - момент продажи, по которому строится аналитика за период.
+func addProductSell(ctx context.Context, q querier, invs []*domain.Inventory, soldAt time.Time) error {
+	stmt, values := getAddProductSellStatement(invs, soldAt)
 
-	stmt, values := getAddProductSellStatement(invs)
-
-	tag, err := db.pool.Exec(context.Background(), stmt, values...)
+	tag, err := q.Exec(ctx, stmt, values...)
 	if err != nil {
-		log.Error("executing statement", zap.Error(err), zap.String("stmt", stmt))
 		return err
 	}
+
 	if int(tag.RowsAffected()) != len(invs) {
-		log.Error("not all product was added to analytics", zap.Int("want", len(invs)), zap.Int64("actual", tag.RowsAffected()))
+		return fmt.Errorf("not all products were added to analytics: want %d, actual %d", len(invs), tag.RowsAffected())
 	}
 
 	return nil
@@ -33,25 +34,22 @@ func (db *Postgres) AddProductSell(invs []*domain.Inventory) error {
 
 // getAddProductSellStatement формирует SQL-запрос для добавления информации
 // о продаже продуктов в аналитику.
-func getAddProductSellStatement(invs []*domain.Inventory) (string, []any) {
+func getAddProductSellStatement(invs []*domain.Inventory, soldAt time.Time) (string, []any) {
 	var (
 		cursor = 1
 		rows   []string
 		values []any
 	)
 
-	query := `INSERT INTO analytics(warehouse_id, product_id, product_count, product_price) VALUES `
+	query := `INSERT INTO analytics(warehouse_id, product_id, product_count, product_price, total_price, total_cost, sold_at) VALUES `
 
 	for _, inv := range invs {
-		price := inv.ProductPrice
-		if inv.ProductSale != 0 {
-			price = price - (price * float64(inv.ProductSale) / 100)
-		}
-		row := fmt.Sprintf("($%d, $%d, $%d, $%d)", cursor, cursor+1, cursor+2, cursor+3)
+		price := inv.PriceWithDiscount()
+		row := fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)", cursor, cursor+1, cursor+2, cursor+3, cursor+4, cursor+5, cursor+6)
 		rows = append(rows, row)
-		values = append(values, inv.Warehouse.ID.String(), inv.Product.ID.String(), inv.ProductCount, price)
+		values = append(values, inv.Warehouse.ID.String(), inv.Product.ID.String(), inv.ProductCount, price, inv.Revenue, inv.Cost, soldAt)
 
-		cursor += 4
+		cursor += 7
 	}
 
 	stmt := query + strings.Join(rows, ", ")
@@ -59,21 +57,24 @@ func getAddProductSellStatement(invs []*domain.Inventory) (string, []any) {
 	return stmt, values
 }
 
-func (db *Postgres) GetWarehouseAnalytics(ctx context.Context, warehouseID string) ([]*domain.Analytics, error) {
+// GetWarehouseAnalytics возвращает продажи продуктов на складе за период вместе с их себестоимостью.
+func (db *Postgres) GetWarehouseAnalytics(ctx context.Context, warehouseID string, period *dto.AnalyticsPeriod) ([]*domain.Analytics, error) {
 	log := logger.GetLogger().With(
 		zap.String("op", "repository.Postgres.GetWarehouseAnalytics"),
 	)
 	var res []*domain.Analytics
 
-	stmt := `
-	SELECT inv.warehouse_id, p.product_id, p.product_name, a.product_count, a.product_price
+	conditions, args := periodConditions(period, "a.sold_at", []string{"warehouse_id = $1"}, []any{warehouseID})
+
+	stmt := fmt.Sprintf(`
+	SELECT inv.warehouse_id, p.product_id, p.product_name, a.product_count, a.product_price, a.total_price, a.total_cost
 	FROM inventory inv
 	JOIN product p USING (product_id)
 	JOIN analytics a USING (warehouse_id, product_id)
-	WHERE warehouse_id = $1
-	`
+	WHERE %s
+	`, strings.Join(conditions, " AND "))
 
-	rows, err := db.pool.Query(ctx, stmt, warehouseID)
+	rows, err := db.pool.Query(ctx, stmt, args...)
 	if err != nil {
 		log.Error("error while getting analytics rows", zap.Error(err))
 		return nil, err
@@ -86,7 +87,7 @@ func (db *Postgres) GetWarehouseAnalytics(ctx context.Context, warehouseID strin
 			Warehouse: &domain.Warehouse{},
 		}
 
-		err = rows.Scan(&anal.Warehouse.ID, &anal.Product.ID, &anal.Product.Name, &anal.ProductCount, &anal.ProductPrice)
+		err = rows.Scan(&anal.Warehouse.ID, &anal.Product.ID, &anal.Product.Name, &anal.ProductCount, &anal.ProductPrice, &anal.Revenue, &anal.Cost)
 		if err != nil {
 			log.Error("error while scanning row", zap.Error(err))
 			continue
@@ -104,25 +105,29 @@ func (db *Postgres) GetWarehouseAnalytics(ctx context.Context, warehouseID strin
 	return res, nil
 }
 
-// GetTopWarehouses возвращает топ limit складов по сумме продаж продуктов.
-func (db *Postgres) GetTopWarehouses(ctx context.Context, limit int) ([]*dto.WarehouseAnalyticsAtListResponse, error) {
+// GetTopWarehouses возвращает топ limit складов по сумме продаж продуктов за период.
+// Возвраты уменьшают сумму продаж, так как записываются с отрицательным количеством.
+func (db *Postgres) GetTopWarehouses(ctx context.Context, limit int, period *dto.AnalyticsPeriod) ([]*dto.WarehouseAnalyticsAtListResponse, error) {
 	log := logger.GetLogger().With(
 		zap.String("op", "repository.Postgres.GetTopWarehouses"),
 	)
 
-	stmt := `
+	// условия периода задаются при соединении, чтобы склады без продаж оставались в списке.
+	conditions, args := periodConditions(period, "a.sold_at", []string{"a.warehouse_id = w.warehouse_id"}, []any{limit})
+
+	stmt := fmt.Sprintf(`
 	SELECT
 	w.warehouse_id,
 	w.warehouse_address,
-	COALESCE(SUM(a.product_price), 0) AS warehouse_total_sum
+	COALESCE(SUM(a.total_price), 0) AS warehouse_total_sum
 	FROM warehouse w
-	LEFT JOIN analytics a USING (warehouse_id)
-	GROUP BY warehouse_id
+	LEFT JOIN analytics a ON %s
+	GROUP BY w.warehouse_id
 	ORDER BY warehouse_total_sum DESC
 	LIMIT $1
-	`
+	`, strings.Join(conditions, " AND "))
 
-	rows, err := db.pool.Query(ctx, stmt, limit)
+	rows, err := db.pool.Query(ctx, stmt, args...)
 	if err != nil {
 		log.Error("error while getting top warehouses", zap.Error(err))
 		return nil, err
@@ -156,3 +161,228 @@ func (db *Postgres) GetTopWarehouses(ctx context.Context, limit int) ([]*dto.War
 	}
 	return res, nil
 }
+
+// periodConditions добавляет к условиям запроса ограничения периода по колонке column.
+func periodConditions(period *dto.AnalyticsPeriod, column string, conditions []string, args []any) ([]string, []any) {
+	if period == nil {
+		return conditions, args
+	}
+
+	if period.From != nil {
+		args = append(args, *period.From)
+		conditions = append(conditions, fmt.Sprintf("%s >= $%d", column, len(args)))
+	}
+
+	if period.To != nil {
+		args = append(args, *period.To)
+		conditions = append(conditions, fmt.Sprintf("%s < $%d", column, len(args)))
+	}
+
+	return conditions, args
+}
+
+// GetSalesTimeSeries возвращает выручку и количество проданных единиц по периодам.
+//
+// Периоды без продаж в результат не попадают.
+func (db *Postgres) GetSalesTimeSeries(ctx context.Context, filter *dto.SalesTimeSeriesFilter) ([]*domain.SalesPoint, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetSalesTimeSeries"),
+	)
+
+	var (
+		conditions = []string{"TRUE"}
+		args       = []any{filter.Bucket}
+	)
+
+	if filter.WarehouseID != "" {
+		args = append(args, filter.WarehouseID)
+		conditions = append(conditions, fmt.Sprintf("warehouse_id = $%d", len(args)))
+	}
+
+	if filter.ProductID != "" {
+		args = append(args, filter.ProductID)
+		conditions = append(conditions, fmt.Sprintf("product_id = $%d", len(args)))
+	}
+
+	conditions, args = periodConditions(&filter.AnalyticsPeriod, "sold_at", conditions, args)
+
+	stmt := fmt.Sprintf(`
+	SELECT
+	date_trunc($1, sold_at AT TIME ZONE 'UTC') AS period_start,
+	SUM(product_count) AS units,
+	SUM(total_price) AS revenue
+	FROM analytics
+	WHERE %s
+	GROUP BY period_start
+	ORDER BY period_start
+	`, strings.Join(conditions, " AND "))
+
+	rows, err := db.pool.Query(ctx, stmt, args...)
+	if err != nil {
+		log.Error("error while getting sales time series", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	points := make([]*domain.SalesPoint, 0)
+	for rows.Next() {
+		point := &domain.SalesPoint{}
+
+		err = rows.Scan(&point.PeriodStart, &point.ProductCount, &point.Revenue)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			continue
+		}
+
+		points = append(points, point)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+
+	return points, nil
+}
+
+// topProductsOrder сопоставляет поле сортировки самых продаваемых продуктов с выражением SQL.
+var topProductsOrder = map[string]string{
+	"revenue": "revenue",
+	"units":   "units",
+}
+
+// GetTopProducts возвращает самые продаваемые продукты по выручке или количеству проданных единиц.
+//
+// Если указан склад, то учитываются только продажи на нем.
+func (db *Postgres) GetTopProducts(ctx context.Context, filter *dto.TopProductsFilter) ([]*domain.SalesTotal, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetTopProducts"),
+	)
+
+	var (
+		conditions = []string{"TRUE"}
+		args       = []any{filter.Limit}
+	)
+
+	if filter.WarehouseID != "" {
+		args = append(args, filter.WarehouseID)
+		conditions = append(conditions, fmt.Sprintf("a.warehouse_id = $%d", len(args)))
+	}
+
+	conditions, args = periodConditions(&filter.AnalyticsPeriod, "a.sold_at", conditions, args)
+
+	orderBy, ok := topProductsOrder[filter.OrderBy]
+	if !ok {
+		orderBy = topProductsOrder["revenue"]
+	}
+
+	stmt := fmt.Sprintf(`
+	SELECT
+	p.product_id,
+	p.product_name,
+	SUM(a.product_count) AS units,
+	SUM(a.total_price) AS revenue
+	FROM analytics a
+	JOIN product p USING (product_id)
+	WHERE %s
+	GROUP BY p.product_id, p.product_name
+	ORDER BY %s DESC, p.product_id
+	LIMIT $1
+	`, strings.Join(conditions, " AND "), orderBy)
+
+	rows, err := db.pool.Query(ctx, stmt, args...)
+	if err != nil {
+		log.Error("error while getting top products", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*domain.SalesTotal, 0)
+	for rows.Next() {
+		total := &domain.SalesTotal{
+			Product: &domain.Product{},
+		}
+
+		err = rows.Scan(&total.Product.ID, &total.Product.Name, &total.ProductCount, &total.Revenue)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			continue
+		}
+
+		res = append(res, total)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+
+	return res, nil
+}
+
+// GetProductAnalytics возвращает продукт и его продажи на каждом складе за период.
+//
+// Склады, на которых продукт не продавался, в результат не попадают.
+//
+// Если продукт не найден, то возвращает ErrProductNotFound.
+func (db *Postgres) GetProductAnalytics(ctx context.Context, productID string, period *dto.AnalyticsPeriod) (*domain.Product, []*domain.SalesTotal, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetProductAnalytics"),
+	)
+
+	product := &domain.Product{}
+	err := db.pool.QueryRow(ctx, `SELECT product_id, product_name FROM product WHERE product_id = $1`, productID).
+		Scan(&product.ID, &product.Name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, custErr.ErrProductNotFound
+		}
+		log.Error("error while getting product", zap.Error(err))
+		return nil, nil, err
+	}
+
+	conditions, args := periodConditions(period, "a.sold_at", []string{"a.product_id = $1"}, []any{productID})
+
+	stmt := fmt.Sprintf(`
+	SELECT
+	w.warehouse_id,
+	w.warehouse_address,
+	SUM(a.product_count) AS units,
+	SUM(a.total_price) AS revenue
+	FROM analytics a
+	JOIN warehouse w USING (warehouse_id)
+	WHERE %s
+	GROUP BY w.warehouse_id, w.warehouse_address
+	ORDER BY revenue DESC, w.warehouse_id
+	`, strings.Join(conditions, " AND "))
+
+	rows, err := db.pool.Query(ctx, stmt, args...)
+	if err != nil {
+		log.Error("error while getting product analytics", zap.Error(err))
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*domain.SalesTotal, 0)
+	for rows.Next() {
+		total := &domain.SalesTotal{
+			Warehouse: &domain.Warehouse{},
+			Product:   product,
+		}
+
+		err = rows.Scan(&total.Warehouse.ID, &total.Warehouse.Address, &total.ProductCount, &total.Revenue)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			continue
+		}
+
+		res = append(res, total)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, nil, rows.Err()
+	}
+
+	return product, res, nil
+}
@@ -2,6 +2,7 @@ package postgresql
 
 import (
 	"testing"
+	"time"
 
 	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
 	"github.com/google/uuid"
@@ -14,29 +15,40 @@ func TestGetAddProductSellStatement(t *testing.T) {
 			Warehouse:    &domain.Warehouse{ID: uuid.New()},
 			Product:      &domain.Product{ID: uuid.New()},
 			ProductCount: 10,
-			ProductPrice: 100.0,
+			ProductPrice: 10000, // 100.00
 			ProductSale:  15,
+			Revenue:      80000, // 800.00
+			Cost:         60000, // 600.00
 		},
 		{
 			Warehouse:    &domain.Warehouse{ID: uuid.New()},
 			Product:      &domain.Product{ID: uuid.New()},
 			ProductCount: 5,
-			ProductPrice: 200.0,
+			ProductPrice: 20000, // 200.00
 			ProductSale:  0,
+			Revenue:      100000, // 1000.00
 		},
 	}
 
-	stmt, values := getAddProductSellStatement(invs)
-	expectedStmt := `INSERT INTO analytics(warehouse_id, product_id, product_count, product_price) VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)`
+	soldAt := time.Date(2025, time.July, 1, 12, 0, 0, 0, time.UTC)
+
+	stmt, values := getAddProductSellStatement(invs, soldAt)
+	expectedStmt := `INSERT INTO analytics(warehouse_id, product_id, product_count, product_price, total_price, total_cost, sold_at) VALUES ($1, $2, $3, $4, $5, $6, $7), ($8, $9, $10, $11, $12, $13, $14)`
 	expectedValues := []any{
 		invs[0].Warehouse.ID.String(),
 		invs[0].Product.ID.String(),
 		invs[0].ProductCount,
-		invs[0].ProductPrice - (invs[0].ProductPrice * float64(invs[0].ProductSale) / 100),
+		domain.Money(8500),
+		invs[0].Revenue,
+		invs[0].Cost,
+		soldAt,
 		invs[1].Warehouse.ID.String(),
 		invs[1].Product.ID.String(),
 		invs[1].ProductCount,
 		invs[1].ProductPrice,
+		invs[1].Revenue,
+		domain.Money(0),
+		soldAt,
 	}
 
 	require.Equal(t, expectedStmt, stmt)
@@ -0,0 +1,342 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// backorderColumns - столбцы предзаказа в порядке сканирования scanBackorder.
+const backorderColumns = `b.backorder_id, b.order_id, b.warehouse_id, b.product_id, b.backorder_count,
+	b.allocated_count, b.backorder_status, b.created_at, b.fulfilled_at`
+
+// SetBackorderLimit задает, сколько товара можно продать сверх остатка склада.
+// Лимит 0 запрещает новые предзаказы, уже созданные предзаказы остаются открытыми.
+//
+// Если запись не найдена, то возвращает ErrInventoryNotFound.
+//
+// Если товар серийный и лимит больше нуля, то возвращает ErrBackorderSerialized.
+func (db *Postgres) SetBackorderLimit(ctx context.Context, inventory *domain.Inventory) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.SetBackorderLimit"),
+	)
+
+	stmt := `
+	SELECT p.product_serialized
+	FROM inventory inv
+	JOIN product p USING (product_id)
+	WHERE inv.warehouse_id = $1 AND inv.product_id = $2
+	`
+
+	var serialized bool
+	err := db.pool.QueryRow(ctx, stmt, inventory.Warehouse.ID, inventory.Product.ID).Scan(&serialized)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return custErr.ErrInventoryNotFound
+		}
+		log.Error("error while getting product", zap.Error(err))
+		return err
+	}
+
+	if serialized && inventory.BackorderLimit > 0 {
+		return custErr.ErrBackorderSerialized
+	}
+
+	stmt = `UPDATE inventory SET backorder_limit = $1 WHERE warehouse_id = $2 AND product_id = $3`
+
+	_, err = db.pool.Exec(ctx, stmt, inventory.BackorderLimit, inventory.Warehouse.ID, inventory.Product.ID)
+	if err != nil {
+		log.Error("error while setting backorder limit", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetBackorders получает открытые предзаказы склада в порядке очереди на обеспечение.
+//
+// Возвращает пустой список, если предзаказов нет.
+func (db *Postgres) GetBackorders(ctx context.Context, warehouseID string, params *dto.Pagination) ([]*domain.Backorder, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetBackorders"),
+	)
+
+	stmt := fmt.Sprintf(`
+	SELECT %s, p.product_name
+	FROM backorder b
+	JOIN product p USING (product_id)
+	WHERE b.warehouse_id = $1 AND b.backorder_status = $2
+	ORDER BY b.created_at, b.backorder_id
+	OFFSET $3
+	LIMIT $4
+	`, backorderColumns)
+
+	rows, err := db.pool.Query(ctx, stmt, warehouseID, domain.BackorderOpen, params.Offset, params.Limit)
+	if err != nil {
+		log.Error("error while executing statement", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	backorders := make([]*domain.Backorder, 0)
+	for rows.Next() {
+		b, err := scanBackorder(rows, true)
+		if err != nil {
+			log.Error("error while scanning backorder", zap.Error(err))
+			return nil, err
+		}
+		backorders = append(backorders, b)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+
+	return backorders, nil
+}
+
+// scanBackorder читает предзаказ из строки со столбцами backorderColumns.
+// Если withName установлен, то после столбцов предзаказа читается название продукта.
+func scanBackorder(row pgx.Row, withName bool) (*domain.Backorder, error) {
+	b := &domain.Backorder{
+		Warehouse: &domain.Warehouse{},
+		Product:   &domain.Product{},
+	}
+
+	dest := []any{&b.ID, &b.OrderID, &b.Warehouse.ID, &b.Product.ID, &b.Count,
+		&b.Allocated, &b.Status, &b.CreatedAt, &b.FulfilledAt}
+	if withName {
+		dest = append(dest, &b.Product.Name)
+	}
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// getOpenBackorderCounts возвращает количество товара в открытых предзаказах склада.
+func getOpenBackorderCounts(ctx context.Context, q querier, warehouseID string, products []string) (map[string]int, error) {
+	stmt := `
+	SELECT product_id, SUM(backorder_count - allocated_count)
+	FROM backorder
+	WHERE warehouse_id = $1 AND product_id = ANY($2) AND backorder_status = $3
+	GROUP BY product_id
+	`
+
+	rows, err := q.Query(ctx, stmt, warehouseID, products, domain.BackorderOpen)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var (
+			productID string
+			count     int
+		)
+
+		err = rows.Scan(&productID, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		counts[productID] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// insertBackorders записывает предзаказы на товар корзины, проданный сверх остатка.
+func insertBackorders(ctx context.Context, tx pgx.Tx, cart *domain.Cart) error {
+	var (
+		cursor = 3
+		rows   []string
+		values = []any{cart.OrderID, cart.Warehouse.ID}
+	)
+
+	for _, inv := range cart.Items {
+		if inv.Backordered == 0 {
+			continue
+		}
+
+		rows = append(rows, fmt.Sprintf("($1, $2, $%d, $%d)", cursor, cursor+1))
+		values = append(values, inv.Product.ID, inv.Backordered)
+		cursor += 2
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	_, err := tx.Exec(ctx, `INSERT INTO backorder(order_id, warehouse_id, product_id, backorder_count) VALUES `+strings.Join(rows, ", "), values...)
+
+	return err
+}
+
+// allocateBackorders обеспечивает открытые предзаказы товара inv свободным остатком склада
+// после поступления товара по причине reason.
+//
+// Предзаказы обеспечиваются в порядке создания. Обеспеченный товар списывается со склада
+// как продажа, его себестоимость добавляется к строке заказа и записывается в outbox
+// аналитики событием продажи без количества и выручки.
+//
+// Возвращает оповещения о полностью обеспеченных предзаказах и об остатках,
+// опустившихся до минимального количества.
+func allocateBackorders(ctx context.Context, tx pgx.Tx, method domain.ValuationMethod, inv *domain.Inventory, reason domain.MovementReason) ([]*domain.StockAlert, error) {
+	warehouseID, productID := inv.Warehouse.ID.String(), inv.Product.ID.String()
+
+	backorders, err := getOpenBackorders(ctx, tx, warehouseID, productID)
+	if err != nil || len(backorders) == 0 {
+		return nil, err
+	}
+
+	available, err := getAvailableCounts(ctx, tx, warehouseID, []string{productID})
+	if err != nil {
+		return nil, err
+	}
+
+	allocated := domain.AllocateBackorders(backorders, available[productID], time.Now())
+
+	var (
+		alerts    []*domain.StockAlert
+		movements []*domain.StockMovement
+		costs     []*domain.Inventory
+	)
+
+	stmt := `
+	UPDATE backorder
+	SET allocated_count = $1, backorder_status = $2, fulfilled_at = $3
+	WHERE backorder_id = $4
+	`
+
+	for _, b := range backorders {
+		count := allocated[b]
+		if count == 0 {
+			continue
+		}
+		b.Warehouse, b.Product = inv.Warehouse, inv.Product
+
+		item := &domain.Inventory{
+			Product:      inv.Product,
+			Warehouse:    inv.Warehouse,
+			ProductCount: count,
+		}
+
+		levelAlerts, err := updateProductCount(ctx, tx, method, []*domain.Inventory{item}, domain.MovementSale)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, levelAlerts...)
+		movements = append(movements, newStockMovement(ctx, item, -count, domain.MovementSale))
+		costs = append(costs, &domain.Inventory{Product: inv.Product, Warehouse: inv.Warehouse, Cost: item.Cost})
+
+		_, err = tx.Exec(ctx, stmt, b.Allocated, b.Status, b.FulfilledAt, b.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE order_line SET line_cost = line_cost + $1 WHERE order_id = $2 AND product_id = $3`,
+			item.Cost, b.OrderID, inv.Product.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if b.Status == domain.BackorderFulfilled {
+			alerts = append(alerts, b.FulfilledAlert(reason))
+		}
+	}
+
+	if len(movements) == 0 {
+		return nil, nil
+	}
+
+	err = addStockMovements(ctx, tx, movements)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addAnalyticsEvent(ctx, tx, analyticsEventSale, costs)
+	if err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+// getOpenBackorders получает открытые предзаказы товара на складе в порядке создания
+// и блокирует их до конца транзакции.
+func getOpenBackorders(ctx context.Context, tx pgx.Tx, warehouseID, productID string) ([]*domain.Backorder, error) {
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM backorder b
+	WHERE b.warehouse_id = $1 AND b.product_id = $2 AND b.backorder_status = $3
+	ORDER BY b.created_at, b.backorder_id
+	FOR UPDATE
+	`, backorderColumns)
+
+	rows, err := tx.Query(ctx, stmt, warehouseID, productID, domain.BackorderOpen)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var backorders []*domain.Backorder
+	for rows.Next() {
+		b, err := scanBackorder(rows, false)
+		if err != nil {
+			return nil, err
+		}
+		backorders = append(backorders, b)
+	}
+
+	return backorders, rows.Err()
+}
+
+// cancelOrderBackorders отменяет открытые предзаказы заказа и возвращает
+// по идентификатору продукта количество товара, которое так и не поступило покупателю.
+func cancelOrderBackorders(ctx context.Context, tx pgx.Tx, orderID uuid.UUID) (map[uuid.UUID]int, error) {
+	stmt := `
+	UPDATE backorder
+	SET backorder_status = $1
+	WHERE order_id = $2 AND backorder_status = $3
+	RETURNING product_id, backorder_count - allocated_count
+	`
+
+	rows, err := tx.Query(ctx, stmt, domain.BackorderCancelled, orderID, domain.BackorderOpen)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cancelled := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var (
+			productID uuid.UUID
+			count     int
+		)
+
+		err = rows.Scan(&productID, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		cancelled[productID] += count
+	}
+
+	return cancelled, rows.Err()
+}
@@ -0,0 +1,282 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// ReceiveBatch принимает партию товара на склад.
+//
+// Количество товара на складе увеличивается на количество в партии, поступление
+// записывается в журнал движения товаров и в слои себестоимости.
+// Поступивший товар обеспечивает открытые предзаказы товара в порядке их создания.
+// Оповещения об остатке и об обеспеченных предзаказах записываются в outbox в той же транзакции.
+// Если товар не найден на складе, то возвращает ErrInventoryNotFound.
+// Если партия с таким номером уже есть, то возвращает ErrBatchAlreadyExists.
+func (db *Postgres) ReceiveBatch(ctx context.Context, batch *domain.Batch) error {
+	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.ReceiveBatch"))
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = insertBatch(ctx, tx, batch)
+	if err != nil {
+		if !custErr.Any(err, custErr.ErrBatchAlreadyExists, custErr.ErrInventoryNotFound) {
+			log.Error("error while inserting batch", zap.Error(err))
+		}
+		return err
+	}
+
+	inv := &domain.Inventory{
+		Product:      batch.Product,
+		Warehouse:    batch.Warehouse,
+		ProductCount: batch.Quantity,
+		Serials:      batch.Serials,
+		UnitCost:     batch.UnitCost,
+	}
+
+	// используется пользовательская функция. код в миграции 000004
+	_, err = tx.Exec(ctx, `SELECT increase_product_count($1, $2, $3)`, inv.Product.ID, inv.Warehouse.ID, inv.ProductCount)
+	if err != nil {
+		log.Error("error while increasing product count", zap.Error(err))
+		return err
+	}
+
+	err = receiveSerials(ctx, tx, inv)
+	if err != nil {
+		if !isSerialError(err) {
+			log.Error("error while receiving serials", zap.Error(err))
+		}
+		return err
+	}
+
+	err = addCostLayer(ctx, tx, inv)
+	if err != nil {
+		log.Error("error while adding cost layer", zap.Error(err))
+		return err
+	}
+
+	movement := newStockMovement(ctx, inv, inv.ProductCount, domain.MovementReceipt)
+	err = addStockMovements(ctx, tx, []*domain.StockMovement{movement})
+	if err != nil {
+		log.Error("error while adding stock movement", zap.Error(err))
+		return err
+	}
+
+	alert, err := getStockAlert(ctx, tx, inv, inv.ProductCount, domain.MovementReceipt)
+	if err != nil {
+		log.Error("error while checking stock level", zap.Error(err))
+		return err
+	}
+
+	alerts, err := allocateBackorders(ctx, tx, db.valuation, inv, domain.MovementReceipt)
+	if err != nil {
+		log.Error("error while allocating backorders", zap.Error(err))
+		return err
+	}
+
+	if alert != nil {
+		alerts = append([]*domain.StockAlert{alert}, alerts...)
+	}
+
+	err = addStockAlertEvents(ctx, tx, alerts)
+	if err != nil {
+		log.Error("error while adding stock alerts", zap.Error(err))
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// insertBatch записывает партию товара в базу данных.
+//
+// Если дата поступления не указана, то используется текущее время.
+// Если товар не найден на складе, то возвращает ErrInventoryNotFound.
+// Если партия с таким номером уже есть, то возвращает ErrBatchAlreadyExists.
+func insertBatch(ctx context.Context, tx pgx.Tx, batch *domain.Batch) error {
+	stmt := `
+	INSERT INTO inventory_batch(product_id, warehouse_id, lot_number, batch_count, expires_at, received_at)
+	VALUES ($1, $2, $3, $4, $5, COALESCE($6, now()))
+	RETURNING batch_id, received_at
+	`
+
+	var receivedAt *time.Time
+	if !batch.ReceivedAt.IsZero() {
+		receivedAt = &batch.ReceivedAt
+	}
+
+	err := tx.QueryRow(ctx, stmt,
+		batch.Product.ID,
+		batch.Warehouse.ID,
+		batch.LotNumber,
+		batch.Quantity,
+		batch.ExpiresAt,
+		receivedAt,
+	).Scan(&batch.ID, &batch.ReceivedAt)
+	if err != nil {
+		pgErr := new(pgconn.PgError)
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return custErr.ErrBatchAlreadyExists
+		}
+		if isForeignKeyError(err) {
+			return custErr.ErrInventoryNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// consumeBatches списывает count единиц товара с партий в порядке истечения срока годности.
+//
+// Если в партиях меньше count единиц, то остаток списывается с товара без партии.
+func consumeBatches(ctx context.Context, q querier, warehouseID, productID string, count int) error {
+	stmt := `
+	SELECT batch_id, lot_number, batch_count, expires_at, received_at
+	FROM inventory_batch
+	WHERE warehouse_id = $1 AND product_id = $2 AND batch_count > 0
+	FOR UPDATE
+	`
+
+	rows, err := q.Query(ctx, stmt, warehouseID, productID)
+	if err != nil {
+		return err
+	}
+
+	batches := make([]*domain.Batch, 0)
+	for rows.Next() {
+		b := &domain.Batch{}
+		err = rows.Scan(&b.ID, &b.LotNumber, &b.Quantity, &b.ExpiresAt, &b.ReceivedAt)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		batches = append(batches, b)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+
+	stmt = `UPDATE inventory_batch SET batch_count = batch_count - $1 WHERE batch_id = $2`
+	for _, pick := range domain.PickFEFO(batches, count) {
+		_, err = q.Exec(ctx, stmt, pick.Count, pick.Batch.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetBatches получает партии товаров на складе, в которых остался товар.
+//
+// Партии отсортированы в порядке списания.
+func (db *Postgres) GetBatches(ctx context.Context, filter *dto.BatchFilter) ([]*domain.Batch, error) {
+	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.GetBatches"))
+
+	var (
+		conditions = []string{"warehouse_id = $1", "batch_count > 0"}
+		args       = []any{filter.WarehouseID}
+	)
+
+	if filter.ProductID != "" {
+		args = append(args, filter.ProductID)
+		conditions = append(conditions, fmt.Sprintf("product_id = $%d", len(args)))
+	}
+
+	args = append(args, filter.Pagination.Offset, filter.Pagination.Limit)
+	stmt := fmt.Sprintf(`
+	SELECT batch_id, warehouse_id, product_id, lot_number, batch_count, expires_at, received_at
+	FROM inventory_batch
+	WHERE %s
+	ORDER BY product_id, expires_at NULLS LAST, received_at
+	OFFSET $%d
+	LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args)-1, len(args))
+
+	batches, err := queryBatches(ctx, db.pool, stmt, args...)
+	if err != nil {
+		log.Error("error while getting batches", zap.Error(err))
+		return nil, err
+	}
+
+	return batches, nil
+}
+
+// GetExpiringBatches получает партии товаров на складе, срок годности которых истекает до before.
+//
+// Партии с истекшим сроком годности тоже попадают в отчет. Партии отсортированы по сроку годности.
+func (db *Postgres) GetExpiringBatches(ctx context.Context, warehouseID string, before time.Time, pagination *dto.Pagination) ([]*domain.Batch, error) {
+	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.GetExpiringBatches"))
+
+	stmt := `
+	SELECT batch_id, warehouse_id, product_id, lot_number, batch_count, expires_at, received_at
+	FROM inventory_batch
+	WHERE warehouse_id = $1 AND batch_count > 0 AND expires_at < $2
+	ORDER BY expires_at, received_at
+	OFFSET $3
+	LIMIT $4
+	`
+
+	batches, err := queryBatches(ctx, db.pool, stmt, warehouseID, before, pagination.Offset, pagination.Limit)
+	if err != nil {
+		log.Error("error while getting expiring batches", zap.Error(err))
+		return nil, err
+	}
+
+	return batches, nil
+}
+
+// queryBatches выполняет запрос stmt и сканирует партии товаров.
+func queryBatches(ctx context.Context, q querier, stmt string, args ...any) ([]*domain.Batch, error) {
+	rows, err := q.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	batches := make([]*domain.Batch, 0)
+	for rows.Next() {
+		b := &domain.Batch{
+			Warehouse: &domain.Warehouse{},
+			Product:   &domain.Product{},
+		}
+
+		err = rows.Scan(
+			&b.ID,
+			&b.Warehouse.ID,
+			&b.Product.ID,
+			&b.LotNumber,
+			&b.Quantity,
+			&b.ExpiresAt,
+			&b.ReceivedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		batches = append(batches, b)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return batches, nil
+}
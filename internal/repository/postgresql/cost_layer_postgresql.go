@@ -0,0 +1,127 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// addCostLayer записывает поступление inv.ProductCount единиц товара в слои себестоимости.
+//
+// Если себестоимость единицы inv.UnitCost неизвестна, то товар поступает по средней
+// себестоимости остатка. Если у остатка тоже нет известной себестоимости, то слой не
+// создается и товар считается товаром без себестоимости.
+func addCostLayer(ctx context.Context, tx pgx.Tx, inv *domain.Inventory) error {
+	if inv.ProductCount <= 0 {
+		return nil
+	}
+
+	unitCost := inv.UnitCost
+	if unitCost == nil {
+		layers, err := getCostLayers(ctx, tx, inv.Warehouse.ID.String(), inv.Product.ID.String())
+		if err != nil {
+			return err
+		}
+
+		if len(layers) == 0 {
+			return nil
+		}
+
+		average := domain.AverageCost(layers)
+		unitCost = &average
+	}
+
+	stmt := `
+	INSERT INTO inventory_cost_layer(product_id, warehouse_id, unit_cost, layer_count)
+	VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := tx.Exec(ctx, stmt, inv.Product.ID, inv.Warehouse.ID, *unitCost, inv.ProductCount)
+
+	return err
+}
+
+// consumeCostLayers списывает count единиц товара со слоев себестоимости методом method
+// и возвращает себестоимость списанного товара.
+//
+// before - остаток товара в продаже до списания. Товар остатка, не покрытый слоями,
+// не имеет известной себестоимости и списывается первым по нулевой цене.
+//
+// Единица товара, в цене которой при средневзвешенной оценке хранится остаток
+// от округления средней цены, записывается отдельным слоем.
+func consumeCostLayers(ctx context.Context, q querier, method domain.ValuationMethod, warehouseID, productID string, before, count int) (domain.Money, error) {
+	layers, err := getCostLayers(ctx, q, warehouseID, productID)
+	if err != nil {
+		return 0, err
+	}
+
+	_, costed := domain.LayersValue(layers)
+	counts := make(map[*domain.CostLayer]int, len(layers))
+	for _, layer := range layers {
+		counts[layer] = layer.Count
+	}
+
+	cost, layers := method.Consume(layers, before-costed, count)
+
+	stmt := `UPDATE inventory_cost_layer SET layer_count = $1, unit_cost = $2 WHERE layer_id = $3`
+	insertStmt := `
+	INSERT INTO inventory_cost_layer(product_id, warehouse_id, unit_cost, layer_count, received_at)
+	VALUES ($1, $2, $3, $4, $5)
+	`
+	for _, layer := range layers {
+		if layer.ID == uuid.Nil {
+			_, err = q.Exec(ctx, insertStmt, productID, warehouseID, layer.UnitCost, layer.Count, layer.ReceivedAt)
+			if err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if layer.Count == counts[layer] && method != domain.ValuationAverage {
+			continue
+		}
+
+		_, err = q.Exec(ctx, stmt, layer.Count, layer.UnitCost, layer.ID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return cost, nil
+}
+
+// getCostLayers получает слои себестоимости товара на складе, в которых остался товар,
+// и блокирует их до конца транзакции. Слои отсортированы от ранних поступлений к поздним.
+func getCostLayers(ctx context.Context, q querier, warehouseID, productID string) ([]*domain.CostLayer, error) {
+	stmt := `
+	SELECT layer_id, unit_cost, layer_count, received_at
+	FROM inventory_cost_layer
+	WHERE warehouse_id = $1 AND product_id = $2 AND layer_count > 0
+	ORDER BY received_at, layer_id
+	FOR UPDATE
+	`
+
+	rows, err := q.Query(ctx, stmt, warehouseID, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	layers := make([]*domain.CostLayer, 0)
+	for rows.Next() {
+		layer := &domain.CostLayer{}
+		err = rows.Scan(&layer.ID, &layer.UnitCost, &layer.Count, &layer.ReceivedAt)
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, layer)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return layers, nil
+}
@@ -0,0 +1,309 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// discountRuleColumns - столбцы правила скидки в порядке, который ожидает scanDiscountRule.
+const discountRuleColumns = `discount_id, warehouse_id, product_id, discount_type, discount_percent, discount_amount,
+	priority, stackable, valid_from, valid_to, created_at`
+
+// CreateDiscountRule создает правило скидки и заполняет его идентификатор и дату создания.
+//
+// Если товара нет на складе, то возвращает ErrInventoryNotFound.
+func (db *Postgres) CreateDiscountRule(ctx context.Context, rule *domain.DiscountRule) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.CreateDiscountRule"),
+	)
+
+	percent, amount := discountRuleValues(rule)
+
+	stmt := `
+	INSERT INTO discount_rule(warehouse_id, product_id, discount_type, discount_percent, discount_amount, priority, stackable, valid_from, valid_to)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	RETURNING discount_id, created_at
+	`
+
+	err := db.pool.QueryRow(ctx, stmt,
+		rule.Warehouse.ID,
+		rule.Product.ID,
+		rule.Type,
+		percent,
+		amount,
+		rule.Priority,
+		rule.Stackable,
+		rule.ValidFrom,
+		rule.ValidTo,
+	).Scan(&rule.ID, &rule.CreatedAt)
+	if err != nil {
+		if isForeignKeyError(err) {
+			return custErr.ErrInventoryNotFound
+		}
+		log.Error("error while creating discount rule", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// discountRuleValues возвращает процент и сумму скидки для записи в базу данных.
+// Значение, не относящееся к типу скидки, записывается как NULL.
+func discountRuleValues(rule *domain.DiscountRule) (any, any) {
+	if rule.Type == domain.DiscountFixed {
+		return nil, rule.Amount
+	}
+
+	return rule.Percent, nil
+}
+
+// isForeignKeyError сообщает, что запрос нарушил ограничение внешнего ключа.
+func isForeignKeyError(err error) bool {
+	pgErr := new(pgconn.PgError)
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
+}
+
+// GetDiscountRule получает правило скидки по его идентификатору.
+//
+// Если правило не найдено, то возвращает ErrDiscountRuleNotFound.
+func (db *Postgres) GetDiscountRule(ctx context.Context, discountID string) (*domain.DiscountRule, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetDiscountRule"),
+	)
+
+	stmt := `SELECT ` + discountRuleColumns + ` FROM discount_rule WHERE discount_id = $1`
+
+	rule, err := scanDiscountRule(db.pool.QueryRow(ctx, stmt, discountID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, custErr.ErrDiscountRuleNotFound
+		}
+		log.Error("error while getting discount rule", zap.Error(err))
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetDiscountRules получает правила скидок с фильтрацией по складу, товару и времени действия.
+func (db *Postgres) GetDiscountRules(ctx context.Context, filter *dto.DiscountRuleFilter) ([]*domain.DiscountRule, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetDiscountRules"),
+	)
+
+	var (
+		conditions = []string{"TRUE"}
+		args       []any
+	)
+
+	if filter.WarehouseID != "" {
+		args = append(args, filter.WarehouseID)
+		conditions = append(conditions, fmt.Sprintf("warehouse_id = $%d", len(args)))
+	}
+
+	if filter.ProductID != "" {
+		args = append(args, filter.ProductID)
+		conditions = append(conditions, fmt.Sprintf("product_id = $%d", len(args)))
+	}
+
+	if filter.ActiveAt != nil {
+		args = append(args, *filter.ActiveAt)
+		conditions = append(conditions, activeDiscountCondition(fmt.Sprintf("$%d", len(args))))
+	}
+
+	args = append(args, filter.Pagination.Offset, filter.Pagination.Limit)
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM discount_rule
+	WHERE %s
+	ORDER BY warehouse_id, product_id, priority DESC, created_at
+	OFFSET $%d
+	LIMIT $%d
+	`, discountRuleColumns, strings.Join(conditions, " AND "), len(args)-1, len(args))
+
+	rows, err := db.pool.Query(ctx, stmt, args...)
+	if err != nil {
+		log.Error("error while getting discount rules", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]*domain.DiscountRule, 0)
+	for rows.Next() {
+		rule, err := scanDiscountRule(rows)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			continue
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+
+	return rules, nil
+}
+
+// activeDiscountCondition возвращает условие, по которому правило действует в момент at.
+func activeDiscountCondition(at string) string {
+	return fmt.Sprintf("(valid_from IS NULL OR valid_from <= %[1]s) AND (valid_to IS NULL OR valid_to > %[1]s)", at)
+}
+
+// scanDiscountRule читает правило скидки из строки с порядком столбцов discountRuleColumns.
+func scanDiscountRule(row pgx.Row) (*domain.DiscountRule, error) {
+	var (
+		discountType string
+		percent      sql.NullInt64
+	)
+
+	rule := &domain.DiscountRule{
+		Warehouse: &domain.Warehouse{},
+		Product:   &domain.Product{},
+	}
+
+	err := row.Scan(
+		&rule.ID,
+		&rule.Warehouse.ID,
+		&rule.Product.ID,
+		&discountType,
+		&percent,
+		&rule.Amount,
+		&rule.Priority,
+		&rule.Stackable,
+		&rule.ValidFrom,
+		&rule.ValidTo,
+		&rule.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Type = domain.DiscountType(discountType)
+	if percent.Valid {
+		rule.Percent = int(percent.Int64)
+	}
+
+	return rule, nil
+}
+
+// UpdateDiscountRule заменяет условия правила скидки и заполняет его дату создания.
+//
+// Если правило не найдено, то возвращает ErrDiscountRuleNotFound.
+//
+// Если товара нет на складе, то возвращает ErrInventoryNotFound.
+func (db *Postgres) UpdateDiscountRule(ctx context.Context, rule *domain.DiscountRule) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.UpdateDiscountRule"),
+	)
+
+	percent, amount := discountRuleValues(rule)
+
+	stmt := `
+	UPDATE discount_rule
+	SET warehouse_id = $2, product_id = $3, discount_type = $4, discount_percent = $5, discount_amount = $6,
+		priority = $7, stackable = $8, valid_from = $9, valid_to = $10
+	WHERE discount_id = $1
+	RETURNING created_at
+	`
+
+	err := db.pool.QueryRow(ctx, stmt,
+		rule.ID,
+		rule.Warehouse.ID,
+		rule.Product.ID,
+		rule.Type,
+		percent,
+		amount,
+		rule.Priority,
+		rule.Stackable,
+		rule.ValidFrom,
+		rule.ValidTo,
+	).Scan(&rule.CreatedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return custErr.ErrDiscountRuleNotFound
+		case isForeignKeyError(err):
+			return custErr.ErrInventoryNotFound
+		}
+		log.Error("error while updating discount rule", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// DeleteDiscountRule удаляет правило скидки.
+//
+// Если правило не найдено, то возвращает ErrDiscountRuleNotFound.
+func (db *Postgres) DeleteDiscountRule(ctx context.Context, discountID string) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.DeleteDiscountRule"),
+	)
+
+	tag, err := db.pool.Exec(ctx, `DELETE FROM discount_rule WHERE discount_id = $1`, discountID)
+	if err != nil {
+		log.Error("error while deleting discount rule", zap.Error(err))
+		return err
+	}
+
+	if tag.RowsAffected() < 1 {
+		return custErr.ErrDiscountRuleNotFound
+	}
+
+	return nil
+}
+
+// fillActiveDiscounts заполняет правила скидок, действующие на товары склада в текущий момент.
+//
+// Внутри транзакции текущим моментом считается время ее начала, поэтому при покупке
+// применяются правила, действовавшие в момент покупки.
+func fillActiveDiscounts(ctx context.Context, q querier, warehouseID string, invs []*domain.Inventory) error {
+	if len(invs) == 0 {
+		return nil
+	}
+
+	invMap := make(map[string]*domain.Inventory, len(invs))
+	for _, inv := range invs {
+		inv.Discounts = nil
+		invMap[inv.Product.ID.String()] = inv
+	}
+
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM discount_rule
+	WHERE warehouse_id = $1 AND product_id = ANY($2) AND %s
+	`, discountRuleColumns, activeDiscountCondition("now()"))
+
+	rows, err := q.Query(ctx, stmt, warehouseID, inventoryProductIDs(invs))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rule, err := scanDiscountRule(rows)
+		if err != nil {
+			return err
+		}
+
+		if inv, ok := invMap[rule.Product.ID.String()]; ok {
+			inv.Discounts = append(inv.Discounts, rule)
+		}
+	}
+
+	return rows.Err()
+}
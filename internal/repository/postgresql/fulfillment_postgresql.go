@@ -0,0 +1,185 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PlanFulfillment распределяет товары заказа по складам без покупки и записывает план в f.Shipments.
+//
+// Учитывается свободное количество товара на момент расчета: товар, удерживаемый
+// активными резервами, недоступен.
+//
+// Если товара на всех складах не хватает, то возвращает ErrNotEnoughProductCount.
+func (db *Postgres) PlanFulfillment(ctx context.Context, f *domain.Fulfillment) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.PlanFulfillment"),
+	)
+
+	stock, err := getFulfillmentStock(ctx, db.pool, fulfillmentProductIDs(f), false)
+	if err != nil {
+		log.Error("error while getting stock", zap.Error(err))
+		return err
+	}
+
+	if !f.Plan(stock) {
+		return custErr.ErrNotEnoughProductCount
+	}
+
+	return nil
+}
+
+// Fulfill распределяет товары заказа по складам и покупает их в одной транзакции.
+//
+// Остатки товара блокируются до расчета плана, поэтому план не может устареть до покупки.
+// Каждая корзина плана покупается как обычная покупка со склада: к ней применяются
+// правила ценообразования склада, создается отдельный заказ, его идентификатор
+// записывается в OrderID корзины. Оповещения об остатках всех складов записываются в outbox.
+//
+// Если товара на всех складах не хватает, то возвращает ErrNotEnoughProductCount,
+// и ни один товар не покупается.
+func (db *Postgres) Fulfill(ctx context.Context, f *domain.Fulfillment) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.Fulfill"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	stock, err := getFulfillmentStock(ctx, tx, fulfillmentProductIDs(f), true)
+	if err != nil {
+		log.Error("error while getting stock", zap.Error(err))
+		return err
+	}
+
+	if !f.Plan(stock) {
+		return custErr.ErrNotEnoughProductCount
+	}
+
+	for _, cart := range f.Shipments {
+		err = buyCart(ctx, tx, db.valuation, cart)
+		if err != nil {
+			log.Error("error while buying cart", zap.String("warehouse_id", cart.Warehouse.ID.String()), zap.Error(err))
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// fulfillmentProductIDs возвращает идентификаторы заказанных продуктов без повторов.
+func fulfillmentProductIDs(f *domain.Fulfillment) []string {
+	seen := make(map[uuid.UUID]bool, len(f.Items))
+	products := make([]string, 0, len(f.Items))
+	for _, item := range f.Items {
+		if seen[item.Product.ID] {
+			continue
+		}
+		seen[item.Product.ID] = true
+		products = append(products, item.Product.ID.String())
+	}
+
+	return products
+}
+
+// getFulfillmentStock получает свободное количество товаров products на всех складах,
+// где они есть, с ценами и скидками, действующими в момент запроса. Архивные продукты не учитываются.
+//
+// Если lock установлен, то строки инвентаря блокируются до конца транзакции.
+// Склады отсортированы по адресу.
+func getFulfillmentStock(ctx context.Context, q querier, products []string, lock bool) ([]*domain.FulfillmentStock, error) {
+	stmt := `
+	SELECT w.warehouse_id, w.warehouse_address, w.latitude, w.longitude,
+	inv.product_id, inv.product_count, inv.product_price, inv.product_sale
+	FROM inventory inv
+	JOIN warehouse w USING (warehouse_id)
+	JOIN product p USING (product_id)
+	WHERE inv.product_id = ANY($1) AND inv.product_count > 0 AND p.archived_at IS NULL
+	ORDER BY w.warehouse_address, w.warehouse_id
+	`
+	if lock {
+		stmt += "FOR UPDATE OF inv"
+	}
+
+	rows, err := q.Query(ctx, stmt, products)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		stock    []*domain.FulfillmentStock
+		stockMap = make(map[uuid.UUID]*domain.FulfillmentStock)
+	)
+
+	for rows.Next() {
+		var (
+			warehouse           domain.Warehouse
+			latitude, longitude *float64
+			inv                 = &domain.Inventory{Product: &domain.Product{}}
+			sale                sql.NullInt64
+		)
+
+		err = rows.Scan(&warehouse.ID, &warehouse.Address, &latitude, &longitude,
+			&inv.Product.ID, &inv.ProductCount, &inv.ProductPrice, &sale)
+		if err != nil {
+			return nil, err
+		}
+
+		s, ok := stockMap[warehouse.ID]
+		if !ok {
+			warehouse.Location = scanLocation(latitude, longitude)
+			s = &domain.FulfillmentStock{
+				Warehouse: &warehouse,
+				Items:     make(map[uuid.UUID]*domain.Inventory),
+			}
+			stockMap[warehouse.ID] = s
+			stock = append(stock, s)
+		}
+
+		inv.Warehouse = s.Warehouse
+		if sale.Valid {
+			inv.ProductSale = int(sale.Int64)
+		}
+		s.Items[inv.Product.ID] = inv
+	}
+	rows.Close()
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	for _, s := range stock {
+		invs := make([]*domain.Inventory, 0, len(s.Items))
+		for _, inv := range s.Items {
+			invs = append(invs, inv)
+		}
+
+		warehouseID := s.Warehouse.ID.String()
+		reserved, err := getReservedCounts(ctx, q, warehouseID, inventoryProductIDs(invs))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, inv := range invs {
+			inv.ProductCount -= reserved[inv.Product.ID.String()]
+		}
+
+		err = fillActiveDiscounts(ctx, q, warehouseID, invs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stock, nil
+}
@@ -0,0 +1,117 @@
+package postgresql
+
+import (
+	"context"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// LockIdempotencyKey захватывает ключ идемпотентности для обработки запроса.
+//
+// Если ключ захвачен впервые, то возвращает true. Если ключ уже существует,
+// то возвращает сохраненную по нему запись и false.
+//
+// Ключ, ответ по которому не сохранен дольше lockTTL, считается брошенным
+// (например, приложение остановилось во время обработки запроса). Такой ключ
+// захватывается заново тем же запросом, и возвращается true.
+func (db *Postgres) LockIdempotencyKey(ctx context.Context, key, requestHash string, lockTTL time.Duration) (*domain.IdempotencyRecord, bool, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.LockIdempotencyKey"),
+	)
+
+	stmt := `
+	INSERT INTO idempotency_key(idempotency_key, request_hash)
+	VALUES ($1, $2)
+	ON CONFLICT (idempotency_key) DO UPDATE
+	SET created_at = now()
+	WHERE idempotency_key.response_status IS NULL
+		AND idempotency_key.request_hash = EXCLUDED.request_hash
+		AND idempotency_key.created_at <= now() - make_interval(secs => $3::float8)
+	`
+
+	tag, err := db.pool.Exec(ctx, stmt, key, requestHash, lockTTL.Seconds())
+	if err != nil {
+		log.Error("error while inserting idempotency key", zap.Error(err))
+		return nil, false, err
+	}
+
+	if tag.RowsAffected() == 1 {
+		return nil, true, nil
+	}
+
+	record := &domain.IdempotencyRecord{Key: key}
+
+	stmt = `
+	SELECT request_hash, COALESCE(response_status, 0), response_body, created_at
+	FROM idempotency_key
+	WHERE idempotency_key = $1
+	`
+
+	err = db.pool.QueryRow(ctx, stmt, key).Scan(&record.RequestHash, &record.ResponseStatus, &record.ResponseBody, &record.CreatedAt)
+	if err != nil {
+		log.Error("error while getting idempotency key", zap.Error(err))
+		return nil, false, err
+	}
+
+	return record, false, nil
+}
+
+// SaveIdempotencyResponse сохраняет ответ на запрос с ключом идемпотентности.
+func (db *Postgres) SaveIdempotencyResponse(ctx context.Context, key string, status int, body []byte) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.SaveIdempotencyResponse"),
+	)
+
+	stmt := `
+	UPDATE idempotency_key
+	SET response_status = $1, response_body = $2
+	WHERE idempotency_key = $3
+	`
+
+	_, err := db.pool.Exec(ctx, stmt, status, body, key)
+	if err != nil {
+		log.Error("error while saving idempotency response", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// DeleteIdempotencyKey удаляет ключ идемпотентности, чтобы запрос можно было повторить.
+func (db *Postgres) DeleteIdempotencyKey(ctx context.Context, key string) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.DeleteIdempotencyKey"),
+	)
+
+	_, err := db.pool.Exec(ctx, `DELETE FROM idempotency_key WHERE idempotency_key = $1`, key)
+	if err != nil {
+		log.Error("error while deleting idempotency key", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// PurgeIdempotencyKeys удаляет ключи идемпотентности, захваченные раньше, чем keyTTL назад,
+// и возвращает их количество.
+func (db *Postgres) PurgeIdempotencyKeys(ctx context.Context, keyTTL time.Duration) (int, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.PurgeIdempotencyKeys"),
+	)
+
+	stmt := `
+	DELETE FROM idempotency_key
+	WHERE created_at <= now() - make_interval(secs => $1::float8)
+	`
+
+	tag, err := db.pool.Exec(ctx, stmt, keyTTL.Seconds())
+	if err != nil {
+		log.Error("error while purging idempotency keys", zap.Error(err))
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
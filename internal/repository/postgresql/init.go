@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
 	"github.com/PIRSON21/mediasoft-intership2025/pkg/config"
 	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
@@ -14,15 +17,30 @@ import (
 // Использует пул соединений для управления подключениями к базе данных.
 // Реализует интерфейс Repository.
 type Postgres struct {
-	pool *pgxpool.Pool
+	pool      *pgxpool.Pool
+	valuation domain.ValuationMethod // Метод оценки себестоимости списываемого товара.
+}
+
+// querier - общий интерфейс пула соединений и транзакции.
+// Позволяет использовать одни и те же запросы как внутри транзакции, так и вне ее.
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
 
 // NewPostgres создает новое соединение с базой данных PostgreSQL.
-func NewPostgres(ctx context.Context, dbConfig config.DBConfig) (*Postgres, error) {
+func NewPostgres(ctx context.Context, dbConfig config.DBConfig, valuationConfig config.ValuationConfig) (*Postgres, error) {
 	const op = "repository.postgresql.NewPostgres"
 	log := logger.GetLogger()
 	log = log.With(zap.String("op", op))
 
+	valuation, err := domain.ParseValuationMethod(valuationConfig.ValuationMethod)
+	if err != nil {
+		log.Error("error while parsing valuation method", zap.Error(err))
+		return nil, err
+	}
+
 	connOpts, err := parsePostgresOpts(dbConfig)
 	if err != nil {
 		log.Error("error while parsing config", zap.Error(err))
@@ -42,7 +60,8 @@ func NewPostgres(ctx context.Context, dbConfig config.DBConfig) (*Postgres, erro
 	}
 
 	return &Postgres{
-		pool: pool,
+		pool:      pool,
+		valuation: valuation,
 	}, nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
@@ -18,18 +19,29 @@ import (
 
 // CreateInventory создает новую запись в таблице inventory.
 //
+// Начальное количество товара записывается в журнал движения как поступление
+// и в слои себестоимости по цене inventory.UnitCost.
+// Для серийного товара записываются серийные номера inventory.Serials.
+//
 // Если запись с таким product_id и warehouse_id уже существует, то возвращает ошибку ErrInventoryAlreadyExists.
 //
 // Если warehouse_id или product_id не существует, то возвращает ошибку ErrForeignKey.
 func (db *Postgres) CreateInventory(ctx context.Context, inventory *domain.Inventory) error {
 	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.CreateInventory"))
 
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
 	stmt := `
 	INSERT INTO inventory(product_id, warehouse_id, product_count, product_price)
 	VALUES ($1, $2, $3, $4)
 	`
 
-	tag, err := db.pool.Exec(ctx, stmt, inventory.Product.ID, inventory.Warehouse.ID, inventory.ProductCount, inventory.ProductPrice)
+	tag, err := tx.Exec(ctx, stmt, inventory.Product.ID, inventory.Warehouse.ID, inventory.ProductCount, inventory.ProductPrice)
 	if err != nil {
 		pgError := new(pgconn.PgError)
 		if errors.As(err, &pgError) {
@@ -48,21 +60,61 @@ func (db *Postgres) CreateInventory(ctx context.Context, inventory *domain.Inven
 		return fmt.Errorf("no rows affected")
 	}
 
-	return nil
+	err = receiveSerials(ctx, tx, inventory)
+	if err != nil {
+		if !isSerialError(err) {
+			log.Error("error while receiving serials", zap.Error(err))
+		}
+		return err
+	}
+
+	err = addCostLayer(ctx, tx, inventory)
+	if err != nil {
+		log.Error("error while adding cost layer", zap.Error(err))
+		return err
+	}
+
+	if inventory.ProductCount > 0 {
+		movement := newStockMovement(ctx, inventory, inventory.ProductCount, domain.MovementReceipt)
+		err = addStockMovements(ctx, tx, []*domain.StockMovement{movement})
+		if err != nil {
+			log.Error("error while adding stock movement", zap.Error(err))
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
 }
 
 // ChangeProductCount изменяет количество продукта на складе.
 //
+// Изменение записывается в журнал движения товаров в той же транзакции.
+// Поступивший товар записывается в слои себестоимости по цене inventory.UnitCost,
+// а убранный со склада товар списывается с партий и слоев себестоимости.
+// Для серийного товара записываются серийные номера inventory.Serials.
+//
+// Поступивший товар обеспечивает открытые предзаказы товара в порядке их создания.
+//
+// Оповещения о том, что остаток пересек минимальное количество, и об обеспеченных
+// предзаказах записываются в outbox в той же транзакции.
+//
 // Если количество меньше нуля, то возвращает ошибку ErrNotEnoughProductCount.
 //
 // Если запись не найдена, то возвращает ErrInventoryNotFound.
 func (db *Postgres) ChangeProductCount(ctx context.Context, inventory *domain.Inventory) error {
 	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.ChangeProductCount"))
 
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
 	// используется пользовательская функция. код в миграции 000004
 	stmt := `SELECT increase_product_count($1, $2, $3)`
 
-	tag, err := db.pool.Exec(ctx, stmt, &inventory.Product.ID, &inventory.Warehouse.ID, &inventory.ProductCount)
+	tag, err := tx.Exec(ctx, stmt, &inventory.Product.ID, &inventory.Warehouse.ID, &inventory.ProductCount)
 	if err != nil {
 		pgErr := new(pgconn.PgError)
 		if errors.As(err, &pgErr) {
@@ -78,10 +130,140 @@ func (db *Postgres) ChangeProductCount(ctx context.Context, inventory *domain.In
 		return fmt.Errorf("no rows affected")
 	}
 
-	return nil
+	err = receiveSerials(ctx, tx, inventory)
+	if err != nil {
+		if !isSerialError(err) {
+			log.Error("error while receiving serials", zap.Error(err))
+		}
+		return err
+	}
+
+	err = addCostLayer(ctx, tx, inventory)
+	if err != nil {
+		log.Error("error while adding cost layer", zap.Error(err))
+		return err
+	}
+
+	if inventory.ProductCount < 0 {
+		err = writeOffStock(ctx, tx, db.valuation, inventory)
+		if err != nil {
+			log.Error("error while writing off stock", zap.Error(err))
+			return err
+		}
+	}
+
+	movement := newStockMovement(ctx, inventory, inventory.ProductCount, domain.MovementAdjustment)
+	err = addStockMovements(ctx, tx, []*domain.StockMovement{movement})
+	if err != nil {
+		log.Error("error while adding stock movement", zap.Error(err))
+		return err
+	}
+
+	alert, err := getStockAlert(ctx, tx, inventory, inventory.ProductCount, domain.MovementAdjustment)
+	if err != nil {
+		log.Error("error while checking stock level", zap.Error(err))
+		return err
+	}
+
+	alerts, err := allocateBackorders(ctx, tx, db.valuation, inventory, domain.MovementAdjustment)
+	if err != nil {
+		log.Error("error while allocating backorders", zap.Error(err))
+		return err
+	}
+
+	if alert != nil {
+		alerts = append([]*domain.StockAlert{alert}, alerts...)
+	}
+
+	err = addStockAlertEvents(ctx, tx, alerts)
+	if err != nil {
+		log.Error("error while adding stock alerts", zap.Error(err))
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// writeOffStock списывает товар, убранный со склада при уменьшении количества на inv.ProductCount,
+// с партий в порядке истечения срока годности, а со слоев себестоимости - методом method.
+// Себестоимость списанного товара записывается в inv.Cost.
+//
+// Количество на складе к этому моменту уже уменьшено.
+func writeOffStock(ctx context.Context, q querier, method domain.ValuationMethod, inv *domain.Inventory) error {
+	warehouseID, productID := inv.Warehouse.ID.String(), inv.Product.ID.String()
+
+	var after int
+	err := q.QueryRow(ctx, `SELECT product_count FROM inventory WHERE warehouse_id = $1 AND product_id = $2`, warehouseID, productID).
+		Scan(&after)
+	if err != nil {
+		return err
+	}
+
+	err = consumeBatches(ctx, q, warehouseID, productID, -inv.ProductCount)
+	if err != nil {
+		return err
+	}
+
+	inv.Cost, err = consumeCostLayers(ctx, q, method, warehouseID, productID, after-inv.ProductCount, -inv.ProductCount)
+
+	return err
+}
+
+// getStockAlert читает остаток и пороги товара inv после изменения остатка на delta
+// и возвращает оповещение, если остаток пересек минимальное количество.
+func getStockAlert(ctx context.Context, q querier, inv *domain.Inventory, delta int, reason domain.MovementReason) (*domain.StockAlert, error) {
+	stmt := `
+	SELECT product_count, min_quantity, reorder_quantity
+	FROM inventory
+	WHERE warehouse_id = $1 AND product_id = $2
+	`
+
+	level := &domain.Inventory{
+		Product:   inv.Product,
+		Warehouse: inv.Warehouse,
+	}
+
+	err := q.QueryRow(ctx, stmt, inv.Warehouse.ID, inv.Product.ID).
+		Scan(&level.ProductCount, &level.MinQuantity, &level.ReorderQuantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return level.StockAlert(level.ProductCount-delta, reason), nil
+}
+
+// ChangeProductPrice изменяет цену товара на складе на change.NewPrice и, если sale не равен nil, скидку.
+//
+// Прежние цена и скидка записываются в change, изменение сохраняется в истории цен в той же транзакции.
+//
+// Если запись не найдена, то возвращает ErrInventoryNotFound.
+func (db *Postgres) ChangeProductPrice(ctx context.Context, change *domain.PriceChange, sale *int) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.ChangeProductPrice"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	price := change.NewPrice
+	err = changeInventoryPrice(ctx, tx, change, &price, sale)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrInventoryNotFound) {
+			log.Error("error while changing price", zap.Error(err))
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 // AddDiscountToProducts добавляет скидку на продукты в инвентаре.
+//
+// Каждое изменение скидки записывается в историю цен.
 func (db *Postgres) AddDiscountToProducts(ctx context.Context, inventory []*domain.Inventory) error {
 	log := logger.GetLogger().With(
 		zap.String("op", "repository.Postgres.AddDiscountToProduct"),
@@ -109,20 +291,12 @@ func (db *Postgres) AddDiscountToProducts(ctx context.Context, inventory []*doma
 //
 // Если запись не найдена, то возвращает ErrInventoryNotFound.
 func addDiscount(ctx context.Context, conn pgx.Tx, discount *domain.Inventory) error {
-	stmt := `
-		UPDATE inventory SET product_sale = $1 WHERE warehouse_id = $2 AND product_id = $3
-	`
-
-	tag, err := conn.Exec(ctx, stmt, discount.ProductSale, discount.Warehouse.ID, discount.Product.ID)
-	if err != nil {
-		return err
-	}
-
-	if tag.RowsAffected() < 1 {
-		return custErr.ErrInventoryNotFound
+	change := &domain.PriceChange{
+		Warehouse: discount.Warehouse,
+		Product:   discount.Product,
 	}
 
-	return nil
+	return changeInventoryPrice(ctx, conn, change, nil, &discount.ProductSale)
 }
 
 // GetProductFromWarehouse получает информацию о продукте на складе.
@@ -140,7 +314,7 @@ func (db *Postgres) GetProductFromWarehouse(ctx context.Context, inventory *doma
 		ProductParams      map[string]any
 		ProductBarcode     string
 		ProductCount       sql.NullInt64
-		ProductPrice       sql.NullFloat64
+		ProductPrice       domain.Money // NULL читается как ноль.
 		ProductSale        sql.NullInt64
 	}{}
 
@@ -183,21 +357,26 @@ func (db *Postgres) GetProductFromWarehouse(ctx context.Context, inventory *doma
 	} else {
 		inventory.ProductCount = 0
 	}
-	if inv.ProductPrice.Valid {
-		inventory.ProductPrice = inv.ProductPrice.Float64
-	} else {
-		inventory.ProductPrice = 0
-	}
+	inventory.ProductPrice = inv.ProductPrice
 	if inv.ProductSale.Valid {
 		inventory.ProductSale = int(inv.ProductSale.Int64)
 	} else {
 		inventory.ProductSale = 0
 	}
 
+	err = fillActiveDiscounts(ctx, db.pool, inventory.Warehouse.ID.String(), []*domain.Inventory{inventory})
+	if err != nil {
+		log.Error("error while getting active discounts", zap.Error(err))
+		return err
+	}
+
 	return nil
 }
 
-// GetPriceAndDiscount получает цену и скидку для продуктов в инвентаре.
+// GetPriceAndDiscount получает цену, скидку и действующие правила скидок для продуктов в инвентаре.
+//
+// Количество, удерживаемое активными резервами, считается недоступным.
+// Архивные продукты считаются отсутствующими на складе.
 //
 // Если запись не найдена, то возвращает ErrInventoryNotFound.
 func (db *Postgres) GetPriceAndDiscount(ctx context.Context, invs []*domain.Inventory) error {
@@ -219,9 +398,17 @@ func (db *Postgres) GetPriceAndDiscount(ctx context.Context, invs []*domain.Inve
 		productsID = append(productsID, productID)
 	}
 
+	reserved, err := getReservedCounts(ctx, db.pool, warehouseID.String(), productsID)
+	if err != nil {
+		log.Error("error while getting reserved product count", zap.Error(err))
+		return err
+	}
+
 	stmt := `
-		SELECT product_id, product_price, product_sale, product_count FROM inventory
-		WHERE warehouse_id = $1 AND product_id = ANY($2)
+		SELECT inv.product_id, inv.product_price, inv.product_sale, inv.product_count
+		FROM inventory inv
+		JOIN product p USING (product_id)
+		WHERE inv.warehouse_id = $1 AND inv.product_id = ANY($2) AND p.archived_at IS NULL
 	`
 
 	rows, err := db.pool.Query(ctx, stmt, warehouseID, productsID)
@@ -231,7 +418,7 @@ func (db *Postgres) GetPriceAndDiscount(ctx context.Context, invs []*domain.Inve
 	}
 	defer rows.Close()
 
-	err = scanRows(rows, invMap)
+	err = scanRows(rows, invMap, reserved)
 	if err != nil {
 		log.Error("error while scanning rows", zap.Error(err))
 		return err
@@ -246,15 +433,23 @@ func (db *Postgres) GetPriceAndDiscount(ctx context.Context, invs []*domain.Inve
 		return custErr.ErrNotFoundProductAtWarehouse
 	}
 
+	err = fillActiveDiscounts(ctx, db.pool, warehouseID.String(), invs)
+	if err != nil {
+		log.Error("error while getting active discounts", zap.Error(err))
+		return err
+	}
+
 	return nil
 }
 
 // scanRows сканирует строки из результата запроса и заполняет информацию о цене и скидке.
-func scanRows(rows pgx.Rows, invMap map[string]*domain.Inventory) error {
+//
+// reserved содержит количество продуктов, удерживаемое резервами.
+func scanRows(rows pgx.Rows, invMap map[string]*domain.Inventory, reserved map[string]int) error {
 	for rows.Next() {
 		var (
 			productID string
-			price     sql.NullFloat64
+			price     domain.Money
 			discount  sql.NullInt64
 			count     sql.NullInt64
 		)
@@ -264,9 +459,6 @@ func scanRows(rows pgx.Rows, invMap map[string]*domain.Inventory) error {
 			return err
 		}
 
-		if !price.Valid {
-			price.Float64 = 0
-		}
 		if !discount.Valid {
 			discount.Int64 = 0
 		}
@@ -275,10 +467,10 @@ func scanRows(rows pgx.Rows, invMap map[string]*domain.Inventory) error {
 		}
 
 		if inv, ok := invMap[productID]; ok {
-			if inv.ProductCount > int(count.Int64) {
+			if inv.ProductCount > int(count.Int64)-reserved[productID] {
 				return custErr.ErrNotEnoughProductCount
 			}
-			inv.ProductPrice = price.Float64
+			inv.ProductPrice = price
 			inv.ProductSale = int(discount.Int64)
 		}
 	}
@@ -292,19 +484,222 @@ func scanRows(rows pgx.Rows, invMap map[string]*domain.Inventory) error {
 	return nil
 }
 
-// GetProductsAtWarehouse получает продукты на складе с пагинацией.
-func (db *Postgres) GetProductsAtWarehouse(ctx context.Context, params *dto.Pagination, warehouseID string) ([]*domain.Inventory, error) {
+// stockSortColumns сопоставляет поле сортировки товаров склада со столбцом выборки stock.
+var stockSortColumns = map[domain.StockSort]string{
+	domain.StockSortName:          `product_name COLLATE "C"`,
+	domain.StockSortPrice:         "product_price",
+	domain.StockSortDiscountPrice: "discount_price",
+	domain.StockSortCount:         "product_count",
+}
+
+// getStockListingStatements формирует SQL-запрос страницы товаров склада и запрос
+// количества всех товаров, подходящих под фильтры listing.
+//
+// Запросу количества передаются первые countArgs аргументов. Страница запрашивается
+// с одним лишним товаром, по которому определяется, есть ли следующая страница.
+func getStockListingStatements(warehouseID string, listing *domain.StockListing) (stmt, countStmt string, args []any, countArgs int) {
+	conditions := []string{"TRUE"}
+	args = []any{warehouseID}
+
+	if listing.InStock {
+		conditions = append(conditions, "product_count > 0")
+	}
+	if listing.Discounted {
+		conditions = append(conditions, "discount_price < product_price")
+	}
+	if listing.MinPrice != nil {
+		args = append(args, *listing.MinPrice)
+		conditions = append(conditions, fmt.Sprintf("discount_price >= $%d", len(args)))
+	}
+	if listing.MaxPrice != nil {
+		args = append(args, *listing.MaxPrice)
+		conditions = append(conditions, fmt.Sprintf("discount_price <= $%d", len(args)))
+	}
+
+	stock := `
+	WITH stock AS (
+		SELECT p.product_id, p.product_name, inv.product_count, inv.product_price, COALESCE(inv.product_sale, 0) AS product_sale,
+			inventory_discount_price(inv.product_id, inv.warehouse_id, inv.product_price, inv.product_sale, now()) AS discount_price
+		FROM inventory inv
+		JOIN product p USING (product_id)
+		WHERE inv.warehouse_id = $1 AND p.archived_at IS NULL
+	)`
+
+	countStmt = fmt.Sprintf(`%s
+	SELECT COUNT(*)
+	FROM stock
+	WHERE %s
+	`, stock, strings.Join(conditions, " AND "))
+	countArgs = len(args)
+
+	column, ok := stockSortColumns[listing.Sort]
+	if !ok {
+		column = stockSortColumns[domain.StockSortName]
+	}
+
+	direction, compare := "ASC", ">"
+	if listing.Desc {
+		direction, compare = "DESC", "<"
+	}
+
+	// курсор заменяет смещение: следующая страница начинается сразу после товара курсора.
+	var offset string
+	if cursor := listing.After; cursor != nil {
+		args = append(args, stockCursorValue(listing.Sort, cursor), cursor.ProductID.String())
+		conditions = append(conditions, fmt.Sprintf("(%s, product_id) %s ($%d, $%d)", column, compare, len(args)-1, len(args)))
+	} else if listing.Offset > 0 {
+		args = append(args, listing.Offset)
+		offset = fmt.Sprintf("OFFSET $%d", len(args))
+	}
+
+	args = append(args, listing.Limit+1)
+
+	stmt = fmt.Sprintf(`%s
+	SELECT product_id, product_name, product_count, product_price, product_sale, discount_price
+	FROM stock
+	WHERE %s
+	ORDER BY %s %s, product_id %s
+	%s
+	LIMIT $%d
+	`, stock, strings.Join(conditions, " AND "), column, direction, direction, offset, len(args))
+
+	return stmt, countStmt, args, countArgs
+}
+
+// stockCursorValue возвращает значение поля сортировки sort, сохраненное в курсоре.
+func stockCursorValue(sort domain.StockSort, cursor *domain.StockCursor) any {
+	switch sort {
+	case domain.StockSortPrice, domain.StockSortDiscountPrice:
+		return cursor.Price
+	case domain.StockSortCount:
+		return cursor.Count
+	default:
+		return cursor.Name
+	}
+}
+
+// GetProductsAtWarehouse получает страницу товаров склада, подходящих под условия listing.
+//
+// Цена со скидкой считается в запросе функцией inventory_discount_price, поэтому фильтры
+// по цене, сортировка и курсор применяются в базе данных. Архивные продукты в список не попадают.
+func (db *Postgres) GetProductsAtWarehouse(ctx context.Context, warehouseID string, listing *domain.StockListing) (*domain.StockPage, error) {
 	log := logger.GetLogger().With(
 		zap.String("op", "repository.Postgres.GetProducts"),
 	)
 
-	var products []*domain.Inventory
+	stmt, countStmt, args, countArgs := getStockListingStatements(warehouseID, listing)
+
+	page, err := getStockPage(ctx, db.pool, stmt, args, listing)
+	if err != nil {
+		log.Error("error while getting stock page", zap.Error(err))
+		return nil, err
+	}
+
+	err = db.pool.QueryRow(ctx, countStmt, args[:countArgs]...).Scan(&page.Total)
+	if err != nil {
+		log.Error("error while counting stock", zap.Error(err))
+		return nil, err
+	}
+
+	err = fillActiveDiscounts(ctx, db.pool, warehouseID, page.Items)
+	if err != nil {
+		log.Error("error while getting active discounts", zap.Error(err))
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// getStockPage выполняет запрос страницы товаров склада и формирует курсор следующей страницы,
+// если запрос вернул больше listing.Limit товаров.
+func getStockPage(ctx context.Context, q querier, stmt string, args []any, listing *domain.StockListing) (*domain.StockPage, error) {
+	rows, err := q.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		page   = &domain.StockPage{}
+		prices []domain.Money
+	)
+
+	for rows.Next() {
+		var (
+			id            string
+			discountPrice domain.Money
+			inv           = &domain.Inventory{Product: &domain.Product{}}
+		)
+
+		err = rows.Scan(&id, &inv.Product.Name, &inv.ProductCount, &inv.ProductPrice, &inv.ProductSale, &discountPrice)
+		if err != nil {
+			return nil, err
+		}
+
+		inv.Product.ID, err = uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+
+		page.Items = append(page.Items, inv)
+		prices = append(prices, discountPrice)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(page.Items) > listing.Limit {
+		page.Items = page.Items[:listing.Limit]
+		last := len(page.Items) - 1
+		page.Next = listing.Cursor(page.Items[last], prices[last])
+	}
+
+	return page, nil
+}
+
+// SetStockThresholds задает минимальное количество товара на складе и количество для дозаказа.
+//
+// Если запись не найдена, то возвращает ErrInventoryNotFound.
+func (db *Postgres) SetStockThresholds(ctx context.Context, inventory *domain.Inventory) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.SetStockThresholds"),
+	)
+
+	stmt := `
+	UPDATE inventory
+	SET min_quantity = $1, reorder_quantity = $2
+	WHERE warehouse_id = $3 AND product_id = $4
+	`
+
+	tag, err := db.pool.Exec(ctx, stmt, inventory.MinQuantity, inventory.ReorderQuantity, inventory.Warehouse.ID, inventory.Product.ID)
+	if err != nil {
+		log.Error("error while setting stock thresholds", zap.Error(err))
+		return err
+	}
+
+	if tag.RowsAffected() < 1 {
+		return custErr.ErrInventoryNotFound
+	}
+
+	return nil
+}
+
+// GetLowStockProducts получает товары склада, остаток которых не больше минимального количества.
+//
+// Товары отсортированы по доле остатка от минимального количества, начиная с закончившихся.
+// Архивные продукты не дозаказываются, поэтому в список не попадают.
+func (db *Postgres) GetLowStockProducts(ctx context.Context, params *dto.Pagination, warehouseID string) ([]*domain.Inventory, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetLowStockProducts"),
+	)
 
 	stmt := `
-	SELECT p.product_id, p.product_name, inv.product_price, inv.product_sale
+	SELECT p.product_id, p.product_name, inv.product_count, inv.min_quantity, inv.reorder_quantity
 	FROM inventory inv
 	JOIN product p USING (product_id)
-	WHERE inv.warehouse_id = $1
+	WHERE inv.warehouse_id = $1 AND p.archived_at IS NULL AND inv.min_quantity > 0 AND inv.product_count <= inv.min_quantity
+	ORDER BY inv.product_count::float / inv.min_quantity, p.product_name, p.product_id
 	OFFSET $2
 	LIMIT $3
 	`
@@ -314,37 +709,21 @@ func (db *Postgres) GetProductsAtWarehouse(ctx context.Context, params *dto.Pagi
 		log.Error("error while executing statement", zap.Error(err))
 		return nil, err
 	}
+	defer rows.Close()
 
+	products := make([]*domain.Inventory, 0)
 	for rows.Next() {
-		var (
-			id    string
-			name  string
-			price sql.NullFloat64
-			sale  sql.NullInt64
-		)
-
-		err = rows.Scan(&id, &name, &price, &sale)
-		if err != nil {
-			continue
+		inv := &domain.Inventory{
+			Product: &domain.Product{},
 		}
 
-		productID, _ := uuid.Parse(id)
-
-		prod := &domain.Inventory{
-			Product: &domain.Product{
-				ID:   productID,
-				Name: name,
-			},
-		}
-
-		if price.Valid {
-			prod.ProductPrice = float64(price.Float64)
-		}
-		if sale.Valid {
-			prod.ProductSale = int(sale.Int64)
+		err = rows.Scan(&inv.Product.ID, &inv.Product.Name, &inv.ProductCount, &inv.MinQuantity, &inv.ReorderQuantity)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			return nil, err
 		}
 
-		products = append(products, prod)
+		products = append(products, inv)
 	}
 
 	if rows.Err() != nil {
@@ -355,15 +734,36 @@ func (db *Postgres) GetProductsAtWarehouse(ctx context.Context, params *dto.Pagi
 	return products, nil
 }
 
-// BuyProducts вычитает количество продуктов из инвентаря.
+// BuyProducts вычитает количество продуктов корзины из инвентаря.
+//
+// Если в корзине указан резерв, то он используется: удерживаемые им товары
+// становятся доступны для этой покупки.
+//
+// Каждое списание записывается в журнал движения товаров как продажа.
+// В той же транзакции создается заказ, его идентификатор записывается в cart.OrderID,
+// и в outbox записывается событие продажи для аналитики.
+// Оповещения о товарах, остаток которых опустился до минимального количества,
+// записываются в outbox.
+// Единицы серийных товаров выдаются в порядке поступления, их номера записываются в Serials строк корзины.
 //
 // Если продуктов нет на складе, то возвращает ErrNotEnoughProductCount.
-func (db *Postgres) BuyProducts(ctx context.Context, inventories []*domain.Inventory) error {
+//
+// К корзине применяются включенные правила ценообразования склада, их скидки
+// сохраняются в строках заказа.
+//
+// Если в корзине указан промокод, то он применяется и его использование учитывается
+// в той же транзакции.
+//
+// Если резерв не найден или просрочен, то возвращает ErrReservationNotFound.
+// Если резерв не покрывает товары корзины, то возвращает ErrReservationMismatch.
+//
+// Если промокод не может быть применен, то возвращает одну из ошибок промокода.
+func (db *Postgres) BuyProducts(ctx context.Context, cart *domain.Cart) error {
 	log := logger.GetLogger().With(
 		zap.String("op", "repository.Postgres.BuyProducts"),
 	)
 
-	if len(inventories) == 0 {
+	if len(cart.Items) == 0 {
 		return nil
 	}
 
@@ -374,42 +774,126 @@ func (db *Postgres) BuyProducts(ctx context.Context, inventories []*domain.Inven
 	}
 	defer tx.Rollback(ctx)
 
-	err = validateProductCount(ctx, tx, inventories)
+	if cart.ReservationID != uuid.Nil {
+		err = consumeReservation(ctx, tx, cart)
+		if err != nil {
+			log.Error("error while consuming reservation", zap.Error(err))
+			return err
+		}
+	}
+
+	err = buyCart(ctx, tx, db.valuation, cart)
+	if err != nil {
+		log.Error("error while buying cart", zap.Error(err))
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// buyCart продает товары корзины в транзакции tx: проверяет их количество, применяет
+// правила ценообразования склада и промокод, списывает товар со склада, создает заказ
+// и предзаказы на недостающий товар и записывает событие продажи для аналитики.
+func buyCart(ctx context.Context, tx pgx.Tx, method domain.ValuationMethod, cart *domain.Cart) error {
+	err := validateProductCount(ctx, tx, cart.Items)
 	if err != nil {
-		log.Error("error while validating product count", zap.Error(err))
 		return err
 	}
 
-	err = updateProductCount(ctx, tx, inventories)
+	rules, err := getActivePricingRules(ctx, tx, cart.Warehouse.ID.String())
 	if err != nil {
-		log.Error("error while updating product count", zap.Error(err))
 		return err
 	}
+	cart.ApplyPricingRules(rules)
 
-	return tx.Commit(ctx)
+	if cart.PromoCode != nil {
+		err = redeemPromoCode(ctx, tx, cart)
+		if err != nil {
+			return err
+		}
+	}
+
+	alerts, err := updateProductCount(ctx, tx, method, cart.Items, domain.MovementSale)
+	if err != nil {
+		return err
+	}
+
+	err = addStockAlertEvents(ctx, tx, alerts)
+	if err != nil {
+		return err
+	}
+
+	movements := make([]*domain.StockMovement, 0, len(cart.Items))
+	for _, inv := range cart.Items {
+		if inv.FromStock() == 0 {
+			continue
+		}
+		movements = append(movements, newStockMovement(ctx, inv, -inv.FromStock(), domain.MovementSale))
+	}
+
+	err = addStockMovements(ctx, tx, movements)
+	if err != nil {
+		return err
+	}
+
+	cart.OrderID, err = insertOrder(ctx, tx, cart)
+	if err != nil {
+		return err
+	}
+
+	err = insertBackorders(ctx, tx, cart)
+	if err != nil {
+		return err
+	}
+
+	err = sellSerials(ctx, tx, cart)
+	if err != nil {
+		return err
+	}
+
+	for _, inv := range cart.Items {
+		inv.Revenue = inv.Total() - cart.PromoShare(inv.Total())
+	}
+
+	return addAnalyticsEvent(ctx, tx, analyticsEventSale, cart.Items)
 }
 
-// validateProductCount проверяет, что количество продуктов на складе достаточно для покупки.
+// validateProductCount проверяет, что количество продуктов на складе достаточно для покупки,
+// и заполняет цены и правила скидок, действующие в момент покупки.
+//
+// Количество, удерживаемое чужими активными резервами, считается недоступным.
+// Если для товара разрешен предзаказ, то недостающее количество в пределах лимита
+// записывается в Backordered строки корзины. Серийный товар предзаказать нельзя.
+// Архивные продукты считаются отсутствующими на складе.
 //
 // Если количество продуктов меньше, чем нужно, то возвращает ErrNotEnoughProductCount.
 func validateProductCount(ctx context.Context, tx pgx.Tx, invs []*domain.Inventory) error {
 	warehouseID := invs[0].Warehouse.ID.String()
 	products := make([]string, 0, len(invs))
-	countMap := make(map[string]int, len(invs))
 	invMap := make(map[string]*domain.Inventory, len(invs))
 
 	for _, inv := range invs {
 		productID := inv.Product.ID.String()
 		products = append(products, productID)
-		countMap[productID] = inv.ProductCount
 		invMap[productID] = inv
 	}
 
+	available, err := getAvailableCounts(ctx, tx, warehouseID, products)
+	if err != nil {
+		return err
+	}
+
+	backordered, err := getOpenBackorderCounts(ctx, tx, warehouseID, products)
+	if err != nil {
+		return err
+	}
+
 	stmt := `
-	SELECT product_id, product_count, product_price, product_sale
-	FROM inventory
-	WHERE warehouse_id = $1 AND product_id = ANY($2)
-	FOR UPDATE
+	SELECT inv.product_id, inv.product_price, inv.product_sale,
+	CASE WHEN p.product_serialized THEN 0 ELSE inv.backorder_limit END
+	FROM inventory inv
+	JOIN product p USING (product_id)
+	WHERE inv.warehouse_id = $1 AND inv.product_id = ANY($2) AND p.archived_at IS NULL
 	`
 
 	rows, err := tx.Query(ctx, stmt, warehouseID, products)
@@ -418,7 +902,7 @@ func validateProductCount(ctx context.Context, tx pgx.Tx, invs []*domain.Invento
 	}
 	defer rows.Close()
 
-	err = processRows(rows, invMap)
+	err = processRows(rows, invMap, available, backordered)
 	if err != nil {
 		return err
 	}
@@ -433,40 +917,38 @@ func validateProductCount(ctx context.Context, tx pgx.Tx, invs []*domain.Invento
 		return custErr.ErrNotFoundProductAtWarehouse
 	}
 
-	return nil
+	return fillActiveDiscounts(ctx, tx, warehouseID, invs)
 }
 
 // processRows обрабатывает строки из результата запроса и проверяет количество продуктов.
-func processRows(rows pgx.Rows, invMap map[string]*domain.Inventory) error {
+//
+// available содержит свободное количество продуктов на складе,
+// backordered - количество продуктов в открытых предзаказах.
+func processRows(rows pgx.Rows, invMap map[string]*domain.Inventory, available, backordered map[string]int) error {
 	for rows.Next() {
 		var (
 			dbProductID string
-			dbCount     sql.NullInt64
-			dbPrice     sql.NullFloat64
+			dbPrice     domain.Money
 			dbSale      sql.NullInt64
+			dbLimit     int
 		)
 
-		err := rows.Scan(&dbProductID, &dbCount, &dbPrice, &dbSale)
+		err := rows.Scan(&dbProductID, &dbPrice, &dbSale, &dbLimit)
 		if err != nil {
 			continue
 		}
 
-		if !dbCount.Valid {
-			return custErr.ErrNotEnoughProductCount
-		}
-
 		currentInv, ok := invMap[dbProductID]
 		if !ok {
 			continue
 		}
 
-		if int(dbCount.Int64) < currentInv.ProductCount {
+		currentInv.BackorderLimit = dbLimit
+		if !currentInv.PlaceBackorder(available[dbProductID], backordered[dbProductID]) {
 			return custErr.ErrNotEnoughProductCount
 		}
 
-		if dbPrice.Valid {
-			currentInv.ProductPrice = dbPrice.Float64
-		}
+		currentInv.ProductPrice = dbPrice
 
 		if dbSale.Valid {
 			currentInv.ProductSale = int(dbSale.Int64)
@@ -476,30 +958,58 @@ func processRows(rows pgx.Rows, invMap map[string]*domain.Inventory) error {
 	return nil
 }
 
-// updateProductCount обновляет количество продуктов в инвентаре.
+// updateProductCount списывает товары со склада и возвращает оповещения о товарах,
+// остаток которых опустился до минимального количества.
+//
+// Товар, проданный по предзаказу сверх остатка, не списывается: он списывается при поступлении.
 //
-// Если количество продуктов меньше нуля, то возвращает ErrNotEnoughProductCount.
-func updateProductCount(ctx context.Context, tx pgx.Tx, invs []*domain.Inventory) error {
+// Товар списывается с партий в порядке истечения срока годности, а со слоев
+// себестоимости - методом method. Себестоимость списанного товара записывается в inv.Cost.
+//
+// Если товара на складе не хватает, то возвращает ErrNotEnoughProductCount.
+func updateProductCount(ctx context.Context, tx pgx.Tx, method domain.ValuationMethod, invs []*domain.Inventory, reason domain.MovementReason) ([]*domain.StockAlert, error) {
+	var alerts []*domain.StockAlert
+
 	warehouseID := invs[0].Warehouse.ID.String()
 	for _, inv := range invs {
 		productID := inv.Product.ID.String()
-		want := inv.ProductCount
+		want := inv.FromStock()
 
 		stmt := `
 		UPDATE inventory
 		SET	product_count = product_count - $1
 		WHERE warehouse_id = $2 AND product_id = $3 AND product_count >= $1
+		RETURNING product_count, min_quantity, reorder_quantity
 		`
 
-		tag, err := tx.Exec(ctx, stmt, want, warehouseID, productID)
+		level := &domain.Inventory{
+			Product:   inv.Product,
+			Warehouse: inv.Warehouse,
+		}
+
+		err := tx.QueryRow(ctx, stmt, want, warehouseID, productID).
+			Scan(&level.ProductCount, &level.MinQuantity, &level.ReorderQuantity)
 		if err != nil {
-			return err
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, custErr.ErrNotEnoughProductCount
+			}
+			return nil, err
 		}
 
-		if tag.RowsAffected() < 1 {
-			return custErr.ErrNotEnoughProductCount
+		err = consumeBatches(ctx, tx, warehouseID, productID, want)
+		if err != nil {
+			return nil, err
+		}
+
+		inv.Cost, err = consumeCostLayers(ctx, tx, method, warehouseID, productID, level.ProductCount+want, want)
+		if err != nil {
+			return nil, err
+		}
+
+		if alert := level.StockAlert(level.ProductCount+want, reason); alert != nil {
+			alerts = append(alerts, alert)
 		}
 	}
 
-	return nil
+	return alerts, nil
 }
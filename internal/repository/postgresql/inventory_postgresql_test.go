@@ -0,0 +1,138 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteOffStock(t *testing.T) {
+	soon := time.Date(2025, time.July, 10, 0, 0, 0, 0, time.UTC)
+	later := soon.AddDate(0, 1, 0)
+	received := soon.AddDate(0, -1, 0)
+
+	first, second := uuid.New(), uuid.New()
+	early, late := uuid.New(), uuid.New()
+	q := &fakeQuerier{
+		rows: []*fakeRow{{values: []any{7}}},
+		results: [][]*fakeRow{
+			{
+				{values: []any{second, "LOT-2", 5, &later, received}},
+				{values: []any{first, "LOT-1", 2, &soon, received}},
+			},
+			{
+				{values: []any{early, domain.Money(1000), 4, received}},
+				{values: []any{late, domain.Money(1200), 6, soon}},
+			},
+		},
+	}
+
+	inv := &domain.Inventory{
+		Product:      &domain.Product{ID: uuid.New()},
+		Warehouse:    &domain.Warehouse{ID: uuid.New()},
+		ProductCount: -3,
+	}
+
+	require.NoError(t, writeOffStock(context.Background(), q, domain.ValuationFIFO, inv))
+
+	require.Equal(t, [][]any{
+		{2, first},
+		{1, second},
+	}, q.execs("UPDATE inventory_batch"))
+
+	require.Equal(t, domain.Money(3000), inv.Cost)
+	require.Equal(t, [][]any{
+		{1, domain.Money(1000), early},
+	}, q.execs("UPDATE inventory_cost_layer"))
+}
+
+func TestGetStockListingStatements(t *testing.T) {
+	const warehouseID = "17b79680-4657-4ef4-9c3d-554a83c31828"
+
+	minPrice, maxPrice := domain.Money(500), domain.Money(1000)
+	after := &domain.StockCursor{Sort: domain.StockSortDiscountPrice, Desc: true, Price: 800, ProductID: uuid.New()}
+
+	tests := []struct {
+		name          string
+		listing       *domain.StockListing
+		wantStmt      []string
+		wantCount     []string
+		wantArgs      []any
+		wantCountArgs int
+	}{
+		{
+			name:          "offset by name",
+			listing:       &domain.StockListing{Sort: domain.StockSortName, Offset: 20, Limit: 10},
+			wantStmt:      []string{`ORDER BY product_name COLLATE "C" ASC, product_id ASC`, "OFFSET $2", "LIMIT $3"},
+			wantCount:     []string{"SELECT COUNT(*)", "WHERE TRUE\n"},
+			wantArgs:      []any{warehouseID, 20, 11},
+			wantCountArgs: 1,
+		},
+		{
+			name: "filters and cursor",
+			listing: &domain.StockListing{
+				Sort: domain.StockSortDiscountPrice, Desc: true, InStock: true, Discounted: true,
+				MinPrice: &minPrice, MaxPrice: &maxPrice, After: after, Offset: 20, Limit: 10,
+			},
+			wantStmt: []string{
+				"product_count > 0 AND discount_price < product_price AND discount_price >= $2 AND discount_price <= $3",
+				"(discount_price, product_id) < ($4, $5)",
+				"ORDER BY discount_price DESC, product_id DESC",
+				"LIMIT $6",
+			},
+			wantCount:     []string{"discount_price >= $2 AND discount_price <= $3\n"},
+			wantArgs:      []any{warehouseID, minPrice, maxPrice, domain.Money(800), after.ProductID.String(), 11},
+			wantCountArgs: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt, countStmt, args, countArgs := getStockListingStatements(warehouseID, tt.listing)
+
+			for _, want := range tt.wantStmt {
+				require.Contains(t, stmt, want)
+			}
+			for _, want := range tt.wantCount {
+				require.Contains(t, countStmt, want)
+			}
+			require.Equal(t, tt.wantArgs, args)
+			require.Equal(t, tt.wantCountArgs, countArgs)
+		})
+	}
+
+	stmt, _, _, _ := getStockListingStatements(warehouseID, &domain.StockListing{Sort: domain.StockSortCount, After: &domain.StockCursor{}, Offset: 20})
+	require.NotContains(t, stmt, "OFFSET")
+}
+
+func TestGetStockPage(t *testing.T) {
+	apple, banana := uuid.New(), uuid.New()
+	q := &fakeQuerier{
+		results: [][]*fakeRow{{
+			{values: []any{apple.String(), "apple", 5, domain.Money(1000), 0, domain.Money(1000)}},
+			{values: []any{banana.String(), "banana", 0, domain.Money(500), 20, domain.Money(400)}},
+		}},
+	}
+
+	listing := &domain.StockListing{Sort: domain.StockSortDiscountPrice, Limit: 1}
+
+	page, err := getStockPage(context.Background(), q, "", nil, listing)
+	require.NoError(t, err)
+
+	require.Equal(t, []*domain.Inventory{{
+		Product:      &domain.Product{ID: apple, Name: "apple"},
+		ProductCount: 5,
+		ProductPrice: 1000,
+	}}, page.Items)
+	require.Equal(t, &domain.StockCursor{Sort: domain.StockSortDiscountPrice, Price: 1000, ProductID: apple}, page.Next)
+
+	q = &fakeQuerier{results: [][]*fakeRow{{{err: errors.New("scan failed")}}}}
+
+	_, err = getStockPage(context.Background(), q, "", nil, listing)
+	require.EqualError(t, err, "scan failed")
+}
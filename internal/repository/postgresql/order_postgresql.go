@@ -0,0 +1,314 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// insertOrder записывает заказ со строками для купленных товаров корзины и возвращает его идентификатор.
+//
+// Цены и скидки берутся из инвентаря, поэтому инвентарь должен быть заполнен
+// функцией validateProductCount, а себестоимость - функцией updateProductCount.
+// Цена со скидкой сохраняется в строке заказа, чтобы последующие изменения
+// правил скидок не меняли стоимость заказа.
+func insertOrder(ctx context.Context, tx pgx.Tx, cart *domain.Cart) (uuid.UUID, error) {
+	var (
+		orderID   uuid.UUID
+		promoCode string
+	)
+
+	if cart.PromoCode != nil {
+		promoCode = cart.PromoCode.Code
+	}
+
+	stmt := `
+	INSERT INTO orders(warehouse_id, order_status, request_id, promo_code, promo_discount)
+	VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5)
+	RETURNING order_id
+	`
+
+	err := tx.QueryRow(ctx, stmt, cart.Warehouse.ID, domain.OrderCreated, middleware.GetRequestID(ctx), promoCode, cart.PromoDiscount).Scan(&orderID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var (
+		cursor = 2
+		rows   []string
+		values = []any{orderID}
+	)
+
+	for _, inv := range cart.Items {
+		rows = append(rows, fmt.Sprintf("($1, $%d, $%d, $%d, $%d, $%d, $%d, $%d)", cursor, cursor+1, cursor+2, cursor+3, cursor+4, cursor+5, cursor+6))
+		values = append(values, inv.Product.ID, inv.ProductCount, inv.ProductPrice, inv.ProductSale, inv.PriceWithDiscount(), inv.RuleDiscount(), inv.Cost)
+		cursor += 7
+	}
+
+	stmt = `INSERT INTO order_line(order_id, product_id, product_count, product_price, product_sale, discount_price, rule_discount, line_cost) VALUES ` + strings.Join(rows, ", ")
+
+	_, err = tx.Exec(ctx, stmt, values...)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return orderID, nil
+}
+
+// GetOrder получает заказ вместе с его строками.
+//
+// Если заказ не найден, то возвращает ErrOrderNotFound.
+func (db *Postgres) GetOrder(ctx context.Context, orderID string) (*domain.Order, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetOrder"),
+	)
+
+	order, err := getOrder(ctx, db.pool, orderID, false)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrOrderNotFound) {
+			log.Error("error while getting order", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// getOrder получает заказ вместе с его строками.
+// Если forUpdate равен true, то строка заказа блокируется до конца транзакции.
+func getOrder(ctx context.Context, q querier, orderID string, forUpdate bool) (*domain.Order, error) {
+	order := &domain.Order{
+		Warehouse: &domain.Warehouse{},
+	}
+
+	stmt := `
+	SELECT order_id, warehouse_id, order_status, COALESCE(promo_code, ''), promo_discount, created_at, updated_at
+	FROM orders
+	WHERE order_id = $1
+	`
+	if forUpdate {
+		stmt += " FOR UPDATE"
+	}
+
+	var status string
+	err := q.QueryRow(ctx, stmt, orderID).Scan(
+		&order.ID,
+		&order.Warehouse.ID,
+		&status,
+		&order.PromoCode,
+		&order.PromoDiscount,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, custErr.ErrOrderNotFound
+		}
+		return nil, err
+	}
+	order.Status = domain.OrderStatus(status)
+
+	err = getOrderLines(ctx, q, []*domain.Order{order})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// getOrderLines заполняет строки для переданных заказов одним запросом.
+func getOrderLines(ctx context.Context, q querier, orders []*domain.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(orders))
+	orderMap := make(map[uuid.UUID]*domain.Order, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+		orderMap[order.ID] = order
+	}
+
+	stmt := `
+	SELECT order_id, product_id, product_count, product_price, product_sale, discount_price, rule_discount, line_cost, returned_count,
+		COALESCE((
+			SELECT SUM(b.backorder_count - b.allocated_count)
+			FROM backorder b
+			WHERE b.order_id = order_line.order_id AND b.product_id = order_line.product_id AND b.backorder_status = $2
+		), 0)
+	FROM order_line
+	WHERE order_id = ANY($1)
+	ORDER BY order_id, product_id
+	`
+
+	rows, err := q.Query(ctx, stmt, ids, domain.BackorderOpen)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var orderID uuid.UUID
+		line := &domain.OrderLine{
+			Product: &domain.Product{},
+		}
+
+		err = rows.Scan(&orderID, &line.Product.ID, &line.ProductCount, &line.ProductPrice, &line.ProductSale, &line.DiscountPrice, &line.RuleDiscount, &line.Cost, &line.ReturnedCount, &line.Backordered)
+		if err != nil {
+			return err
+		}
+
+		if order, ok := orderMap[orderID]; ok {
+			order.Lines = append(order.Lines, line)
+		}
+	}
+
+	return rows.Err()
+}
+
+// GetOrders получает заказы склада с фильтрацией по дате создания и пагинацией.
+func (db *Postgres) GetOrders(ctx context.Context, filter *dto.OrderFilter) ([]*domain.Order, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetOrders"),
+	)
+
+	var (
+		conditions = []string{"TRUE"}
+		args       []any
+	)
+
+	if filter.WarehouseID != "" {
+		args = append(args, filter.WarehouseID)
+		conditions = append(conditions, fmt.Sprintf("warehouse_id = $%d", len(args)))
+	}
+
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+
+	args = append(args, filter.Pagination.Offset, filter.Pagination.Limit)
+	stmt := fmt.Sprintf(`
+	SELECT order_id, warehouse_id, order_status, COALESCE(promo_code, ''), promo_discount, created_at, updated_at
+	FROM orders
+	WHERE %s
+	ORDER BY created_at DESC, order_id
+	OFFSET $%d
+	LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args)-1, len(args))
+
+	rows, err := db.pool.Query(ctx, stmt, args...)
+	if err != nil {
+		log.Error("error while getting orders", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := make([]*domain.Order, 0)
+	for rows.Next() {
+		var status string
+		order := &domain.Order{
+			Warehouse: &domain.Warehouse{},
+		}
+
+		err = rows.Scan(&order.ID, &order.Warehouse.ID, &status, &order.PromoCode, &order.PromoDiscount, &order.CreatedAt, &order.UpdatedAt)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			continue
+		}
+		order.Status = domain.OrderStatus(status)
+
+		orders = append(orders, order)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+	rows.Close()
+
+	err = getOrderLines(ctx, db.pool, orders)
+	if err != nil {
+		log.Error("error while getting order lines", zap.Error(err))
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// UpdateOrderStatus переводит заказ в новое состояние.
+//
+// Если заказ не найден, то возвращает ErrOrderNotFound.
+//
+// Если переход из текущего состояния недопустим, то возвращает ErrInvalidOrderTransition.
+//
+// Если заказ отгружается, пока часть товара еще ожидает поступления по предзаказу, то возвращает ErrOrderBackordered.
+//
+// При успехе order заполняется актуальными данными заказа.
+func (db *Postgres) UpdateOrderStatus(ctx context.Context, order *domain.Order) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.UpdateOrderStatus"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	stored, err := getOrder(ctx, tx, order.ID.String(), true)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrOrderNotFound) {
+			log.Error("error while getting order", zap.Error(err))
+		}
+		return err
+	}
+
+	if !stored.Status.CanTransitionTo(order.Status) {
+		return custErr.ErrInvalidOrderTransition
+	}
+
+	if order.Status == domain.OrderShipped && stored.HasBackorders() {
+		return custErr.ErrOrderBackordered
+	}
+
+	stmt := `
+	UPDATE orders
+	SET order_status = $1, updated_at = now()
+	WHERE order_id = $2
+	RETURNING updated_at
+	`
+
+	err = tx.QueryRow(ctx, stmt, order.Status, stored.ID).Scan(&stored.UpdatedAt)
+	if err != nil {
+		log.Error("error while updating order status", zap.Error(err))
+		return err
+	}
+	stored.Status = order.Status
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	*order = *stored
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// CancelOrder отменяет заказ и возвращает на склад все еще не возвращенные товары.
+// Открытые предзаказы заказа отменяются, товар, который так и не поступил, на склад не возвращается.
+//
+// Если заказ не найден, то возвращает ErrOrderNotFound.
+//
+// Если заказ нельзя отменить из текущего состояния, то возвращает ErrInvalidOrderTransition.
+//
+// При успехе ret заполняется возвращенными строками, а ret.Order - актуальными данными заказа.
+func (db *Postgres) CancelOrder(ctx context.Context, ret *domain.OrderReturn) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.CancelOrder"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	order, err := getOrder(ctx, tx, ret.Order.ID.String(), true)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrOrderNotFound) {
+			log.Error("error while getting order", zap.Error(err))
+		}
+		return err
+	}
+
+	if !order.Status.CanTransitionTo(domain.OrderCancelled) {
+		return custErr.ErrInvalidOrderTransition
+	}
+
+	cancelled, err := cancelOrderBackorders(ctx, tx, order.ID)
+	if err != nil {
+		log.Error("error while cancelling order backorders", zap.Error(err))
+		return err
+	}
+
+	ret.Lines = order.CancelLines(cancelled)
+	for _, line := range ret.Lines {
+		line.Serials, err = getOrderSerials(ctx, tx, order.ID, line.Product.ID)
+		if err != nil {
+			log.Error("error while getting order serials", zap.Error(err))
+			return err
+		}
+	}
+
+	err = applyOrderReturn(ctx, tx, order, ret)
+	if err != nil {
+		log.Error("error while returning products", zap.Error(err))
+		return err
+	}
+
+	stmt := `
+	UPDATE orders
+	SET order_status = $1, updated_at = now()
+	WHERE order_id = $2
+	RETURNING updated_at
+	`
+
+	err = tx.QueryRow(ctx, stmt, domain.OrderCancelled, order.ID).Scan(&order.UpdatedAt)
+	if err != nil {
+		log.Error("error while updating order status", zap.Error(err))
+		return err
+	}
+	order.Status = domain.OrderCancelled
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	ret.Order = order
+
+	return nil
+}
+
+// ReturnOrderProducts возвращает часть товаров заказа на склад.
+//
+// Если заказ не найден, то возвращает ErrOrderNotFound.
+//
+// Если заказ не оплачен или отменен, то возвращает ErrOrderNotReturnable.
+//
+// Если какого-то продукта нет в заказе, то возвращает ErrProductNotInOrder.
+//
+// Если возвращается больше товара, чем осталось в заказе, то возвращает ErrReturnExceedsOrder.
+// Товар, который еще ожидает поступления по предзаказу, вернуть нельзя.
+func (db *Postgres) ReturnOrderProducts(ctx context.Context, ret *domain.OrderReturn) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.ReturnOrderProducts"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	order, err := getOrder(ctx, tx, ret.Order.ID.String(), true)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrOrderNotFound) {
+			log.Error("error while getting order", zap.Error(err))
+		}
+		return err
+	}
+
+	if !order.Status.Returnable() {
+		return custErr.ErrOrderNotReturnable
+	}
+
+	for _, line := range ret.Lines {
+		orderLine := order.Line(line.Product.ID)
+		if orderLine == nil {
+			return custErr.ErrProductNotInOrder
+		}
+
+		if !orderLine.CanReturn(line.ProductCount) {
+			return custErr.ErrReturnExceedsOrder
+		}
+
+		orderLine.PriceReturn(line)
+	}
+
+	err = applyOrderReturn(ctx, tx, order, ret)
+	if err != nil {
+		log.Error("error while returning products", zap.Error(err))
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	ret.Order = order
+
+	return nil
+}
+
+// applyOrderReturn записывает возврат и возвращает товары на склад заказа.
+//
+// Товары поступают в продажу или в карантин склада в зависимости от ret.Quarantine,
+// туда же возвращаются единицы серийных товаров.
+// Поступление в продажу записывается в журнал движения товаров.
+// Товар отмененных предзаказов на склад не поступал, поэтому на склад не возвращается.
+// Для аналитики в outbox записываются компенсирующие строки с отрицательным количеством,
+// чтобы продажи учитывались за вычетом возвратов.
+func applyOrderReturn(ctx context.Context, tx pgx.Tx, order *domain.Order, ret *domain.OrderReturn) error {
+	stmt := `
+	INSERT INTO order_return(order_id, return_reason, quarantine, request_id)
+	VALUES ($1, $2, $3, NULLIF($4, ''))
+	RETURNING return_id, created_at
+	`
+
+	err := tx.QueryRow(ctx, stmt, order.ID, ret.Reason, ret.Quarantine, middleware.GetRequestID(ctx)).
+		Scan(&ret.ID, &ret.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if len(ret.Lines) == 0 {
+		return nil
+	}
+
+	err = insertOrderReturnLines(ctx, tx, order.ID, ret)
+	if err != nil {
+		return err
+	}
+
+	err = returnSerials(ctx, tx, order, ret)
+	if err != nil {
+		return err
+	}
+
+	invs := order.ApplyReturn(ret.Lines)
+	restocked := ret.Restocked(invs)
+
+	err = restockProducts(ctx, tx, restocked, ret.Quarantine)
+	if err != nil {
+		return err
+	}
+
+	if !ret.Quarantine {
+		movements := make([]*domain.StockMovement, 0, len(restocked))
+		for _, inv := range restocked {
+			movements = append(movements, newStockMovement(ctx, inv, inv.ProductCount, domain.MovementReturn))
+		}
+
+		err = addStockMovements(ctx, tx, movements)
+		if err != nil {
+			return err
+		}
+	}
+
+	return addAnalyticsEvent(ctx, tx, analyticsEventReturn, domain.SaleCompensation(invs))
+}
+
+// insertOrderReturnLines записывает строки возврата и увеличивает количество возвращенного товара в заказе.
+func insertOrderReturnLines(ctx context.Context, tx pgx.Tx, orderID uuid.UUID, ret *domain.OrderReturn) error {
+	var (
+		cursor = 2
+		rows   []string
+		values = []any{ret.ID}
+	)
+
+	for _, line := range ret.Lines {
+		rows = append(rows, fmt.Sprintf("($1, $%d, $%d)", cursor, cursor+1))
+		values = append(values, line.Product.ID, line.ProductCount)
+		cursor += 2
+	}
+
+	_, err := tx.Exec(ctx, `INSERT INTO order_return_line(return_id, product_id, product_count) VALUES `+strings.Join(rows, ", "), values...)
+	if err != nil {
+		return err
+	}
+
+	stmt := `
+	UPDATE order_line
+	SET returned_count = returned_count + $1
+	WHERE order_id = $2 AND product_id = $3
+	`
+
+	for _, line := range ret.Lines {
+		_, err = tx.Exec(ctx, stmt, line.ProductCount, orderID, line.Product.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restockProducts возвращает товары на склад.
+// Если quarantine равен true, то товары попадают в карантин и недоступны для продажи,
+// иначе поступают в слои себестоимости.
+func restockProducts(ctx context.Context, tx pgx.Tx, invs []*domain.Inventory, quarantine bool) error {
+	column := "product_count"
+	if quarantine {
+		column = "quarantine_count"
+	}
+
+	stmt := fmt.Sprintf(`
+	UPDATE inventory
+	SET %[1]s = %[1]s + $1
+	WHERE warehouse_id = $2 AND product_id = $3
+	`, column)
+
+	for _, inv := range invs {
+		tag, err := tx.Exec(ctx, stmt, inv.ProductCount, inv.Warehouse.ID, inv.Product.ID)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() < 1 {
+			return custErr.ErrNotFoundProductAtWarehouse
+		}
+
+		if !quarantine {
+			err = addCostLayer(ctx, tx, inv)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// changeInventoryPrice изменяет цену и скидку товара на складе и записывает изменение в историю цен.
+//
+// Если price или sale равны nil, то соответствующее значение не меняется.
+// Прежние и новые значения записываются в change, идентификатор запроса берется из контекста.
+// Если ни цена, ни скидка не изменились, то запись в историю не добавляется.
+//
+// Если запись не найдена, то возвращает ErrInventoryNotFound.
+func changeInventoryPrice(ctx context.Context, q querier, change *domain.PriceChange, price *domain.Money, sale *int) error {
+	stmt := `
+	UPDATE inventory i
+	SET product_price = COALESCE($3, i.product_price), product_sale = COALESCE($4, i.product_sale)
+	FROM (
+		SELECT product_price, product_sale
+		FROM inventory
+		WHERE warehouse_id = $1 AND product_id = $2
+		FOR UPDATE
+	) old
+	WHERE i.warehouse_id = $1 AND i.product_id = $2
+	RETURNING old.product_price, COALESCE(old.product_sale, 0), i.product_price, COALESCE(i.product_sale, 0)
+	`
+
+	err := q.QueryRow(ctx, stmt, change.Warehouse.ID, change.Product.ID, price, sale).
+		Scan(&change.OldPrice, &change.OldSale, &change.NewPrice, &change.NewSale)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return custErr.ErrInventoryNotFound
+		}
+		return err
+	}
+
+	change.RequestID = middleware.GetRequestID(ctx)
+
+	if change.OldPrice == change.NewPrice && change.OldSale == change.NewSale {
+		return nil
+	}
+
+	stmt = `
+	INSERT INTO price_history(product_id, warehouse_id, old_price, new_price, old_sale, new_sale, changed_by, request_id)
+	VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), NULLIF($8, ''))
+	RETURNING history_id, changed_at
+	`
+
+	return q.QueryRow(ctx, stmt,
+		change.Product.ID,
+		change.Warehouse.ID,
+		change.OldPrice,
+		change.NewPrice,
+		change.OldSale,
+		change.NewSale,
+		change.ChangedBy,
+		change.RequestID,
+	).Scan(&change.ID, &change.ChangedAt)
+}
+
+// GetPriceHistory получает историю изменения цен товаров на складе.
+//
+// Записи отсортированы от новых к старым.
+func (db *Postgres) GetPriceHistory(ctx context.Context, filter *dto.PriceHistoryFilter) ([]*domain.PriceChange, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetPriceHistory"),
+	)
+
+	var (
+		conditions = []string{"warehouse_id = $1"}
+		args       = []any{filter.WarehouseID}
+	)
+
+	if filter.ProductID != "" {
+		args = append(args, filter.ProductID)
+		conditions = append(conditions, fmt.Sprintf("product_id = $%d", len(args)))
+	}
+
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		conditions = append(conditions, fmt.Sprintf("changed_at >= $%d", len(args)))
+	}
+
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		conditions = append(conditions, fmt.Sprintf("changed_at < $%d", len(args)))
+	}
+
+	args = append(args, filter.Pagination.Offset, filter.Pagination.Limit)
+	stmt := fmt.Sprintf(`
+	SELECT history_id, warehouse_id, product_id, old_price, new_price, old_sale, new_sale,
+		COALESCE(changed_by, ''), COALESCE(request_id, ''), changed_at
+	FROM price_history
+	WHERE %s
+	ORDER BY changed_at DESC, history_id
+	OFFSET $%d
+	LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args)-1, len(args))
+
+	rows, err := db.pool.Query(ctx, stmt, args...)
+	if err != nil {
+		log.Error("error while getting price history", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]*domain.PriceChange, 0)
+	for rows.Next() {
+		c := &domain.PriceChange{
+			Warehouse: &domain.Warehouse{},
+			Product:   &domain.Product{},
+		}
+
+		err = rows.Scan(
+			&c.ID,
+			&c.Warehouse.ID,
+			&c.Product.ID,
+			&c.OldPrice,
+			&c.NewPrice,
+			&c.OldSale,
+			&c.NewSale,
+			&c.ChangedBy,
+			&c.RequestID,
+			&c.ChangedAt,
+		)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			return nil, err
+		}
+
+		changes = append(changes, c)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,74 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeInventoryPrice(t *testing.T) {
+	historyID := uuid.New()
+	changedAt := time.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC)
+	price := domain.Money(12050)
+	sale := 5
+
+	newChange := func() *domain.PriceChange {
+		return &domain.PriceChange{
+			Product:   &domain.Product{ID: uuid.New()},
+			Warehouse: &domain.Warehouse{ID: uuid.New()},
+			ChangedBy: "manager",
+		}
+	}
+
+	t.Run("price changed", func(t *testing.T) {
+		q := &fakeQuerier{rows: []*fakeRow{
+			{values: []any{domain.Money(10000), 0, price, sale}},
+			{values: []any{historyID, changedAt}},
+		}}
+		change := newChange()
+
+		require.NoError(t, changeInventoryPrice(context.Background(), q, change, &price, &sale))
+
+		require.Equal(t, domain.Money(10000), change.OldPrice)
+		require.Equal(t, price, change.NewPrice)
+		require.Equal(t, 0, change.OldSale)
+		require.Equal(t, sale, change.NewSale)
+		require.Equal(t, historyID, change.ID)
+		require.Equal(t, changedAt, change.ChangedAt)
+
+		require.Len(t, q.queries, 2)
+		require.Equal(t, []any{
+			change.Product.ID, change.Warehouse.ID,
+			domain.Money(10000), price, 0, sale,
+			"manager", "",
+		}, q.queries[1].args)
+	})
+
+	t.Run("nothing changed", func(t *testing.T) {
+		q := &fakeQuerier{rows: []*fakeRow{
+			{values: []any{price, sale, price, sale}},
+		}}
+		change := newChange()
+
+		require.NoError(t, changeInventoryPrice(context.Background(), q, change, &price, &sale))
+
+		require.Len(t, q.queries, 1)
+		require.Equal(t, uuid.Nil, change.ID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		q := &fakeQuerier{rows: []*fakeRow{
+			{err: pgx.ErrNoRows},
+		}}
+
+		err := changeInventoryPrice(context.Background(), q, newChange(), &price, nil)
+		require.ErrorIs(t, err, custErr.ErrInventoryNotFound)
+		require.Len(t, q.queries, 1)
+	})
+}
@@ -0,0 +1,315 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// pricingRuleColumns - столбцы правила ценообразования в порядке, который ожидает scanPricingRule.
+const pricingRuleColumns = `rule_id, warehouse_id, rule_name, rule_type, rule_params, priority, active, created_at`
+
+// pricingRuleParams - условия правила ценообразования, сохраненные в rule_params.
+type pricingRuleParams struct {
+	ProductID  *uuid.UUID   `json:"product_id,omitempty"`
+	ProductIDs []uuid.UUID  `json:"product_ids,omitempty"`
+	MinCount   int          `json:"min_count,omitempty"`
+	UnitPrice  domain.Money `json:"unit_price,omitempty"`
+	Buy        int          `json:"buy,omitempty"`
+	Pay        int          `json:"pay,omitempty"`
+	Percent    int          `json:"percent,omitempty"`
+}
+
+// marshalPricingRuleParams записывает условия правила в JSON.
+func marshalPricingRuleParams(rule *domain.PricingRule) ([]byte, error) {
+	params := pricingRuleParams{
+		ProductIDs: rule.ProductIDs,
+		MinCount:   rule.MinCount,
+		UnitPrice:  rule.UnitPrice,
+		Buy:        rule.Buy,
+		Pay:        rule.Pay,
+		Percent:    rule.Percent,
+	}
+	if rule.ProductID != uuid.Nil {
+		params.ProductID = &rule.ProductID
+	}
+
+	return json.Marshal(params)
+}
+
+// CreatePricingRule создает правило ценообразования и заполняет его идентификатор и дату создания.
+//
+// Если склад не существует, то возвращает ErrForeignKey.
+func (db *Postgres) CreatePricingRule(ctx context.Context, rule *domain.PricingRule) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.CreatePricingRule"),
+	)
+
+	params, err := marshalPricingRuleParams(rule)
+	if err != nil {
+		log.Error("error while marshalling rule params", zap.Error(err))
+		return err
+	}
+
+	stmt := `
+	INSERT INTO pricing_rule(warehouse_id, rule_name, rule_type, rule_params, priority, active)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING rule_id, created_at
+	`
+
+	err = db.pool.QueryRow(ctx, stmt,
+		pricingRuleWarehouseID(rule),
+		rule.Name,
+		rule.Type,
+		params,
+		rule.Priority,
+		rule.Active,
+	).Scan(&rule.ID, &rule.CreatedAt)
+	if err != nil {
+		if isForeignKeyError(err) {
+			return custErr.ErrForeignKey
+		}
+		log.Error("error while creating pricing rule", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// pricingRuleWarehouseID возвращает склад правила или nil, если правило действует везде.
+func pricingRuleWarehouseID(rule *domain.PricingRule) *uuid.UUID {
+	if rule.Warehouse == nil {
+		return nil
+	}
+
+	return &rule.Warehouse.ID
+}
+
+// GetPricingRule получает правило ценообразования по его идентификатору.
+//
+// Если правило не найдено, то возвращает ErrPricingRuleNotFound.
+func (db *Postgres) GetPricingRule(ctx context.Context, ruleID string) (*domain.PricingRule, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetPricingRule"),
+	)
+
+	stmt := `SELECT ` + pricingRuleColumns + ` FROM pricing_rule WHERE rule_id = $1`
+
+	rule, err := scanPricingRule(db.pool.QueryRow(ctx, stmt, ruleID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, custErr.ErrPricingRuleNotFound
+		}
+		log.Error("error while getting pricing rule", zap.Error(err))
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetPricingRules получает правила ценообразования с пагинацией.
+//
+// Если в фильтре указан склад, то возвращаются правила этого склада и правила, действующие везде.
+func (db *Postgres) GetPricingRules(ctx context.Context, filter *dto.PricingRuleFilter) ([]*domain.PricingRule, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetPricingRules"),
+	)
+
+	var (
+		condition = "TRUE"
+		args      []any
+	)
+
+	if filter.WarehouseID != "" {
+		args = append(args, filter.WarehouseID)
+		condition = "(warehouse_id = $1 OR warehouse_id IS NULL)"
+	}
+
+	args = append(args, filter.Pagination.Offset, filter.Pagination.Limit)
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM pricing_rule
+	WHERE %s
+	ORDER BY priority DESC, created_at, rule_id
+	OFFSET $%d
+	LIMIT $%d
+	`, pricingRuleColumns, condition, len(args)-1, len(args))
+
+	rules, err := queryPricingRules(ctx, db.pool, stmt, args...)
+	if err != nil {
+		log.Error("error while getting pricing rules", zap.Error(err))
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// GetActivePricingRules получает включенные правила ценообразования склада и правила, действующие везде.
+func (db *Postgres) GetActivePricingRules(ctx context.Context, warehouseID string) ([]*domain.PricingRule, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetActivePricingRules"),
+	)
+
+	rules, err := getActivePricingRules(ctx, db.pool, warehouseID)
+	if err != nil {
+		log.Error("error while getting active pricing rules", zap.Error(err))
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// getActivePricingRules получает включенные правила ценообразования склада и правила, действующие везде.
+func getActivePricingRules(ctx context.Context, q querier, warehouseID string) ([]*domain.PricingRule, error) {
+	stmt := `
+	SELECT ` + pricingRuleColumns + `
+	FROM pricing_rule
+	WHERE active AND (warehouse_id = $1 OR warehouse_id IS NULL)
+	`
+
+	return queryPricingRules(ctx, q, stmt, warehouseID)
+}
+
+// queryPricingRules выполняет запрос и читает правила ценообразования.
+func queryPricingRules(ctx context.Context, q querier, stmt string, args ...any) ([]*domain.PricingRule, error) {
+	rows, err := q.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]*domain.PricingRule, 0)
+	for rows.Next() {
+		rule, err := scanPricingRule(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// scanPricingRule читает правило ценообразования из строки с порядком столбцов pricingRuleColumns.
+func scanPricingRule(row pgx.Row) (*domain.PricingRule, error) {
+	var (
+		warehouseID *uuid.UUID
+		ruleType    string
+		rawParams   []byte
+		params      pricingRuleParams
+	)
+
+	rule := &domain.PricingRule{}
+
+	err := row.Scan(
+		&rule.ID,
+		&warehouseID,
+		&rule.Name,
+		&ruleType,
+		&rawParams,
+		&rule.Priority,
+		&rule.Active,
+		&rule.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(rawParams, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Type = domain.PricingRuleType(ruleType)
+	if warehouseID != nil {
+		rule.Warehouse = &domain.Warehouse{ID: *warehouseID}
+	}
+	if params.ProductID != nil {
+		rule.ProductID = *params.ProductID
+	}
+	rule.ProductIDs = params.ProductIDs
+	rule.MinCount = params.MinCount
+	rule.UnitPrice = params.UnitPrice
+	rule.Buy = params.Buy
+	rule.Pay = params.Pay
+	rule.Percent = params.Percent
+
+	return rule, nil
+}
+
+// UpdatePricingRule заменяет условия правила ценообразования и заполняет его дату создания.
+//
+// Если правило не найдено, то возвращает ErrPricingRuleNotFound.
+//
+// Если склад не существует, то возвращает ErrForeignKey.
+func (db *Postgres) UpdatePricingRule(ctx context.Context, rule *domain.PricingRule) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.UpdatePricingRule"),
+	)
+
+	params, err := marshalPricingRuleParams(rule)
+	if err != nil {
+		log.Error("error while marshalling rule params", zap.Error(err))
+		return err
+	}
+
+	stmt := `
+	UPDATE pricing_rule
+	SET warehouse_id = $2, rule_name = $3, rule_type = $4, rule_params = $5, priority = $6, active = $7
+	WHERE rule_id = $1
+	RETURNING created_at
+	`
+
+	err = db.pool.QueryRow(ctx, stmt,
+		rule.ID,
+		pricingRuleWarehouseID(rule),
+		rule.Name,
+		rule.Type,
+		params,
+		rule.Priority,
+		rule.Active,
+	).Scan(&rule.CreatedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return custErr.ErrPricingRuleNotFound
+		case isForeignKeyError(err):
+			return custErr.ErrForeignKey
+		}
+		log.Error("error while updating pricing rule", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// DeletePricingRule удаляет правило ценообразования.
+//
+// Если правило не найдено, то возвращает ErrPricingRuleNotFound.
+func (db *Postgres) DeletePricingRule(ctx context.Context, ruleID string) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.DeletePricingRule"),
+	)
+
+	tag, err := db.pool.Exec(ctx, `DELETE FROM pricing_rule WHERE rule_id = $1`, ruleID)
+	if err != nil {
+		log.Error("error while deleting pricing rule", zap.Error(err))
+		return err
+	}
+
+	if tag.RowsAffected() < 1 {
+		return custErr.ErrPricingRuleNotFound
+	}
+
+	return nil
+}
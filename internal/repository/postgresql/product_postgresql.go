@@ -2,36 +2,113 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
 	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
 	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"go.uber.org/zap"
 )
 
-// GetProducts получает список продуктов из базы данных.
-func (db *Postgres) GetProducts(ctx context.Context) ([]*domain.Product, error) {
-	products := make([]*domain.Product, 0)
-	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.GetProduct"))
+// productOrder сопоставляет поле сортировки каталога с выражением SQL.
+var productOrder = map[string]string{
+	"name":   "product_name",
+	"weight": "product_weight",
+}
 
-	stmt := `
-	SELECT product_id, product_name, product_description, product_weight, product_params, product_barcode
+// likeEscaper экранирует спецсимволы шаблона LIKE.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// GetProducts получает страницу каталога продуктов, подходящих под фильтры,
+// и общее количество таких продуктов. Архивные продукты возвращаются только
+// при filter.Archived, и тогда только они.
+//
+// Параметры продукта сравниваются как строки, поэтому фильтр подходит и для
+// строковых, и для числовых значений.
+func (db *Postgres) GetProducts(ctx context.Context, filter *dto.ProductFilter) ([]*domain.Product, int, error) {
+	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.GetProducts"))
+
+	var (
+		conditions = []string{"archived_at IS NULL"}
+		args       []any
+	)
+
+	if filter.Archived {
+		conditions[0] = "archived_at IS NOT NULL"
+	}
+
+	if filter.Name != "" {
+		args = append(args, "%"+likeEscaper.Replace(filter.Name)+"%")
+		conditions = append(conditions, fmt.Sprintf("product_name ILIKE $%d", len(args)))
+	}
+
+	if filter.Search != "" {
+		args = append(args, filter.Search)
+		conditions = append(conditions, fmt.Sprintf(
+			"to_tsvector('simple', COALESCE(product_name, '') || ' ' || COALESCE(product_description, '')) @@ plainto_tsquery('simple', $%d)",
+			len(args),
+		))
+	}
+
+	keys := make([]string, 0, len(filter.Params))
+	for key := range filter.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		args = append(args, key, filter.Params[key])
+		conditions = append(conditions, fmt.Sprintf("product_params ->> $%d = $%d", len(args)-1, len(args)))
+	}
+
+	where := strings.Join(conditions, " AND ")
+
+	var total int
+	err := db.pool.QueryRow(ctx, "SELECT COUNT(*) FROM product WHERE "+where, args...).Scan(&total)
+	if err != nil {
+		log.Error("error while counting products", zap.Error(err))
+		return nil, 0, err
+	}
+
+	orderBy, ok := productOrder[filter.SortBy]
+	if !ok {
+		orderBy = productOrder["name"]
+	}
+
+	direction := "ASC"
+	if filter.Desc {
+		direction = "DESC"
+	}
+
+	args = append(args, filter.Pagination.Offset, filter.Pagination.Limit)
+	stmt := fmt.Sprintf(`
+	SELECT product_id, product_name, product_description, product_weight, product_params, product_barcode, product_serialized, archived_at
 	FROM product
-	`
+	WHERE %s
+	ORDER BY %s %s, product_id
+	OFFSET $%d
+	LIMIT $%d
+	`, where, orderBy, direction, len(args)-1, len(args))
 
-	rows, err := db.pool.Query(ctx, stmt)
+	rows, err := db.pool.Query(ctx, stmt, args...)
 	if err != nil {
-		log.Error("error while getting products from DB", zap.String("err", err.Error()))
-		return nil, err
+		log.Error("error while getting products from DB", zap.Error(err))
+		return nil, 0, err
 	}
+	defer rows.Close()
 
+	products := make([]*domain.Product, 0)
 	for rows.Next() {
 		var product domain.Product
-		err := rows.Scan(&product.ID, &product.Name, &product.Description, &product.Weight, &product.Params, &product.Barcode)
+		err := rows.Scan(&product.ID, &product.Name, &product.Description, &product.Weight, &product.Params, &product.Barcode, &product.Serialized, &product.ArchivedAt)
 		if err != nil {
 			log.Error("error while parsing product", zap.String("err", err.Error()))
 			continue
@@ -40,10 +117,10 @@ func (db *Postgres) GetProducts(ctx context.Context) ([]*domain.Product, error)
 	}
 	if rows.Err() != nil {
 		log.Error("error while getting rows", zap.String("err", rows.Err().Error()))
-		return nil, rows.Err()
+		return nil, 0, rows.Err()
 	}
 
-	return products, nil
+	return products, total, nil
 }
 
 // AddProduct добавляет новый продукт в базу данных.
@@ -51,11 +128,11 @@ func (db *Postgres) GetProducts(ctx context.Context) ([]*domain.Product, error)
 // Если продукт с таким именем уже существует, то возвращает ErrProductAlreadyExists.
 func (db *Postgres) AddProduct(ctx context.Context, p *domain.Product) error {
 	stmt := `
-	INSERT INTO product(product_name, product_description, product_weight, product_params, product_barcode)
-	VALUES ($1, $2, $3, $4, $5)
+	INSERT INTO product(product_name, product_description, product_weight, product_params, product_barcode, product_serialized)
+	VALUES ($1, $2, $3, $4, $5, $6)
 	`
 
-	tag, err := db.pool.Exec(ctx, stmt, p.Name, p.Description, p.Weight, p.Params, p.Barcode)
+	tag, err := db.pool.Exec(ctx, stmt, p.Name, p.Description, p.Weight, p.Params, p.Barcode, p.Serialized)
 	if err != nil {
 		pgError := new(pgconn.PgError)
 		if errors.As(err, &pgError) {
@@ -133,3 +210,161 @@ func (db *Postgres) UpdateProduct(ctx context.Context, product *domain.Product)
 
 	return nil
 }
+
+// ArchiveProduct переносит продукт в архив и заполняет product.ArchivedAt.
+//
+// Архивный продукт скрыт из каталога и корзин, но остается в заказах, аналитике и на складах.
+//
+// Если продукт не найден, то возвращает ErrProductNotFound.
+//
+// Если продукт уже в архиве, то возвращает ErrProductArchived.
+func (db *Postgres) ArchiveProduct(ctx context.Context, product *domain.Product) error {
+	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.ArchiveProduct"))
+
+	stmt := `
+	UPDATE product
+	SET archived_at = now()
+	WHERE product_id = $1 AND archived_at IS NULL
+	RETURNING archived_at
+	`
+
+	err := db.pool.QueryRow(ctx, stmt, product.ID).Scan(&product.ArchivedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return productArchiveError(ctx, db.pool, product.ID, custErr.ErrProductArchived)
+		}
+		log.Error("error while archiving product", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// RestoreProduct возвращает продукт из архива.
+//
+// Если продукт не найден, то возвращает ErrProductNotFound.
+//
+// Если продукт не в архиве, то возвращает ErrProductNotArchived.
+func (db *Postgres) RestoreProduct(ctx context.Context, product *domain.Product) error {
+	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.RestoreProduct"))
+
+	stmt := `
+	UPDATE product
+	SET archived_at = NULL
+	WHERE product_id = $1 AND archived_at IS NOT NULL
+	`
+
+	tag, err := db.pool.Exec(ctx, stmt, product.ID)
+	if err != nil {
+		log.Error("error while restoring product", zap.Error(err))
+		return err
+	}
+
+	if tag.RowsAffected() < 1 {
+		return productArchiveError(ctx, db.pool, product.ID, custErr.ErrProductNotArchived)
+	}
+	product.ArchivedAt = nil
+
+	return nil
+}
+
+// productArchiveError выясняет, почему состояние архива продукта не изменилось:
+// возвращает ErrProductNotFound, если продукта нет, иначе stateErr.
+func productArchiveError(ctx context.Context, q querier, productID uuid.UUID, stateErr error) error {
+	var exists bool
+	err := q.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM product WHERE product_id = $1)`, productID).Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return custErr.ErrProductNotFound
+	}
+
+	return stateErr
+}
+
+// DeleteProduct удаляет продукт вместе с его пустыми строками инвентаря.
+//
+// В product.Barcode записывается файл штрихкода, который больше не нужен. Если тот же
+// файл указан у другого продукта, то product.Barcode остается пустым.
+//
+// Если продукт не найден, то возвращает ErrProductNotFound.
+//
+// Если товар еще есть на каком-то складе, в том числе в карантине, то возвращает ErrProductHasStock.
+//
+// Если продукт есть в заказах, аналитике или другой истории, то возвращает ErrProductInUse:
+// такой продукт можно только отправить в архив.
+func (db *Postgres) DeleteProduct(ctx context.Context, product *domain.Product) error {
+	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.DeleteProduct"))
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var barcode sql.NullString
+	err = tx.QueryRow(ctx, `SELECT product_barcode FROM product WHERE product_id = $1 FOR UPDATE`, product.ID).Scan(&barcode)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return custErr.ErrProductNotFound
+		}
+		log.Error("error while getting product", zap.Error(err))
+		return err
+	}
+
+	stmt := `
+	SELECT EXISTS(
+		SELECT 1 FROM inventory
+		WHERE product_id = $1 AND (product_count > 0 OR quarantine_count > 0)
+	)
+	`
+
+	var hasStock bool
+	err = tx.QueryRow(ctx, stmt, product.ID).Scan(&hasStock)
+	if err != nil {
+		log.Error("error while checking product stock", zap.Error(err))
+		return err
+	}
+
+	if hasStock {
+		return custErr.ErrProductHasStock
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM inventory WHERE product_id = $1`, product.ID)
+	if err == nil {
+		_, err = tx.Exec(ctx, `DELETE FROM product WHERE product_id = $1`, product.ID)
+	}
+	if err != nil {
+		pgError := new(pgconn.PgError)
+		if errors.As(err, &pgError) && pgError.Code == "23503" {
+			return custErr.ErrProductInUse
+		}
+		log.Error("error while deleting product", zap.Error(err))
+		return err
+	}
+
+	product.Barcode = ""
+	if barcode.Valid && barcode.String != "" {
+		var shared bool
+		err = tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM product WHERE product_barcode = $1)`, barcode.String).Scan(&shared)
+		if err != nil {
+			log.Error("error while checking barcode usage", zap.Error(err))
+			return err
+		}
+
+		if !shared {
+			product.Barcode = barcode.String
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// promoCodeColumns - столбцы промокода в порядке, который ожидает scanPromoCode.
+const promoCodeColumns = `promo_code_id, promo_code, discount_type, discount_percent, discount_amount, min_total,
+	warehouse_id, usage_limit, used_count, expires_at, created_at`
+
+// CreatePromoCode создает промокод и заполняет его идентификатор и дату создания.
+//
+// Если промокод с таким кодом уже существует, то возвращает ErrPromoCodeAlreadyExists.
+//
+// Если склад не существует, то возвращает ErrForeignKey.
+func (db *Postgres) CreatePromoCode(ctx context.Context, promo *domain.PromoCode) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.CreatePromoCode"),
+	)
+
+	var percent, amount any = promo.Percent, nil
+	if promo.Type == domain.DiscountFixed {
+		percent, amount = nil, promo.Amount
+	}
+
+	var warehouseID *uuid.UUID
+	if promo.Warehouse != nil {
+		warehouseID = &promo.Warehouse.ID
+	}
+
+	stmt := `
+	INSERT INTO promo_code(promo_code, discount_type, discount_percent, discount_amount, min_total, warehouse_id, usage_limit, expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	RETURNING promo_code_id, created_at
+	`
+
+	err := db.pool.QueryRow(ctx, stmt,
+		promo.Code,
+		promo.Type,
+		percent,
+		amount,
+		promo.MinTotal,
+		warehouseID,
+		promo.UsageLimit,
+		promo.ExpiresAt,
+	).Scan(&promo.ID, &promo.CreatedAt)
+	if err != nil {
+		pgErr := new(pgconn.PgError)
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return custErr.ErrPromoCodeAlreadyExists
+			case "23503":
+				return custErr.ErrForeignKey
+			}
+		}
+		log.Error("error while creating promo code", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetPromoCode получает промокод по его идентификатору.
+//
+// Если промокод не найден, то возвращает ErrPromoCodeNotFound.
+func (db *Postgres) GetPromoCode(ctx context.Context, promoCodeID string) (*domain.PromoCode, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetPromoCode"),
+	)
+
+	stmt := `SELECT ` + promoCodeColumns + ` FROM promo_code WHERE promo_code_id = $1`
+
+	promo, err := scanPromoCode(db.pool.QueryRow(ctx, stmt, promoCodeID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, custErr.ErrPromoCodeNotFound
+		}
+		log.Error("error while getting promo code", zap.Error(err))
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+// GetPromoCodes получает промокоды с пагинацией.
+//
+// Если в фильтре указан склад, то возвращаются промокоды этого склада и промокоды, действующие везде.
+func (db *Postgres) GetPromoCodes(ctx context.Context, filter *dto.PromoCodeFilter) ([]*domain.PromoCode, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetPromoCodes"),
+	)
+
+	var (
+		condition = "TRUE"
+		args      []any
+	)
+
+	if filter.WarehouseID != "" {
+		args = append(args, filter.WarehouseID)
+		condition = "(warehouse_id = $1 OR warehouse_id IS NULL)"
+	}
+
+	args = append(args, filter.Pagination.Offset, filter.Pagination.Limit)
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM promo_code
+	WHERE %s
+	ORDER BY created_at DESC, promo_code_id
+	OFFSET $%d
+	LIMIT $%d
+	`, promoCodeColumns, condition, len(args)-1, len(args))
+
+	rows, err := db.pool.Query(ctx, stmt, args...)
+	if err != nil {
+		log.Error("error while getting promo codes", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	promos := make([]*domain.PromoCode, 0)
+	for rows.Next() {
+		promo, err := scanPromoCode(rows)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			continue
+		}
+
+		promos = append(promos, promo)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+
+	return promos, nil
+}
+
+// DeletePromoCode удаляет промокод. Заказы, оформленные с ним, сохраняют код и скидку.
+//
+// Если промокод не найден, то возвращает ErrPromoCodeNotFound.
+func (db *Postgres) DeletePromoCode(ctx context.Context, promoCodeID string) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.DeletePromoCode"),
+	)
+
+	tag, err := db.pool.Exec(ctx, `DELETE FROM promo_code WHERE promo_code_id = $1`, promoCodeID)
+	if err != nil {
+		log.Error("error while deleting promo code", zap.Error(err))
+		return err
+	}
+
+	if tag.RowsAffected() < 1 {
+		return custErr.ErrPromoCodeNotFound
+	}
+
+	return nil
+}
+
+// ApplyPromoCode проверяет промокод корзины и рассчитывает скидку по нему, не расходуя его.
+//
+// Цены товаров корзины должны быть заполнены.
+//
+// Если промокод не найден или не может быть применен к корзине, то возвращает
+// одну из ошибок промокода.
+func (db *Postgres) ApplyPromoCode(ctx context.Context, cart *domain.Cart) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.ApplyPromoCode"),
+	)
+
+	promo, err := getPromoCodeByCode(ctx, db.pool, cart.PromoCode.Code, false)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrPromoCodeNotFound) {
+			log.Error("error while getting promo code", zap.Error(err))
+		}
+		return err
+	}
+
+	return applyPromoCode(cart, promo, time.Now())
+}
+
+// redeemPromoCode применяет промокод корзины и учитывает его использование в рамках транзакции tx.
+//
+// Строка промокода блокируется до конца транзакции, поэтому параллельные покупки
+// не превысят лимит использований.
+func redeemPromoCode(ctx context.Context, tx pgx.Tx, cart *domain.Cart) error {
+	promo, err := getPromoCodeByCode(ctx, tx, cart.PromoCode.Code, true)
+	if err != nil {
+		return err
+	}
+
+	err = applyPromoCode(cart, promo, time.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE promo_code SET used_count = used_count + 1 WHERE promo_code_id = $1`, promo.ID)
+	if err != nil {
+		return err
+	}
+	promo.UsedCount++
+
+	return nil
+}
+
+// applyPromoCode проверяет, что промокод можно применить к корзине в момент at,
+// и записывает в корзину промокод и скидку по нему.
+func applyPromoCode(cart *domain.Cart, promo *domain.PromoCode, at time.Time) error {
+	total := cart.TotalWithDiscount()
+
+	switch {
+	case promo.Expired(at):
+		return custErr.ErrPromoCodeExpired
+	case promo.Exhausted():
+		return custErr.ErrPromoCodeExhausted
+	case !promo.AppliesTo(cart.Warehouse.ID):
+		return custErr.ErrPromoCodeNotApplicable
+	case total < promo.MinTotal:
+		return custErr.ErrPromoCodeMinTotal
+	}
+
+	cart.PromoCode = promo
+	cart.PromoDiscount = promo.Discount(total)
+
+	return nil
+}
+
+// getPromoCodeByCode получает промокод по коду без учета регистра.
+// Если forUpdate равен true, то строка промокода блокируется до конца транзакции.
+func getPromoCodeByCode(ctx context.Context, q querier, code string, forUpdate bool) (*domain.PromoCode, error) {
+	stmt := `SELECT ` + promoCodeColumns + ` FROM promo_code WHERE upper(promo_code) = upper($1)`
+	if forUpdate {
+		stmt += " FOR UPDATE"
+	}
+
+	promo, err := scanPromoCode(q.QueryRow(ctx, stmt, code))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, custErr.ErrPromoCodeNotFound
+		}
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+// scanPromoCode читает промокод из строки с порядком столбцов promoCodeColumns.
+func scanPromoCode(row pgx.Row) (*domain.PromoCode, error) {
+	var (
+		discountType string
+		percent      sql.NullInt64
+		warehouseID  *uuid.UUID
+		usageLimit   sql.NullInt64
+	)
+
+	promo := &domain.PromoCode{}
+
+	err := row.Scan(
+		&promo.ID,
+		&promo.Code,
+		&discountType,
+		&percent,
+		&promo.Amount,
+		&promo.MinTotal,
+		&warehouseID,
+		&usageLimit,
+		&promo.UsedCount,
+		&promo.ExpiresAt,
+		&promo.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	promo.Type = domain.DiscountType(discountType)
+	if percent.Valid {
+		promo.Percent = int(percent.Int64)
+	}
+	if warehouseID != nil {
+		promo.Warehouse = &domain.Warehouse{ID: *warehouseID}
+	}
+	if usageLimit.Valid {
+		limit := int(usageLimit.Int64)
+		promo.UsageLimit = &limit
+	}
+
+	return promo, nil
+}
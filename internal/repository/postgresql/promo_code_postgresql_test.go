@@ -0,0 +1,91 @@
+package postgresql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPromoCode(t *testing.T) {
+	now := time.Date(2025, time.July, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	limit := 3
+
+	warehouse := &domain.Warehouse{ID: uuid.New()}
+	other := &domain.Warehouse{ID: uuid.New()}
+
+	tests := []struct {
+		name         string
+		promo        *domain.PromoCode
+		wantErr      error
+		wantDiscount domain.Money
+	}{
+		{
+			name:         "percent promo",
+			promo:        &domain.PromoCode{Type: domain.DiscountPercent, Percent: 10, ExpiresAt: &future},
+			wantDiscount: 1800,
+		},
+		{
+			name:         "fixed promo on cart warehouse",
+			promo:        &domain.PromoCode{Type: domain.DiscountFixed, Amount: 5000, Warehouse: warehouse, MinTotal: 18000},
+			wantDiscount: 5000,
+		},
+		{
+			name:         "fixed promo not above total",
+			promo:        &domain.PromoCode{Type: domain.DiscountFixed, Amount: 50000},
+			wantDiscount: 18000,
+		},
+		{
+			name:    "expired",
+			promo:   &domain.PromoCode{Type: domain.DiscountPercent, Percent: 10, ExpiresAt: &past},
+			wantErr: custErr.ErrPromoCodeExpired,
+		},
+		{
+			name:    "expires right now",
+			promo:   &domain.PromoCode{Type: domain.DiscountPercent, Percent: 10, ExpiresAt: &now},
+			wantErr: custErr.ErrPromoCodeExpired,
+		},
+		{
+			name:    "usage limit reached",
+			promo:   &domain.PromoCode{Type: domain.DiscountPercent, Percent: 10, UsageLimit: &limit, UsedCount: 3},
+			wantErr: custErr.ErrPromoCodeExhausted,
+		},
+		{
+			name:    "other warehouse",
+			promo:   &domain.PromoCode{Type: domain.DiscountPercent, Percent: 10, Warehouse: other},
+			wantErr: custErr.ErrPromoCodeNotApplicable,
+		},
+		{
+			name:    "total below minimum",
+			promo:   &domain.PromoCode{Type: domain.DiscountPercent, Percent: 10, MinTotal: 18001},
+			wantErr: custErr.ErrPromoCodeMinTotal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cart := &domain.Cart{
+				Warehouse: warehouse,
+				Items: []*domain.Inventory{
+					{ProductCount: 2, ProductPrice: 10000, ProductSale: 10},
+				},
+			}
+
+			err := applyPromoCode(cart, tt.promo, now)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Zero(t, cart.PromoDiscount)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.promo, cart.PromoCode)
+			require.Equal(t, tt.wantDiscount, cart.PromoDiscount)
+		})
+	}
+}
@@ -0,0 +1,515 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// CreatePurchaseOrder создает заказ поставщику в статусе PurchaseOrderOrdered.
+//
+// Все товары заказа должны продаваться на складе, иначе возвращает ErrNotFoundProductAtWarehouse.
+//
+// Если поставщика или склада не существует, то возвращает ErrForeignKey.
+func (db *Postgres) CreatePurchaseOrder(ctx context.Context, order *domain.PurchaseOrder) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.CreatePurchaseOrder"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	stmt := `
+	INSERT INTO purchase_order(supplier_id, warehouse_id, purchase_order_status)
+	VALUES ($1, $2, $3)
+	RETURNING purchase_order_id, created_at, updated_at
+	`
+
+	err = tx.QueryRow(ctx, stmt, order.Supplier.ID, order.Warehouse.ID, domain.PurchaseOrderOrdered).
+		Scan(&order.ID, &order.CreatedAt, &order.UpdatedAt)
+	if err != nil {
+		if isForeignKeyError(err) {
+			return custErr.ErrForeignKey
+		}
+		log.Error("error while inserting purchase order", zap.Error(err))
+		return err
+	}
+	order.Status = domain.PurchaseOrderOrdered
+
+	productIDs := make([]uuid.UUID, 0, len(order.Lines))
+	for _, line := range order.Lines {
+		productIDs = append(productIDs, line.Product.ID)
+	}
+
+	var stocked int
+	err = tx.QueryRow(ctx, `SELECT count(*) FROM inventory WHERE warehouse_id = $1 AND product_id = ANY($2)`, order.Warehouse.ID, productIDs).
+		Scan(&stocked)
+	if err != nil {
+		log.Error("error while checking products at warehouse", zap.Error(err))
+		return err
+	}
+
+	if stocked != len(productIDs) {
+		return custErr.ErrNotFoundProductAtWarehouse
+	}
+
+	var (
+		cursor = 2
+		rows   []string
+		values = []any{order.ID}
+	)
+
+	for _, line := range order.Lines {
+		rows = append(rows, fmt.Sprintf("($1, $%d, $%d, $%d, $%d)", cursor, cursor+1, cursor+2, cursor+3))
+		values = append(values, line.Product.ID, line.OrderedCount, line.UnitCost, line.ExpectedAt)
+		cursor += 4
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO purchase_order_line(purchase_order_id, product_id, ordered_count, unit_cost, expected_at) VALUES `+strings.Join(rows, ", "), values...)
+	if err != nil {
+		log.Error("error while inserting purchase order lines", zap.Error(err))
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetPurchaseOrder получает заказ поставщику по его идентификатору.
+//
+// Если заказ не найден, то возвращает ErrPurchaseOrderNotFound.
+func (db *Postgres) GetPurchaseOrder(ctx context.Context, orderID string) (*domain.PurchaseOrder, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetPurchaseOrder"),
+	)
+
+	order, err := getPurchaseOrder(ctx, db.pool, orderID, false)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrPurchaseOrderNotFound) {
+			log.Error("error while getting purchase order", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// getPurchaseOrder получает заказ поставщику вместе с его строками.
+// Если forUpdate равен true, то строка заказа блокируется до конца транзакции.
+func getPurchaseOrder(ctx context.Context, q querier, orderID string, forUpdate bool) (*domain.PurchaseOrder, error) {
+	order := &domain.PurchaseOrder{
+		Supplier:  &domain.Supplier{},
+		Warehouse: &domain.Warehouse{},
+	}
+
+	stmt := `
+	SELECT purchase_order_id, supplier_id, warehouse_id, purchase_order_status, created_at, updated_at
+	FROM purchase_order
+	WHERE purchase_order_id = $1
+	`
+	if forUpdate {
+		stmt += " FOR UPDATE"
+	}
+
+	var status string
+	err := q.QueryRow(ctx, stmt, orderID).Scan(
+		&order.ID,
+		&order.Supplier.ID,
+		&order.Warehouse.ID,
+		&status,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, custErr.ErrPurchaseOrderNotFound
+		}
+		return nil, err
+	}
+	order.Status = domain.PurchaseOrderStatus(status)
+
+	err = getPurchaseOrderLines(ctx, q, []*domain.PurchaseOrder{order})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// getPurchaseOrderLines получает строки переданных заказов поставщикам.
+func getPurchaseOrderLines(ctx context.Context, q querier, orders []*domain.PurchaseOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(orders))
+	orderMap := make(map[uuid.UUID]*domain.PurchaseOrder, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+		orderMap[order.ID] = order
+	}
+
+	stmt := `
+	SELECT purchase_order_id, product_id, ordered_count, received_count, unit_cost, expected_at
+	FROM purchase_order_line
+	WHERE purchase_order_id = ANY($1)
+	ORDER BY purchase_order_id, product_id
+	`
+
+	rows, err := q.Query(ctx, stmt, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var orderID uuid.UUID
+		line := &domain.PurchaseOrderLine{
+			Product: &domain.Product{},
+		}
+
+		err = rows.Scan(&orderID, &line.Product.ID, &line.OrderedCount, &line.ReceivedCount, &line.UnitCost, &line.ExpectedAt)
+		if err != nil {
+			return err
+		}
+
+		if order, ok := orderMap[orderID]; ok {
+			order.Lines = append(order.Lines, line)
+		}
+	}
+
+	return rows.Err()
+}
+
+// GetPurchaseOrders получает заказы поставщикам с фильтрацией по поставщику, складу и статусу.
+//
+// Заказы отсортированы от новых к старым.
+func (db *Postgres) GetPurchaseOrders(ctx context.Context, filter *dto.PurchaseOrderFilter) ([]*domain.PurchaseOrder, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetPurchaseOrders"),
+	)
+
+	var (
+		conditions = []string{"TRUE"}
+		args       []any
+	)
+
+	if filter.SupplierID != "" {
+		args = append(args, filter.SupplierID)
+		conditions = append(conditions, fmt.Sprintf("supplier_id = $%d", len(args)))
+	}
+
+	if filter.WarehouseID != "" {
+		args = append(args, filter.WarehouseID)
+		conditions = append(conditions, fmt.Sprintf("warehouse_id = $%d", len(args)))
+	}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("purchase_order_status = $%d", len(args)))
+	}
+
+	args = append(args, filter.Pagination.Offset, filter.Pagination.Limit)
+	stmt := fmt.Sprintf(`
+	SELECT purchase_order_id, supplier_id, warehouse_id, purchase_order_status, created_at, updated_at
+	FROM purchase_order
+	WHERE %s
+	ORDER BY created_at DESC, purchase_order_id
+	OFFSET $%d
+	LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args)-1, len(args))
+
+	rows, err := db.pool.Query(ctx, stmt, args...)
+	if err != nil {
+		log.Error("error while getting purchase orders", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := make([]*domain.PurchaseOrder, 0)
+	for rows.Next() {
+		var status string
+		order := &domain.PurchaseOrder{
+			Supplier:  &domain.Supplier{},
+			Warehouse: &domain.Warehouse{},
+		}
+
+		err = rows.Scan(&order.ID, &order.Supplier.ID, &order.Warehouse.ID, &status, &order.CreatedAt, &order.UpdatedAt)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			continue
+		}
+		order.Status = domain.PurchaseOrderStatus(status)
+
+		orders = append(orders, order)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+	rows.Close()
+
+	err = getPurchaseOrderLines(ctx, db.pool, orders)
+	if err != nil {
+		log.Error("error while getting purchase order lines", zap.Error(err))
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// ReceivePurchaseOrder принимает товары по заказу поставщику на склад заказа.
+//
+// Количество товара на складе увеличивается, поступления записываются в журнал движения товаров
+// со ссылкой на заказ. Товары с номером партии принимаются партиями. Заказ переходит
+// в статус PurchaseOrderPartiallyReceived или PurchaseOrderReceived, если принят весь товар.
+// Принятый товар обеспечивает открытые предзаказы в порядке их создания.
+// Оповещения об остатках и об обеспеченных предзаказах записываются в outbox в той же транзакции.
+//
+// Если заказ не найден, то возвращает ErrPurchaseOrderNotFound.
+// Если заказ уже принят или отменен, то возвращает ErrPurchaseOrderNotOpen.
+// Если товара нет в заказе, то возвращает ErrProductNotInPurchaseOrder.
+// Если принимается больше, чем осталось принять, то возвращает ErrPurchaseOrderOverReceipt.
+func (db *Postgres) ReceivePurchaseOrder(ctx context.Context, receipt *domain.PurchaseOrderReceipt) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.ReceivePurchaseOrder"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	stored, err := getPurchaseOrder(ctx, tx, receipt.Order.ID.String(), true)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrPurchaseOrderNotFound) {
+			log.Error("error while getting purchase order", zap.Error(err))
+		}
+		return err
+	}
+
+	if !stored.Status.Open() {
+		return custErr.ErrPurchaseOrderNotOpen
+	}
+
+	lines := make(map[uuid.UUID]*domain.PurchaseOrderLine, len(stored.Lines))
+	for _, line := range stored.Lines {
+		lines[line.Product.ID] = line
+	}
+
+	var (
+		movements = make([]*domain.StockMovement, 0, len(receipt.Lines))
+		alerts    []*domain.StockAlert
+	)
+
+	for _, received := range receipt.Lines {
+		line, ok := lines[received.Product.ID]
+		if !ok {
+			return custErr.ErrProductNotInPurchaseOrder
+		}
+
+		if received.Count > line.Remaining() {
+			return custErr.ErrPurchaseOrderOverReceipt
+		}
+
+		inv := &domain.Inventory{
+			Product:      line.Product,
+			Warehouse:    stored.Warehouse,
+			ProductCount: received.Count,
+			Serials:      received.Serials,
+			UnitCost:     &line.UnitCost,
+		}
+
+		alert, err := receivePurchaseOrderLine(ctx, tx, stored, received, inv)
+		if err != nil {
+			if !isPurchaseOrderReceiptError(err) {
+				log.Error("error while receiving purchase order line", zap.Error(err))
+			}
+			return err
+		}
+
+		line.ReceivedCount += received.Count
+
+		movement := newStockMovement(ctx, inv, received.Count, domain.MovementReceipt)
+		movement.PurchaseOrderID = stored.ID
+		movements = append(movements, movement)
+
+		if alert != nil {
+			alerts = append(alerts, alert)
+		}
+	}
+
+	err = addStockMovements(ctx, tx, movements)
+	if err != nil {
+		log.Error("error while adding stock movements", zap.Error(err))
+		return err
+	}
+
+	for _, movement := range movements {
+		allocated, err := allocateBackorders(ctx, tx, db.valuation, &domain.Inventory{
+			Product:   movement.Product,
+			Warehouse: movement.Warehouse,
+		}, domain.MovementReceipt)
+		if err != nil {
+			log.Error("error while allocating backorders", zap.Error(err))
+			return err
+		}
+		alerts = append(alerts, allocated...)
+	}
+
+	stored.Status = stored.ReceivedStatus()
+	stmt := `
+	UPDATE purchase_order
+	SET purchase_order_status = $1, updated_at = now()
+	WHERE purchase_order_id = $2
+	RETURNING updated_at
+	`
+
+	err = tx.QueryRow(ctx, stmt, stored.Status, stored.ID).Scan(&stored.UpdatedAt)
+	if err != nil {
+		log.Error("error while updating purchase order status", zap.Error(err))
+		return err
+	}
+
+	err = addStockAlertEvents(ctx, tx, alerts)
+	if err != nil {
+		log.Error("error while adding stock alerts", zap.Error(err))
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	*receipt.Order = *stored
+
+	return nil
+}
+
+// isPurchaseOrderReceiptError сообщает, является ли ошибка ожидаемой ошибкой приемки товара.
+func isPurchaseOrderReceiptError(err error) bool {
+	return isSerialError(err) || custErr.Any(err, custErr.ErrBatchAlreadyExists, custErr.ErrInventoryNotFound)
+}
+
+// receivePurchaseOrderLine приходует товар строки заказа поставщику на склад
+// по закупочной цене строки.
+//
+// Возвращает оповещение, если после приемки остаток товара пересек минимальное количество.
+func receivePurchaseOrderLine(ctx context.Context, tx pgx.Tx, order *domain.PurchaseOrder, received *domain.PurchaseOrderReceiptLine, inv *domain.Inventory) (*domain.StockAlert, error) {
+	if received.LotNumber != "" {
+		err := insertBatch(ctx, tx, &domain.Batch{
+			Product:   inv.Product,
+			Warehouse: inv.Warehouse,
+			LotNumber: received.LotNumber,
+			Quantity:  received.Count,
+			ExpiresAt: received.ExpiresAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// используется пользовательская функция. код в миграции 000004
+	_, err := tx.Exec(ctx, `SELECT increase_product_count($1, $2, $3)`, inv.Product.ID, inv.Warehouse.ID, inv.ProductCount)
+	if err != nil {
+		pgErr := new(pgconn.PgError)
+		if errors.As(err, &pgErr) && pgErr.Code == "P0002" {
+			return nil, custErr.ErrInventoryNotFound
+		}
+		return nil, err
+	}
+
+	err = receiveSerials(ctx, tx, inv)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addCostLayer(ctx, tx, inv)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := `
+	UPDATE purchase_order_line
+	SET received_count = received_count + $3
+	WHERE purchase_order_id = $1 AND product_id = $2
+	`
+
+	_, err = tx.Exec(ctx, stmt, order.ID, inv.Product.ID, inv.ProductCount)
+	if err != nil {
+		return nil, err
+	}
+
+	return getStockAlert(ctx, tx, inv, inv.ProductCount, domain.MovementReceipt)
+}
+
+// CancelPurchaseOrder отменяет заказ поставщику. Уже принятый товар остается на складе.
+//
+// Если заказ не найден, то возвращает ErrPurchaseOrderNotFound.
+// Если заказ уже принят или отменен, то возвращает ErrPurchaseOrderNotOpen.
+func (db *Postgres) CancelPurchaseOrder(ctx context.Context, order *domain.PurchaseOrder) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.CancelPurchaseOrder"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	stored, err := getPurchaseOrder(ctx, tx, order.ID.String(), true)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrPurchaseOrderNotFound) {
+			log.Error("error while getting purchase order", zap.Error(err))
+		}
+		return err
+	}
+
+	if !stored.Status.Open() {
+		return custErr.ErrPurchaseOrderNotOpen
+	}
+
+	stmt := `
+	UPDATE purchase_order
+	SET purchase_order_status = $1, updated_at = now()
+	WHERE purchase_order_id = $2
+	RETURNING updated_at
+	`
+
+	err = tx.QueryRow(ctx, stmt, domain.PurchaseOrderCancelled, stored.ID).Scan(&stored.UpdatedAt)
+	if err != nil {
+		log.Error("error while cancelling purchase order", zap.Error(err))
+		return err
+	}
+	stored.Status = domain.PurchaseOrderCancelled
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	*order = *stored
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeRow возвращает заранее заданные значения или ошибку при сканировании.
+type fakeRow struct {
+	values []any
+	err    error
+}
+
+func (r *fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	if len(dest) != len(r.values) {
+		return errors.New("fakeRow: wrong number of scan destinations")
+	}
+
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(r.values[i]))
+	}
+
+	return nil
+}
+
+// fakeRows возвращает заранее заданные строки результата запроса.
+type fakeRows struct {
+	pgx.Rows
+	rows []*fakeRow
+	row  *fakeRow
+}
+
+func (r *fakeRows) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+
+	r.row, r.rows = r.rows[0], r.rows[1:]
+
+	return true
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	return r.row.Scan(dest...)
+}
+
+func (r *fakeRows) Err() error {
+	return nil
+}
+
+func (r *fakeRows) Close() {}
+
+// fakeQuery хранит аргументы одного запроса к fakeQuerier.
+type fakeQuery struct {
+	sql  string
+	args []any
+}
+
+// fakeQuerier подменяет соединение с базой: запоминает запросы,
+// отвечает на QueryRow заранее заданными строками, а на Query - наборами строк по порядку.
+type fakeQuerier struct {
+	rows    []*fakeRow
+	results [][]*fakeRow
+	queries []fakeQuery
+}
+
+func (q *fakeQuerier) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	q.queries = append(q.queries, fakeQuery{sql: sql, args: args})
+	return pgconn.CommandTag{}, nil
+}
+
+func (q *fakeQuerier) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	q.queries = append(q.queries, fakeQuery{sql: sql, args: args})
+	if len(q.results) == 0 {
+		return nil, errors.New("fakeQuerier: unexpected query")
+	}
+
+	rows := &fakeRows{rows: q.results[0]}
+	q.results = q.results[1:]
+
+	return rows, nil
+}
+
+func (q *fakeQuerier) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	q.queries = append(q.queries, fakeQuery{sql: sql, args: args})
+	if len(q.rows) == 0 {
+		return &fakeRow{err: errors.New("fakeQuerier: unexpected query")}
+	}
+
+	row := q.rows[0]
+	q.rows = q.rows[1:]
+
+	return row
+}
+
+// execs возвращает аргументы запросов Exec, текст которых содержит sql.
+func (q *fakeQuerier) execs(sql string) [][]any {
+	var args [][]any
+	for _, query := range q.queries {
+		if strings.Contains(query.sql, sql) {
+			args = append(args, query.args)
+		}
+	}
+
+	return args
+}
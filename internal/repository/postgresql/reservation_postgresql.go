@@ -0,0 +1,282 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// CreateReservation удерживает товары на складе до истечения срока резерва.
+//
+// Если свободного (не зарезервированного) количества не хватает, то возвращает ErrNotEnoughProductCount.
+//
+// Если каких-то продуктов нет на складе, то возвращает ErrNotFoundProductAtWarehouse.
+func (db *Postgres) CreateReservation(ctx context.Context, reservation *domain.Reservation) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.CreateReservation"),
+	)
+
+	if len(reservation.Items) == 0 {
+		return nil
+	}
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	warehouseID := reservation.Warehouse.ID.String()
+	products := inventoryProductIDs(reservation.Items)
+
+	available, err := getAvailableCounts(ctx, tx, warehouseID, products)
+	if err != nil {
+		log.Error("error while getting available product count", zap.Error(err))
+		return err
+	}
+
+	for _, inv := range reservation.Items {
+		count, ok := available[inv.Product.ID.String()]
+		if !ok {
+			return custErr.ErrNotFoundProductAtWarehouse
+		}
+		if count < inv.ProductCount {
+			return custErr.ErrNotEnoughProductCount
+		}
+	}
+
+	stmt := `
+	INSERT INTO reservation(warehouse_id, reservation_status, expires_at)
+	VALUES ($1, $2, $3)
+	RETURNING reservation_id, created_at
+	`
+
+	err = tx.QueryRow(ctx, stmt, warehouseID, domain.ReservationActive, reservation.ExpiresAt).
+		Scan(&reservation.ID, &reservation.CreatedAt)
+	if err != nil {
+		log.Error("error while inserting reservation", zap.Error(err))
+		return err
+	}
+	reservation.Status = domain.ReservationActive
+
+	var (
+		cursor = 2
+		rows   []string
+		values = []any{reservation.ID}
+	)
+
+	for _, inv := range reservation.Items {
+		rows = append(rows, fmt.Sprintf("($1, $%d, $%d)", cursor, cursor+1))
+		values = append(values, inv.Product.ID, inv.ProductCount)
+		cursor += 2
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO reservation_product(reservation_id, product_id, product_count) VALUES `+strings.Join(rows, ", "), values...)
+	if err != nil {
+		log.Error("error while inserting reservation products", zap.Error(err))
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ReleaseExpiredReservations освобождает все просроченные резервы и возвращает их количество.
+func (db *Postgres) ReleaseExpiredReservations(ctx context.Context) (int, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.ReleaseExpiredReservations"),
+	)
+
+	stmt := `
+	UPDATE reservation
+	SET reservation_status = $1
+	WHERE reservation_status = $2 AND expires_at <= now()
+	`
+
+	tag, err := db.pool.Exec(ctx, stmt, domain.ReservationReleased, domain.ReservationActive)
+	if err != nil {
+		log.Error("error while releasing expired reservations", zap.Error(err))
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// consumeReservation отмечает резерв корзины как использованный при покупке.
+//
+// Если резерв не найден, уже использован или просрочен, то возвращает ErrReservationNotFound.
+//
+// Если резерв не покрывает товары корзины, то возвращает ErrReservationMismatch.
+func consumeReservation(ctx context.Context, tx pgx.Tx, cart *domain.Cart) error {
+	stmt := `
+	SELECT reservation_id
+	FROM reservation
+	WHERE reservation_id = $1 AND warehouse_id = $2 AND reservation_status = $3 AND expires_at > now()
+	FOR UPDATE
+	`
+
+	reservation := &domain.Reservation{Warehouse: cart.Warehouse}
+	err := tx.QueryRow(ctx, stmt, cart.ReservationID, cart.Warehouse.ID, domain.ReservationActive).Scan(&reservation.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return custErr.ErrReservationNotFound
+		}
+		return err
+	}
+
+	reservation.Items, err = getReservationItems(ctx, tx, reservation.ID)
+	if err != nil {
+		return err
+	}
+
+	if !reservation.Covers(cart.Items) {
+		return custErr.ErrReservationMismatch
+	}
+
+	stmt = `UPDATE reservation SET reservation_status = $1 WHERE reservation_id = $2`
+
+	_, err = tx.Exec(ctx, stmt, domain.ReservationConsumed, reservation.ID)
+
+	return err
+}
+
+// getReservationItems получает зарезервированные продукты и их количество.
+func getReservationItems(ctx context.Context, tx pgx.Tx, reservationID uuid.UUID) ([]*domain.Inventory, error) {
+	stmt := `
+	SELECT product_id, product_count
+	FROM reservation_product
+	WHERE reservation_id = $1
+	`
+
+	rows, err := tx.Query(ctx, stmt, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*domain.Inventory, 0)
+	for rows.Next() {
+		inv := &domain.Inventory{Product: &domain.Product{}}
+		err = rows.Scan(&inv.Product.ID, &inv.ProductCount)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, inv)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return items, nil
+}
+
+// getAvailableCounts блокирует строки инвентаря и возвращает свободное количество продуктов на складе.
+//
+// Свободное количество - это остаток за вычетом активных резервов.
+func getAvailableCounts(ctx context.Context, tx pgx.Tx, warehouseID string, products []string) (map[string]int, error) {
+	stmt := `
+	SELECT product_id, COALESCE(product_count, 0)
+	FROM inventory
+	WHERE warehouse_id = $1 AND product_id = ANY($2)
+	FOR UPDATE
+	`
+
+	rows, err := tx.Query(ctx, stmt, warehouseID, products)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[string]int, len(products))
+	for rows.Next() {
+		var (
+			productID string
+			count     int
+		)
+
+		err = rows.Scan(&productID, &count)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		available[productID] = count
+	}
+	rows.Close()
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	reserved, err := getReservedCounts(ctx, tx, warehouseID, products)
+	if err != nil {
+		return nil, err
+	}
+
+	for productID, count := range reserved {
+		if _, ok := available[productID]; ok {
+			available[productID] -= count
+		}
+	}
+
+	return available, nil
+}
+
+// getReservedCounts возвращает количество продуктов, удерживаемых активными резервами на складе.
+func getReservedCounts(ctx context.Context, q querier, warehouseID string, products []string) (map[string]int, error) {
+	stmt := `
+	SELECT rp.product_id, SUM(rp.product_count)
+	FROM reservation r
+	JOIN reservation_product rp USING (reservation_id)
+	WHERE r.warehouse_id = $1
+		AND rp.product_id = ANY($2)
+		AND r.reservation_status = $3
+		AND r.expires_at > now()
+	GROUP BY rp.product_id
+	`
+
+	rows, err := q.Query(ctx, stmt, warehouseID, products, domain.ReservationActive)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reserved := make(map[string]int)
+	for rows.Next() {
+		var (
+			productID string
+			count     int
+		)
+
+		err = rows.Scan(&productID, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		reserved[productID] = count
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return reserved, nil
+}
+
+// inventoryProductIDs возвращает идентификаторы продуктов из списка инвентаря.
+func inventoryProductIDs(invs []*domain.Inventory) []string {
+	products := make([]string, 0, len(invs))
+	for _, inv := range invs {
+		products = append(products, inv.Product.ID.String())
+	}
+
+	return products
+}
@@ -0,0 +1,325 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// isSerializedProduct сообщает, учитывается ли товар по серийным номерам.
+//
+// Если товар не найден, то возвращает ErrProductNotFound.
+func isSerializedProduct(ctx context.Context, q querier, productID uuid.UUID) (bool, error) {
+	var serialized bool
+
+	err := q.QueryRow(ctx, `SELECT product_serialized FROM product WHERE product_id = $1`, productID).Scan(&serialized)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, custErr.ErrProductNotFound
+		}
+		return false, err
+	}
+
+	return serialized, nil
+}
+
+// isSerialError сообщает, что err - ошибка учета серийных номеров, вызванная данными запроса.
+func isSerialError(err error) bool {
+	return custErr.Any(err,
+		custErr.ErrSerialsRequired,
+		custErr.ErrProductNotSerialized,
+		custErr.ErrSerialAlreadyExists,
+		custErr.ErrSerialNotInOrder,
+	)
+}
+
+// receiveSerials записывает серийные номера inv.Serials единиц товара, поступивших на склад.
+//
+// Для серийного товара количество номеров должно совпадать с inv.ProductCount,
+// иначе возвращает ErrSerialsRequired. Для обычного товара номера не принимаются
+// и возвращается ErrProductNotSerialized.
+//
+// Если номер уже есть у этого товара, то возвращает ErrSerialAlreadyExists.
+func receiveSerials(ctx context.Context, tx pgx.Tx, inv *domain.Inventory) error {
+	serialized, err := isSerializedProduct(ctx, tx, inv.Product.ID)
+	if err != nil {
+		return err
+	}
+
+	if !serialized {
+		if len(inv.Serials) > 0 {
+			return custErr.ErrProductNotSerialized
+		}
+		return nil
+	}
+
+	if len(inv.Serials) != inv.ProductCount {
+		return custErr.ErrSerialsRequired
+	}
+
+	if len(inv.Serials) == 0 {
+		return nil
+	}
+
+	stmt := `
+	INSERT INTO product_serial(product_id, warehouse_id, serial_number)
+	SELECT $1, $2, unnest($3::varchar[])
+	`
+
+	_, err = tx.Exec(ctx, stmt, inv.Product.ID, inv.Warehouse.ID, inv.Serials)
+	if err != nil {
+		pgErr := new(pgconn.PgError)
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return custErr.ErrSerialAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// takeSerials переводит count единиц товара inv со склада в состояние status
+// и возвращает их серийные номера.
+//
+// Единицы выдаются в порядке поступления. Для обычного товара возвращает nil.
+// Если на складе меньше единиц с номерами, чем count, то возвращает ErrNotEnoughSerials.
+//
+// set задает дополнительные поля, которые меняются вместе с состоянием, args - их значения,
+// нумерация параметров в set начинается с $5.
+func takeSerials(ctx context.Context, tx pgx.Tx, inv *domain.Inventory, status domain.SerialStatus, set string, args ...any) ([]string, error) {
+	serialized, err := isSerializedProduct(ctx, tx, inv.Product.ID)
+	if err != nil || !serialized {
+		return nil, err
+	}
+
+	stmt := `
+	UPDATE product_serial
+	SET serial_status = $4, updated_at = now()` + set + `
+	WHERE serial_id IN (
+		SELECT serial_id
+		FROM product_serial
+		WHERE warehouse_id = $1 AND product_id = $2 AND serial_status = 'in_stock'
+		ORDER BY received_at, serial_number
+		LIMIT $3
+		FOR UPDATE
+	)
+	RETURNING serial_number
+	`
+
+	rows, err := tx.Query(ctx, stmt, append([]any{inv.Warehouse.ID, inv.Product.ID, inv.ProductCount, status}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	serials, err := scanSerialNumbers(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(serials) != inv.ProductCount {
+		return nil, custErr.ErrNotEnoughSerials
+	}
+
+	return serials, nil
+}
+
+// sellSerials отмечает проданными единицы серийных товаров корзины и записывает
+// их номера в cart.Items. Заказ корзины уже должен быть создан.
+func sellSerials(ctx context.Context, tx pgx.Tx, cart *domain.Cart) error {
+	for _, inv := range cart.Items {
+		serials, err := takeSerials(ctx, tx, inv, domain.SerialSold, ", order_id = $5", cart.OrderID)
+		if err != nil {
+			return err
+		}
+		inv.Serials = serials
+	}
+
+	return nil
+}
+
+// dispatchSerials отправляет единицы серийных товаров перемещения на склад-получатель.
+// Перемещение уже должно быть создано.
+func dispatchSerials(ctx context.Context, tx pgx.Tx, transfer *domain.Transfer) error {
+	for _, inv := range transferInventories(transfer.Source, transfer.Products) {
+		_, err := takeSerials(ctx, tx, inv, domain.SerialInTransit,
+			", warehouse_id = $5, transfer_id = $6", transfer.Destination.ID, transfer.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// receiveTransferSerials приходует на складе-получателе единицы серийных товаров перемещения.
+func receiveTransferSerials(ctx context.Context, tx pgx.Tx, transfer *domain.Transfer) error {
+	stmt := `
+	UPDATE product_serial
+	SET serial_status = $1, updated_at = now()
+	WHERE transfer_id = $2 AND serial_status = $3
+	`
+
+	_, err := tx.Exec(ctx, stmt, domain.SerialInStock, transfer.ID, domain.SerialInTransit)
+
+	return err
+}
+
+// getOrderSerials возвращает номера проданных в заказе единиц товара, которые еще не возвращены.
+func getOrderSerials(ctx context.Context, tx pgx.Tx, orderID, productID uuid.UUID) ([]string, error) {
+	stmt := `
+	SELECT serial_number
+	FROM product_serial
+	WHERE order_id = $1 AND product_id = $2 AND serial_status = $3
+	ORDER BY serial_number
+	`
+
+	rows, err := tx.Query(ctx, stmt, orderID, productID, domain.SerialSold)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanSerialNumbers(rows)
+}
+
+// scanSerialNumbers сканирует серийные номера из результата запроса и закрывает rows.
+func scanSerialNumbers(rows pgx.Rows) ([]string, error) {
+	defer rows.Close()
+
+	serials := make([]string, 0)
+	for rows.Next() {
+		var serial string
+		if err := rows.Scan(&serial); err != nil {
+			return nil, err
+		}
+		serials = append(serials, serial)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return serials, nil
+}
+
+// returnSerials возвращает на склад заказа единицы серийных товаров из строк возврата.
+//
+// Если товар в заказе продавался с номерами, то в строке возврата должны быть указаны
+// номера всех возвращаемых единиц, иначе возвращает ErrSerialsRequired.
+// Если номер не был продан в этом заказе, то возвращает ErrSerialNotInOrder.
+func returnSerials(ctx context.Context, tx pgx.Tx, order *domain.Order, ret *domain.OrderReturn) error {
+	status := domain.SerialInStock
+	if ret.Quarantine {
+		status = domain.SerialQuarantine
+	}
+
+	stmt := `
+	UPDATE product_serial
+	SET serial_status = $1, order_id = NULL, updated_at = now()
+	WHERE order_id = $2 AND product_id = $3 AND serial_status = $4 AND serial_number = ANY($5)
+	`
+
+	for _, line := range ret.Lines {
+		if len(line.Serials) == 0 {
+			sold, err := getOrderSerials(ctx, tx, order.ID, line.Product.ID)
+			if err != nil {
+				return err
+			}
+			if len(sold) > 0 {
+				return custErr.ErrSerialsRequired
+			}
+			continue
+		}
+
+		if len(line.Serials) != line.ProductCount {
+			return custErr.ErrSerialsRequired
+		}
+
+		tag, err := tx.Exec(ctx, stmt, status, order.ID, line.Product.ID, domain.SerialSold, line.Serials)
+		if err != nil {
+			return err
+		}
+
+		if int(tag.RowsAffected()) != len(line.Serials) {
+			return custErr.ErrSerialNotInOrder
+		}
+	}
+
+	return nil
+}
+
+// GetSerials получает единицы товаров с серийным номером serialNumber.
+//
+// Номер уникален в пределах товара, поэтому у разных товаров может быть несколько единиц с одним номером.
+// Если единиц не найдено, то возвращает ErrSerialNotFound.
+func (db *Postgres) GetSerials(ctx context.Context, serialNumber string) ([]*domain.ProductSerial, error) {
+	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.GetSerials"))
+
+	stmt := `
+	SELECT s.serial_id, s.serial_number, s.product_id, p.product_name, s.warehouse_id, s.serial_status,
+		s.order_id, s.transfer_id, s.received_at, s.updated_at
+	FROM product_serial s
+	JOIN product p ON p.product_id = s.product_id
+	WHERE s.serial_number = $1
+	ORDER BY p.product_name
+	`
+
+	rows, err := db.pool.Query(ctx, stmt, serialNumber)
+	if err != nil {
+		log.Error("error while getting serials", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	serials := make([]*domain.ProductSerial, 0)
+	for rows.Next() {
+		var (
+			s          = &domain.ProductSerial{Product: &domain.Product{}, Warehouse: &domain.Warehouse{}}
+			orderID    *uuid.UUID
+			transferID *uuid.UUID
+		)
+
+		err = rows.Scan(
+			&s.ID,
+			&s.SerialNumber,
+			&s.Product.ID,
+			&s.Product.Name,
+			&s.Warehouse.ID,
+			&s.Status,
+			&orderID,
+			&transferID,
+			&s.ReceivedAt,
+			&s.UpdatedAt,
+		)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			return nil, err
+		}
+
+		if orderID != nil {
+			s.OrderID = *orderID
+		}
+		if transferID != nil {
+			s.TransferID = *transferID
+		}
+
+		serials = append(serials, s)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+
+	if len(serials) == 0 {
+		return nil, custErr.ErrSerialNotFound
+	}
+
+	return serials, nil
+}
@@ -0,0 +1,221 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// stockAlertOutboxItem - оповещение об остатке, сохраненное в outbox.
+//
+// Поля предзаказа заданы только для оповещения об обеспеченном предзаказе.
+type stockAlertOutboxItem struct {
+	Kind            domain.StockAlertKind `json:"kind"`
+	WarehouseID     uuid.UUID             `json:"warehouse_id"`
+	ProductID       uuid.UUID             `json:"product_id"`
+	ProductCount    int                   `json:"product_count"`
+	MinQuantity     int                   `json:"min_quantity"`
+	ReorderQuantity int                   `json:"reorder_quantity"`
+	Reason          domain.MovementReason `json:"reason"`
+	BackorderID     uuid.UUID             `json:"backorder_id"`
+	OrderID         uuid.UUID             `json:"order_id"`
+	BackorderCount  int                   `json:"backorder_count,omitempty"`
+	CreatedAt       time.Time             `json:"created_at"`
+}
+
+// newStockAlertOutboxItem преобразует оповещение для записи в outbox.
+func newStockAlertOutboxItem(alert *domain.StockAlert) *stockAlertOutboxItem {
+	item := &stockAlertOutboxItem{
+		Kind:            alert.Kind,
+		WarehouseID:     alert.Warehouse.ID,
+		ProductID:       alert.Product.ID,
+		ProductCount:    alert.ProductCount,
+		MinQuantity:     alert.MinQuantity,
+		ReorderQuantity: alert.ReorderQuantity,
+		Reason:          alert.Reason,
+		CreatedAt:       alert.CreatedAt,
+	}
+
+	if alert.Backorder != nil {
+		item.BackorderID = alert.Backorder.ID
+		item.OrderID = alert.Backorder.OrderID
+		item.BackorderCount = alert.Backorder.Count
+	}
+
+	return item
+}
+
+// stockAlert восстанавливает оповещение из outbox.
+func (item *stockAlertOutboxItem) stockAlert() *domain.StockAlert {
+	alert := &domain.StockAlert{
+		Kind:            item.Kind,
+		Warehouse:       &domain.Warehouse{ID: item.WarehouseID},
+		Product:         &domain.Product{ID: item.ProductID},
+		ProductCount:    item.ProductCount,
+		MinQuantity:     item.MinQuantity,
+		ReorderQuantity: item.ReorderQuantity,
+		Reason:          item.Reason,
+		CreatedAt:       item.CreatedAt,
+	}
+
+	if item.BackorderID != uuid.Nil {
+		alert.Backorder = &domain.Backorder{
+			ID:        item.BackorderID,
+			OrderID:   item.OrderID,
+			Warehouse: alert.Warehouse,
+			Product:   alert.Product,
+			Count:     item.BackorderCount,
+		}
+	}
+
+	return alert
+}
+
+// addStockAlertEvents записывает оповещения об остатках в outbox в рамках транзакции q.
+//
+// Оповещения доставляются диспетчером после фиксации транзакции, поэтому доставка
+// не задерживает запрос, а оповещение не теряется, если получатель недоступен.
+func addStockAlertEvents(ctx context.Context, q querier, alerts []*domain.StockAlert) error {
+	for _, alert := range alerts {
+		payload, err := json.Marshal(newStockAlertOutboxItem(alert))
+		if err != nil {
+			return err
+		}
+
+		_, err = q.Exec(ctx, `INSERT INTO stock_alert_outbox(alert_payload) VALUES ($1)`, payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stockAlertOutboxEvent - оповещение outbox, ожидающее доставки.
+type stockAlertOutboxEvent struct {
+	ID      uuid.UUID
+	Payload []byte
+}
+
+// DispatchStockAlerts доставляет до batchSize оповещений из outbox через send.
+//
+// Оповещения блокируются через SKIP LOCKED, поэтому несколько диспетчеров не доставят
+// одно оповещение дважды. Доставленное оповещение удаляется из outbox. Если оповещение
+// доставить не удалось, то оно откладывается на retryDelay, умноженное на число попыток.
+//
+// Возвращает количество доставленных оповещений.
+func (db *Postgres) DispatchStockAlerts(ctx context.Context, batchSize int, retryDelay time.Duration, send func(context.Context, *domain.StockAlert) error) (int, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.DispatchStockAlerts"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	events, err := getStockAlertEvents(ctx, tx, batchSize)
+	if err != nil {
+		log.Error("error while getting stock alerts", zap.Error(err))
+		return 0, err
+	}
+
+	dispatched, err := dispatchStockAlertEvents(ctx, tx, events, retryDelay, send)
+	if err != nil {
+		log.Error("error while dispatching stock alerts", zap.Error(err))
+		return 0, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return 0, err
+	}
+
+	return dispatched, nil
+}
+
+// getStockAlertEvents получает и блокирует оповещения, готовые к доставке.
+func getStockAlertEvents(ctx context.Context, tx pgx.Tx, limit int) ([]*stockAlertOutboxEvent, error) {
+	stmt := `
+	SELECT alert_id, alert_payload
+	FROM stock_alert_outbox
+	WHERE available_at <= now()
+	ORDER BY created_at
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := tx.Query(ctx, stmt, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*stockAlertOutboxEvent
+	for rows.Next() {
+		event := &stockAlertOutboxEvent{}
+
+		err = rows.Scan(&event.ID, &event.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// dispatchStockAlertEvents доставляет оповещения events через send в рамках транзакции q:
+// доставленные удаляет из outbox, а недоставленные откладывает на retryDelay, умноженное на число попыток.
+//
+// Возвращает количество доставленных оповещений.
+func dispatchStockAlertEvents(ctx context.Context, q querier, events []*stockAlertOutboxEvent, retryDelay time.Duration, send func(context.Context, *domain.StockAlert) error) (int, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.dispatchStockAlertEvents"),
+	)
+
+	stmt := `
+	UPDATE stock_alert_outbox
+	SET attempts = attempts + 1,
+		last_error = $2,
+		available_at = now() + make_interval(secs => $3::float8 * (attempts + 1))
+	WHERE alert_id = $1
+	`
+
+	dispatched := 0
+	for _, event := range events {
+		var item stockAlertOutboxItem
+		err := json.Unmarshal(event.Payload, &item)
+		if err == nil {
+			err = send(ctx, item.stockAlert())
+		}
+		if err != nil {
+			log.Warn("error while sending stock alert", zap.String("alert-id", event.ID.String()), zap.Error(err))
+
+			_, err = q.Exec(ctx, stmt, event.ID, err.Error(), retryDelay.Seconds())
+			if err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		_, err = q.Exec(ctx, `DELETE FROM stock_alert_outbox WHERE alert_id = $1`, event.ID)
+		if err != nil {
+			return 0, err
+		}
+
+		dispatched++
+	}
+
+	return dispatched, nil
+}
@@ -0,0 +1,63 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchStockAlertEvents(t *testing.T) {
+	logger.CreateNOPLogger()
+
+	createdAt := time.Date(2025, time.July, 10, 12, 0, 0, 0, time.UTC)
+	warehouse := &domain.Warehouse{ID: uuid.New()}
+
+	failed := &domain.StockAlert{
+		Kind:      domain.StockAlertLow,
+		Warehouse: warehouse,
+		Product:   &domain.Product{ID: uuid.New()},
+		Reason:    domain.MovementSale,
+		CreatedAt: createdAt,
+	}
+	product := &domain.Product{ID: uuid.New()}
+	delivered := &domain.StockAlert{
+		Kind:      domain.StockAlertBackorderFulfilled,
+		Warehouse: warehouse,
+		Product:   product,
+		Reason:    domain.MovementReceipt,
+		Backorder: &domain.Backorder{ID: uuid.New(), OrderID: uuid.New(), Warehouse: warehouse, Product: product, Count: 2},
+		CreatedAt: createdAt,
+	}
+
+	var events []*stockAlertOutboxEvent
+	for _, alert := range []*domain.StockAlert{failed, delivered} {
+		payload, err := json.Marshal(newStockAlertOutboxItem(alert))
+		require.NoError(t, err)
+		events = append(events, &stockAlertOutboxEvent{ID: uuid.New(), Payload: payload})
+	}
+
+	var sent []*domain.StockAlert
+	send := func(_ context.Context, alert *domain.StockAlert) error {
+		sent = append(sent, alert)
+		if alert.Product.ID == failed.Product.ID {
+			return errors.New("webhook is down")
+		}
+		return nil
+	}
+
+	q := &fakeQuerier{}
+	dispatched, err := dispatchStockAlertEvents(context.Background(), q, events, time.Minute, send)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, dispatched)
+	require.Equal(t, []*domain.StockAlert{failed, delivered}, sent)
+	require.Equal(t, [][]any{{events[0].ID, "webhook is down", 60.0}}, q.execs("UPDATE stock_alert_outbox"))
+	require.Equal(t, [][]any{{events[1].ID}}, q.execs("DELETE FROM stock_alert_outbox"))
+}
@@ -0,0 +1,158 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// newStockMovement создает запись журнала для изменения количества товара на складе.
+//
+// Идентификатор запроса берется из контекста.
+func newStockMovement(ctx context.Context, inv *domain.Inventory, delta int, reason domain.MovementReason) *domain.StockMovement {
+	return &domain.StockMovement{
+		Warehouse: inv.Warehouse,
+		Product:   inv.Product,
+		Delta:     delta,
+		Reason:    reason,
+		RequestID: middleware.GetRequestID(ctx),
+	}
+}
+
+// addStockMovements записывает движения товаров в журнал в рамках переданной транзакции.
+func addStockMovements(ctx context.Context, tx pgx.Tx, movements []*domain.StockMovement) error {
+	if len(movements) == 0 {
+		return nil
+	}
+
+	stmt, values := getAddStockMovementsStatement(movements)
+
+	tag, err := tx.Exec(ctx, stmt, values...)
+	if err != nil {
+		return err
+	}
+
+	if int(tag.RowsAffected()) != len(movements) {
+		return fmt.Errorf("not all stock movements were written")
+	}
+
+	return nil
+}
+
+// getAddStockMovementsStatement формирует SQL-запрос для добавления движений товаров в журнал.
+func getAddStockMovementsStatement(movements []*domain.StockMovement) (string, []any) {
+	var (
+		cursor = 1
+		rows   []string
+		values []any
+	)
+
+	query := `INSERT INTO stock_movement(warehouse_id, product_id, movement_delta, movement_reason, request_id, stocktake_id, purchase_order_id) VALUES `
+
+	for _, m := range movements {
+		var stocktakeID, purchaseOrderID *uuid.UUID
+		if m.StocktakeID != uuid.Nil {
+			stocktakeID = &m.StocktakeID
+		}
+		if m.PurchaseOrderID != uuid.Nil {
+			purchaseOrderID = &m.PurchaseOrderID
+		}
+
+		row := fmt.Sprintf("($%d, $%d, $%d, $%d, NULLIF($%d, ''), $%d, $%d)", cursor, cursor+1, cursor+2, cursor+3, cursor+4, cursor+5, cursor+6)
+		rows = append(rows, row)
+		values = append(values, m.Warehouse.ID.String(), m.Product.ID.String(), m.Delta, string(m.Reason), m.RequestID, stocktakeID, purchaseOrderID)
+
+		cursor += 7
+	}
+
+	return query + strings.Join(rows, ", "), values
+}
+
+// GetStockMovements получает записи журнала движения товаров на складе.
+//
+// Записи отсортированы от новых к старым.
+func (db *Postgres) GetStockMovements(ctx context.Context, filter *dto.StockMovementFilter) ([]*domain.StockMovement, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetStockMovements"),
+	)
+
+	var (
+		conditions = []string{"warehouse_id = $1"}
+		args       = []any{filter.WarehouseID}
+	)
+
+	if filter.ProductID != "" {
+		args = append(args, filter.ProductID)
+		conditions = append(conditions, fmt.Sprintf("product_id = $%d", len(args)))
+	}
+
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+
+	args = append(args, filter.Pagination.Offset, filter.Pagination.Limit)
+	stmt := fmt.Sprintf(`
+	SELECT movement_id, warehouse_id, product_id, movement_delta, movement_reason, COALESCE(request_id, ''),
+		stocktake_id, purchase_order_id, created_at
+	FROM stock_movement
+	WHERE %s
+	ORDER BY created_at DESC, movement_id
+	OFFSET $%d
+	LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args)-1, len(args))
+
+	rows, err := db.pool.Query(ctx, stmt, args...)
+	if err != nil {
+		log.Error("error while getting stock movements", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	movements := make([]*domain.StockMovement, 0)
+	for rows.Next() {
+		var (
+			reason                       string
+			stocktakeID, purchaseOrderID *uuid.UUID
+		)
+		m := &domain.StockMovement{
+			Warehouse: &domain.Warehouse{},
+			Product:   &domain.Product{},
+		}
+
+		err = rows.Scan(&m.ID, &m.Warehouse.ID, &m.Product.ID, &m.Delta, &reason, &m.RequestID, &stocktakeID, &purchaseOrderID, &m.CreatedAt)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			continue
+		}
+		m.Reason = domain.MovementReason(reason)
+		if stocktakeID != nil {
+			m.StocktakeID = *stocktakeID
+		}
+		if purchaseOrderID != nil {
+			m.PurchaseOrderID = *purchaseOrderID
+		}
+
+		movements = append(movements, m)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+
+	return movements, nil
+}
@@ -0,0 +1,547 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// CreateStocktake открывает пересчет товаров на складе.
+//
+// Если в stocktake.Lines указаны товары, то пересчитываются только они, иначе все товары склада.
+// Текущий остаток каждого товара запоминается как ожидаемое количество.
+// При успехе stocktake заполняется данными открытого пересчета.
+//
+// Если какого-то товара нет на складе, то возвращает ErrNotFoundProductAtWarehouse.
+//
+// Если на складе нет товаров, то возвращает ErrStocktakeEmpty.
+func (db *Postgres) CreateStocktake(ctx context.Context, stocktake *domain.Stocktake) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.CreateStocktake"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var stocktakeID uuid.UUID
+	err = tx.QueryRow(ctx, `INSERT INTO stocktake(warehouse_id) VALUES ($1) RETURNING stocktake_id`, stocktake.Warehouse.ID).
+		Scan(&stocktakeID)
+	if err != nil {
+		if isForeignKeyError(err) {
+			return custErr.ErrForeignKey
+		}
+		log.Error("error while inserting stocktake", zap.Error(err))
+		return err
+	}
+
+	stmt := `
+	INSERT INTO stocktake_line(stocktake_id, product_id, expected_count)
+	SELECT $1, product_id, product_count
+	FROM inventory
+	WHERE warehouse_id = $2
+	`
+	args := []any{stocktakeID, stocktake.Warehouse.ID}
+
+	if len(stocktake.Lines) > 0 {
+		products := make([]uuid.UUID, 0, len(stocktake.Lines))
+		for _, line := range stocktake.Lines {
+			products = append(products, line.Product.ID)
+		}
+
+		stmt += " AND product_id = ANY($3)"
+		args = append(args, products)
+	}
+
+	tag, err := tx.Exec(ctx, stmt, args...)
+	if err != nil {
+		log.Error("error while inserting stocktake lines", zap.Error(err))
+		return err
+	}
+
+	switch {
+	case tag.RowsAffected() == 0 && len(stocktake.Lines) == 0:
+		return custErr.ErrStocktakeEmpty
+	case int(tag.RowsAffected()) != len(stocktake.Lines) && len(stocktake.Lines) > 0:
+		return custErr.ErrNotFoundProductAtWarehouse
+	}
+
+	stored, err := getStocktake(ctx, tx, stocktakeID.String(), false)
+	if err != nil {
+		log.Error("error while getting stocktake", zap.Error(err))
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	*stocktake = *stored
+
+	return nil
+}
+
+// GetStocktake получает пересчет по его идентификатору вместе с текущими остатками товаров.
+//
+// Если пересчет не найден, то возвращает ErrStocktakeNotFound.
+func (db *Postgres) GetStocktake(ctx context.Context, stocktakeID string) (*domain.Stocktake, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetStocktake"),
+	)
+
+	stocktake, err := getStocktake(ctx, db.pool, stocktakeID, false)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrStocktakeNotFound) {
+			log.Error("error while getting stocktake", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return stocktake, nil
+}
+
+// getStocktake получает пересчет и его строки.
+// Если forUpdate равен true, то пересчет блокируется до конца транзакции.
+//
+// Если пересчет не найден, то возвращает ErrStocktakeNotFound.
+func getStocktake(ctx context.Context, q querier, stocktakeID string, forUpdate bool) (*domain.Stocktake, error) {
+	stocktake := &domain.Stocktake{
+		Warehouse: &domain.Warehouse{},
+	}
+
+	stmt := `
+	SELECT stocktake_id, warehouse_id, stocktake_status, created_at, closed_at
+	FROM stocktake
+	WHERE stocktake_id = $1
+	`
+	if forUpdate {
+		stmt += " FOR UPDATE"
+	}
+
+	var status string
+	err := q.QueryRow(ctx, stmt, stocktakeID).Scan(
+		&stocktake.ID,
+		&stocktake.Warehouse.ID,
+		&status,
+		&stocktake.CreatedAt,
+		&stocktake.ClosedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, custErr.ErrStocktakeNotFound
+		}
+		return nil, err
+	}
+	stocktake.Status = domain.StocktakeStatus(status)
+
+	stmt = `
+	SELECT l.product_id, p.product_name, p.product_serialized, l.expected_count,
+		COALESCE(i.product_count, 0), i.product_price, l.counted_count, l.counted_at, l.posted_delta
+	FROM stocktake_line l
+	JOIN product p ON p.product_id = l.product_id
+	LEFT JOIN inventory i ON i.warehouse_id = $2 AND i.product_id = l.product_id
+	WHERE l.stocktake_id = $1
+	ORDER BY p.product_name, l.product_id
+	`
+
+	rows, err := q.Query(ctx, stmt, stocktakeID, stocktake.Warehouse.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		line := &domain.StocktakeLine{
+			Product: &domain.Product{},
+		}
+
+		err = rows.Scan(
+			&line.Product.ID,
+			&line.Product.Name,
+			&line.Product.Serialized,
+			&line.ExpectedCount,
+			&line.SystemCount,
+			&line.ProductPrice,
+			&line.CountedCount,
+			&line.CountedAt,
+			&line.PostedDelta,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		stocktake.Lines = append(stocktake.Lines, line)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return stocktake, nil
+}
+
+// SubmitStocktakeCounts записывает посчитанное количество товаров из stocktake.Lines.
+//
+// Повторная запись для товара заменяет прежнее количество.
+// При успехе stocktake заполняется актуальными данными пересчета.
+//
+// Если пересчет не найден, то возвращает ErrStocktakeNotFound.
+//
+// Если пересчет уже проведен или отменен, то возвращает ErrStocktakeNotOpen.
+//
+// Если товара нет в пересчете, то возвращает ErrProductNotInStocktake.
+func (db *Postgres) SubmitStocktakeCounts(ctx context.Context, stocktake *domain.Stocktake) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.SubmitStocktakeCounts"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	stored, err := getStocktake(ctx, tx, stocktake.ID.String(), true)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrStocktakeNotFound) {
+			log.Error("error while getting stocktake", zap.Error(err))
+		}
+		return err
+	}
+
+	if stored.Status != domain.StocktakeOpen {
+		return custErr.ErrStocktakeNotOpen
+	}
+
+	stmt := `
+	UPDATE stocktake_line
+	SET counted_count = $1, counted_at = now()
+	WHERE stocktake_id = $2 AND product_id = $3
+	`
+
+	for _, line := range stocktake.Lines {
+		tag, err := tx.Exec(ctx, stmt, line.CountedCount, stocktake.ID, line.Product.ID)
+		if err != nil {
+			log.Error("error while updating stocktake line", zap.Error(err))
+			return err
+		}
+
+		if tag.RowsAffected() < 1 {
+			return custErr.ErrProductNotInStocktake
+		}
+	}
+
+	stored, err = getStocktake(ctx, tx, stocktake.ID.String(), false)
+	if err != nil {
+		log.Error("error while getting stocktake", zap.Error(err))
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	*stocktake = *stored
+
+	return nil
+}
+
+// PostStocktake проводит расхождения пересчета и закрывает его.
+//
+// Проводятся строки товаров productIDs, а если они не указаны, то все посчитанные строки.
+// Остаток каждого товара становится равным посчитанному количеству, расхождение с остатком
+// на момент проведения записывается в журнал движения как исправление со ссылкой на пересчет.
+// Недостача списывается с партий в порядке истечения срока годности.
+// Все изменения выполняются в одной транзакции.
+//
+// Оповещения о товарах, остаток которых пересек минимальное количество,
+// записываются в outbox в той же транзакции. При успехе stocktake заполняется актуальными данными пересчета.
+//
+// Если пересчет не найден, то возвращает ErrStocktakeNotFound.
+//
+// Если пересчет уже проведен или отменен, то возвращает ErrStocktakeNotOpen.
+//
+// Если товара нет в пересчете, то возвращает ErrProductNotInStocktake,
+// а если товар еще не посчитан - ErrStocktakeLineNotCounted.
+//
+// Если есть расхождение по серийному товару, то возвращает ErrStocktakeSerialized.
+func (db *Postgres) PostStocktake(ctx context.Context, stocktake *domain.Stocktake, productIDs []uuid.UUID) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.PostStocktake"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	stored, err := getStocktake(ctx, tx, stocktake.ID.String(), true)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrStocktakeNotFound) {
+			log.Error("error while getting stocktake", zap.Error(err))
+		}
+		return err
+	}
+
+	if stored.Status != domain.StocktakeOpen {
+		return custErr.ErrStocktakeNotOpen
+	}
+
+	lines, err := approvedStocktakeLines(stored, productIDs)
+	if err != nil {
+		return err
+	}
+
+	var (
+		alerts    []*domain.StockAlert
+		movements = make([]*domain.StockMovement, 0, len(lines))
+	)
+
+	for _, line := range lines {
+		inv := &domain.Inventory{
+			Product:   line.Product,
+			Warehouse: stored.Warehouse,
+		}
+
+		delta, alert, err := postStocktakeLine(ctx, tx, db.valuation, stored, line, inv)
+		if err != nil {
+			if !custErr.Any(err, custErr.ErrStocktakeSerialized, custErr.ErrInventoryNotFound) {
+				log.Error("error while posting stocktake line", zap.Error(err))
+			}
+			return err
+		}
+
+		if alert != nil {
+			alerts = append(alerts, alert)
+		}
+
+		if delta != 0 {
+			movement := newStockMovement(ctx, inv, delta, domain.MovementCorrection)
+			movement.StocktakeID = stored.ID
+			movements = append(movements, movement)
+		}
+	}
+
+	err = addStockMovements(ctx, tx, movements)
+	if err != nil {
+		log.Error("error while adding stock movements", zap.Error(err))
+		return err
+	}
+
+	stmt := `
+	UPDATE stocktake
+	SET stocktake_status = $1, closed_at = now()
+	WHERE stocktake_id = $2
+	`
+
+	_, err = tx.Exec(ctx, stmt, domain.StocktakePosted, stored.ID)
+	if err != nil {
+		log.Error("error while closing stocktake", zap.Error(err))
+		return err
+	}
+
+	stored, err = getStocktake(ctx, tx, stocktake.ID.String(), false)
+	if err != nil {
+		log.Error("error while getting stocktake", zap.Error(err))
+		return err
+	}
+
+	err = addStockAlertEvents(ctx, tx, alerts)
+	if err != nil {
+		log.Error("error while adding stock alerts", zap.Error(err))
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	*stocktake = *stored
+
+	return nil
+}
+
+// approvedStocktakeLines возвращает строки пересчета, которые нужно провести.
+//
+// Если productIDs пуст, то возвращаются все посчитанные строки.
+func approvedStocktakeLines(stocktake *domain.Stocktake, productIDs []uuid.UUID) ([]*domain.StocktakeLine, error) {
+	lineMap := make(map[uuid.UUID]*domain.StocktakeLine, len(stocktake.Lines))
+	for _, line := range stocktake.Lines {
+		lineMap[line.Product.ID] = line
+	}
+
+	if len(productIDs) == 0 {
+		lines := make([]*domain.StocktakeLine, 0, len(stocktake.Lines))
+		for _, line := range stocktake.Lines {
+			if line.CountedCount != nil {
+				lines = append(lines, line)
+			}
+		}
+
+		return lines, nil
+	}
+
+	lines := make([]*domain.StocktakeLine, 0, len(productIDs))
+	for _, productID := range productIDs {
+		line, ok := lineMap[productID]
+		if !ok {
+			return nil, custErr.ErrProductNotInStocktake
+		}
+
+		if line.CountedCount == nil {
+			return nil, custErr.ErrStocktakeLineNotCounted
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// postStocktakeLine устанавливает остаток товара равным посчитанному количеству
+// и записывает проведенное исправление в строку пересчета.
+//
+// Недостача списывается со слоев себестоимости методом method, излишек поступает
+// по средней себестоимости остатка.
+//
+// Возвращает исправление остатка и оповещение, если остаток пересек минимальное количество.
+// inv заполняется остатком и порогами товара после исправления.
+func postStocktakeLine(ctx context.Context, tx pgx.Tx, method domain.ValuationMethod, stocktake *domain.Stocktake, line *domain.StocktakeLine, inv *domain.Inventory) (int, *domain.StockAlert, error) {
+	stmt := `
+	UPDATE inventory i
+	SET product_count = $3
+	FROM (
+		SELECT product_count
+		FROM inventory
+		WHERE warehouse_id = $1 AND product_id = $2
+		FOR UPDATE
+	) old
+	WHERE i.warehouse_id = $1 AND i.product_id = $2
+	RETURNING old.product_count, i.product_count, i.min_quantity, i.reorder_quantity
+	`
+
+	var before int
+	err := tx.QueryRow(ctx, stmt, stocktake.Warehouse.ID, line.Product.ID, *line.CountedCount).
+		Scan(&before, &inv.ProductCount, &inv.MinQuantity, &inv.ReorderQuantity)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil, custErr.ErrInventoryNotFound
+		}
+		return 0, nil, err
+	}
+
+	delta := inv.ProductCount - before
+	if delta != 0 && line.Product.Serialized {
+		return 0, nil, custErr.ErrStocktakeSerialized
+	}
+
+	if delta < 0 {
+		err = consumeBatches(ctx, tx, stocktake.Warehouse.ID.String(), line.Product.ID.String(), -delta)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		inv.Cost, err = consumeCostLayers(ctx, tx, method, stocktake.Warehouse.ID.String(), line.Product.ID.String(), before, -delta)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	if delta > 0 {
+		err = addCostLayer(ctx, tx, &domain.Inventory{
+			Product:      inv.Product,
+			Warehouse:    inv.Warehouse,
+			ProductCount: delta,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	stmt = `
+	UPDATE stocktake_line
+	SET posted_delta = $1
+	WHERE stocktake_id = $2 AND product_id = $3
+	`
+
+	_, err = tx.Exec(ctx, stmt, delta, stocktake.ID, line.Product.ID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return delta, inv.StockAlert(before, domain.MovementCorrection), nil
+}
+
+// CancelStocktake отменяет пересчет без изменения остатков.
+//
+// При успехе stocktake заполняется актуальными данными пересчета.
+//
+// Если пересчет не найден, то возвращает ErrStocktakeNotFound.
+//
+// Если пересчет уже проведен или отменен, то возвращает ErrStocktakeNotOpen.
+func (db *Postgres) CancelStocktake(ctx context.Context, stocktake *domain.Stocktake) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.CancelStocktake"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	stored, err := getStocktake(ctx, tx, stocktake.ID.String(), true)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrStocktakeNotFound) {
+			log.Error("error while getting stocktake", zap.Error(err))
+		}
+		return err
+	}
+
+	if stored.Status != domain.StocktakeOpen {
+		return custErr.ErrStocktakeNotOpen
+	}
+
+	stmt := `
+	UPDATE stocktake
+	SET stocktake_status = $1, closed_at = now()
+	WHERE stocktake_id = $2
+	RETURNING closed_at
+	`
+
+	err = tx.QueryRow(ctx, stmt, domain.StocktakeCancelled, stored.ID).Scan(&stored.ClosedAt)
+	if err != nil {
+		log.Error("error while cancelling stocktake", zap.Error(err))
+		return err
+	}
+	stored.Status = domain.StocktakeCancelled
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	*stocktake = *stored
+
+	return nil
+}
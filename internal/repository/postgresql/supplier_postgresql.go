@@ -0,0 +1,74 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// GetSuppliers получает список поставщиков, отсортированный по названию.
+func (db *Postgres) GetSuppliers(ctx context.Context) ([]*domain.Supplier, error) {
+	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.GetSuppliers"))
+
+	stmt := `
+	SELECT supplier_id, supplier_name, COALESCE(supplier_email, ''), COALESCE(supplier_phone, ''), created_at
+	FROM supplier
+	ORDER BY supplier_name
+	`
+
+	rows, err := db.pool.Query(ctx, stmt)
+	if err != nil {
+		log.Error("error while getting suppliers", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	suppliers := make([]*domain.Supplier, 0)
+	for rows.Next() {
+		supplier := &domain.Supplier{}
+		err = rows.Scan(&supplier.ID, &supplier.Name, &supplier.Email, &supplier.Phone, &supplier.CreatedAt)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			continue
+		}
+		suppliers = append(suppliers, supplier)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+
+	return suppliers, nil
+}
+
+// CreateSupplier создает нового поставщика.
+//
+// Если поставщик с таким названием уже существует, то возвращает ErrSupplierAlreadyExists.
+func (db *Postgres) CreateSupplier(ctx context.Context, supplier *domain.Supplier) error {
+	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.CreateSupplier"))
+
+	stmt := `
+	INSERT INTO supplier(supplier_name, supplier_email, supplier_phone)
+	VALUES ($1, NULLIF($2, ''), NULLIF($3, ''))
+	RETURNING supplier_id, created_at
+	`
+
+	err := db.pool.QueryRow(ctx, stmt, supplier.Name, supplier.Email, supplier.Phone).
+		Scan(&supplier.ID, &supplier.CreatedAt)
+	if err != nil {
+		pgErr := new(pgconn.PgError)
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return custErr.ErrSupplierAlreadyExists
+		}
+		log.Error("error while creating supplier", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,352 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// CreateTransfer создает перемещение товаров и списывает их со склада-отправителя.
+//
+// Если у перемещения статус TransferReceived, то товары сразу же приходуются
+// на складе-получателе в той же транзакции. Иначе перемещение остается в пути.
+//
+// Если свободного (не зарезервированного) количества товаров на складе-отправителе
+// недостаточно, то возвращает ErrNotEnoughProductCount.
+//
+// Если склада или продукта не существует, то возвращает ErrForeignKey.
+func (db *Postgres) CreateTransfer(ctx context.Context, transfer *domain.Transfer) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.CreateTransfer"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	sourceInvs := transferInventories(transfer.Source, transfer.Products)
+
+	available, err := getAvailableCounts(ctx, tx, transfer.Source.ID.String(), inventoryProductIDs(sourceInvs))
+	if err != nil {
+		log.Error("error while getting available product count", zap.Error(err))
+		return err
+	}
+
+	// зарезервированный товар не перемещается, иначе резерв нечем будет выкупить.
+	for _, inv := range sourceInvs {
+		if available[inv.Product.ID.String()] < inv.ProductCount {
+			return custErr.ErrNotEnoughProductCount
+		}
+	}
+
+	// оповещения об остатках отправляются только при продажах и ручном изменении количества.
+	_, err = updateProductCount(ctx, tx, db.valuation, sourceInvs, domain.MovementTransfer)
+	if err != nil {
+		log.Error("error while dispatching products", zap.Error(err))
+		return err
+	}
+
+	// товар поступает на склад-получатель по себестоимости, с которой списан со склада-отправителя.
+	for i, p := range transfer.Products {
+		p.UnitCost = sourceInvs[i].Cost.Div(p.ProductCount)
+	}
+
+	err = addStockMovements(ctx, tx, transferMovements(ctx, sourceInvs, -1))
+	if err != nil {
+		log.Error("error while adding stock movements", zap.Error(err))
+		return err
+	}
+
+	err = insertTransfer(ctx, tx, transfer)
+	if err != nil {
+		pgErr := new(pgconn.PgError)
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return custErr.ErrForeignKey
+		}
+		log.Error("error while inserting transfer", zap.Error(err))
+		return err
+	}
+
+	err = dispatchSerials(ctx, tx, transfer)
+	if err != nil {
+		log.Error("error while dispatching serials", zap.Error(err))
+		return err
+	}
+
+	if transfer.Status == domain.TransferReceived {
+		err = receiveTransferProducts(ctx, tx, db.valuation, transfer)
+		if err != nil {
+			log.Error("error while receiving products", zap.Error(err))
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// transferInventories преобразует продукты перемещения в инвентарь указанного склада.
+func transferInventories(warehouse *domain.Warehouse, products []*domain.TransferProduct) []*domain.Inventory {
+	invs := make([]*domain.Inventory, 0, len(products))
+	for _, p := range products {
+		invs = append(invs, &domain.Inventory{
+			Product:      p.Product,
+			Warehouse:    warehouse,
+			ProductCount: p.ProductCount,
+		})
+	}
+
+	return invs
+}
+
+// transferMovements создает записи журнала для перемещения.
+// sign определяет направление: -1 для списания, 1 для оприходования.
+func transferMovements(ctx context.Context, invs []*domain.Inventory, sign int) []*domain.StockMovement {
+	movements := make([]*domain.StockMovement, 0, len(invs))
+	for _, inv := range invs {
+		movements = append(movements, newStockMovement(ctx, inv, sign*inv.ProductCount, domain.MovementTransfer))
+	}
+
+	return movements
+}
+
+// insertTransfer записывает перемещение и его продукты в базу данных.
+// Перемещение создается в статусе TransferInTransit.
+func insertTransfer(ctx context.Context, tx pgx.Tx, transfer *domain.Transfer) error {
+	stmt := `
+	INSERT INTO transfer(source_warehouse_id, destination_warehouse_id, transfer_status)
+	VALUES ($1, $2, $3)
+	RETURNING transfer_id, created_at
+	`
+
+	err := tx.QueryRow(ctx, stmt, transfer.Source.ID, transfer.Destination.ID, domain.TransferInTransit).
+		Scan(&transfer.ID, &transfer.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	var (
+		cursor = 2
+		rows   []string
+		values = []any{transfer.ID}
+	)
+
+	for _, p := range transfer.Products {
+		rows = append(rows, fmt.Sprintf("($1, $%d, $%d, $%d)", cursor, cursor+1, cursor+2))
+		values = append(values, p.Product.ID, p.ProductCount, p.UnitCost)
+		cursor += 3
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO transfer_product(transfer_id, product_id, product_count, unit_cost) VALUES `+strings.Join(rows, ", "), values...)
+
+	return err
+}
+
+// receiveTransferProducts приходует продукты перемещения на склад-получатель.
+//
+// Если записи инвентаря на складе-получателе нет, то она создается с ценой склада-отправителя.
+// Товар поступает в слои себестоимости склада-получателя по себестоимости перемещения
+// и обеспечивает открытые предзаказы склада-получателя, оповещения о них записываются в outbox.
+func receiveTransferProducts(ctx context.Context, tx pgx.Tx, method domain.ValuationMethod, transfer *domain.Transfer) error {
+	stmt := `
+	INSERT INTO inventory(product_id, warehouse_id, product_count, product_price, product_sale)
+	SELECT src.product_id, $2, $3, src.product_price, 0
+	FROM inventory src
+	WHERE src.product_id = $1 AND src.warehouse_id = $4
+	ON CONFLICT (product_id, warehouse_id)
+	DO UPDATE SET product_count = inventory.product_count + EXCLUDED.product_count
+	`
+
+	for _, p := range transfer.Products {
+		tag, err := tx.Exec(ctx, stmt, p.Product.ID, transfer.Destination.ID, p.ProductCount, transfer.Source.ID)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() < 1 {
+			return custErr.ErrNotFoundProductAtWarehouse
+		}
+	}
+
+	err := receiveTransferSerials(ctx, tx, transfer)
+	if err != nil {
+		return err
+	}
+
+	destinationInvs := transferInventories(transfer.Destination, transfer.Products)
+	for i, inv := range destinationInvs {
+		inv.UnitCost = &transfer.Products[i].UnitCost
+
+		err = addCostLayer(ctx, tx, inv)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = addStockMovements(ctx, tx, transferMovements(ctx, destinationInvs, 1))
+	if err != nil {
+		return err
+	}
+
+	for _, inv := range destinationInvs {
+		alerts, err := allocateBackorders(ctx, tx, method, inv, domain.MovementTransfer)
+		if err != nil {
+			return err
+		}
+
+		err = addStockAlertEvents(ctx, tx, alerts)
+		if err != nil {
+			return err
+		}
+	}
+
+	stmt = `
+	UPDATE transfer
+	SET transfer_status = $1, received_at = now()
+	WHERE transfer_id = $2
+	RETURNING received_at
+	`
+
+	err = tx.QueryRow(ctx, stmt, domain.TransferReceived, transfer.ID).Scan(&transfer.ReceivedAt)
+	if err != nil {
+		return err
+	}
+	transfer.Status = domain.TransferReceived
+
+	return nil
+}
+
+// ReceiveTransfer приходует находящееся в пути перемещение на складе-получателе.
+//
+// Если перемещение не найдено, то возвращает ErrTransferNotFound.
+//
+// Если перемещение уже принято, то возвращает ErrTransferNotInTransit.
+func (db *Postgres) ReceiveTransfer(ctx context.Context, transfer *domain.Transfer) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.ReceiveTransfer"),
+	)
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		log.Error("error while beginning transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	stored, err := getTransfer(ctx, tx, transfer.ID.String(), true)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrTransferNotFound) {
+			log.Error("error while getting transfer", zap.Error(err))
+		}
+		return err
+	}
+
+	if !stored.Status.CanTransitionTo(domain.TransferReceived) {
+		return custErr.ErrTransferNotInTransit
+	}
+
+	err = receiveTransferProducts(ctx, tx, db.valuation, stored)
+	if err != nil {
+		log.Error("error while receiving products", zap.Error(err))
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error("error while committing transaction", zap.Error(err))
+		return err
+	}
+
+	*transfer = *stored
+
+	return nil
+}
+
+// GetTransfer получает перемещение по его идентификатору.
+//
+// Если перемещение не найдено, то возвращает ErrTransferNotFound.
+func (db *Postgres) GetTransfer(ctx context.Context, transferID string) (*domain.Transfer, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetTransfer"),
+	)
+
+	transfer, err := getTransfer(ctx, db.pool, transferID, false)
+	if err != nil {
+		if !errors.Is(err, custErr.ErrTransferNotFound) {
+			log.Error("error while getting transfer", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// getTransfer получает перемещение вместе с его продуктами.
+// Если forUpdate равен true, то строка перемещения блокируется до конца транзакции.
+func getTransfer(ctx context.Context, q querier, transferID string, forUpdate bool) (*domain.Transfer, error) {
+	transfer := &domain.Transfer{
+		Source:      &domain.Warehouse{},
+		Destination: &domain.Warehouse{},
+	}
+
+	stmt := `
+	SELECT transfer_id, source_warehouse_id, destination_warehouse_id, transfer_status, created_at, received_at
+	FROM transfer
+	WHERE transfer_id = $1
+	`
+	if forUpdate {
+		stmt += " FOR UPDATE"
+	}
+
+	var status string
+	err := q.QueryRow(ctx, stmt, transferID).Scan(
+		&transfer.ID,
+		&transfer.Source.ID,
+		&transfer.Destination.ID,
+		&status,
+		&transfer.CreatedAt,
+		&transfer.ReceivedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, custErr.ErrTransferNotFound
+		}
+		return nil, err
+	}
+	transfer.Status = domain.TransferStatus(status)
+
+	rows, err := q.Query(ctx, `SELECT product_id, product_count, unit_cost FROM transfer_product WHERE transfer_id = $1`, transferID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := &domain.TransferProduct{
+			Product: &domain.Product{},
+		}
+
+		err = rows.Scan(&p.Product.ID, &p.ProductCount, &p.UnitCost)
+		if err != nil {
+			return nil, err
+		}
+
+		transfer.Products = append(transfer.Products, p)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return transfer, nil
+}
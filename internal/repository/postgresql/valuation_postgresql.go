@@ -0,0 +1,92 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetStockValuation оценивает остатки товаров на складе по слоям себестоимости.
+//
+// Товары отсортированы по названию. Общая себестоимость считается по всем товарам склада,
+// а не только по странице params. Архивные продукты в оценку не попадают.
+func (db *Postgres) GetStockValuation(ctx context.Context, warehouseID string, params *dto.Pagination) (*domain.StockValuation, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "repository.Postgres.GetStockValuation"),
+	)
+
+	id, err := uuid.Parse(warehouseID)
+	if err != nil {
+		log.Error("error while parsing warehouse ID", zap.Error(err))
+		return nil, err
+	}
+
+	valuation := &domain.StockValuation{
+		Warehouse: &domain.Warehouse{ID: id},
+		Method:    db.valuation,
+		Products:  make([]*domain.ProductValuation, 0),
+	}
+
+	stmt := `
+	SELECT COALESCE(SUM(l.layer_count * l.unit_cost), 0)
+	FROM inventory_cost_layer l
+	JOIN product p USING (product_id)
+	WHERE l.warehouse_id = $1 AND l.layer_count > 0 AND p.archived_at IS NULL
+	`
+
+	err = db.pool.QueryRow(ctx, stmt, warehouseID).Scan(&valuation.TotalValue)
+	if err != nil {
+		log.Error("error while getting total stock value", zap.Error(err))
+		return nil, err
+	}
+
+	stmt = `
+	SELECT
+	p.product_id,
+	p.product_name,
+	inv.product_count,
+	COALESCE(SUM(l.layer_count), 0),
+	COALESCE(SUM(l.layer_count * l.unit_cost), 0)
+	FROM inventory inv
+	JOIN product p USING (product_id)
+	LEFT JOIN inventory_cost_layer l
+		ON l.warehouse_id = inv.warehouse_id AND l.product_id = inv.product_id AND l.layer_count > 0
+	WHERE inv.warehouse_id = $1 AND p.archived_at IS NULL
+	GROUP BY p.product_id, p.product_name, inv.product_count
+	ORDER BY p.product_name, p.product_id
+	OFFSET $2
+	LIMIT $3
+	`
+
+	rows, err := db.pool.Query(ctx, stmt, warehouseID, params.Offset, params.Limit)
+	if err != nil {
+		log.Error("error while executing statement", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		product := &domain.ProductValuation{
+			Product: &domain.Product{},
+		}
+
+		err = rows.Scan(&product.Product.ID, &product.Product.Name, &product.ProductCount, &product.CostedCount, &product.Value)
+		if err != nil {
+			log.Error("error while scanning row", zap.Error(err))
+			return nil, err
+		}
+
+		valuation.Products = append(valuation.Products, product)
+	}
+
+	if rows.Err() != nil {
+		log.Error("error after scanning rows", zap.Error(rows.Err()))
+		return nil, rows.Err()
+	}
+
+	return valuation, nil
+}
@@ -20,7 +20,7 @@ func (db *Postgres) GetWarehouses(ctx context.Context) ([]*domain.Warehouse, err
 	var warehouses []*domain.Warehouse
 	log := logger.GetLogger().With(zap.String("op", "repository.postgres.GetWarehouses"))
 
-	stmt := `SELECT warehouse_id, warehouse_address FROM warehouse`
+	stmt := `SELECT warehouse_id, warehouse_address, latitude, longitude FROM warehouse`
 
 	rows, err := db.pool.Query(ctx, stmt)
 	if err != nil {
@@ -33,12 +33,16 @@ func (db *Postgres) GetWarehouses(ctx context.Context) ([]*domain.Warehouse, err
 	defer rows.Close()
 
 	for rows.Next() {
-		var warehouse domain.Warehouse
-		err := rows.Scan(&warehouse.ID, &warehouse.Address)
+		var (
+			warehouse           domain.Warehouse
+			latitude, longitude *float64
+		)
+		err := rows.Scan(&warehouse.ID, &warehouse.Address, &latitude, &longitude)
 		if err != nil {
 			log.Error("error while parsing warehouse", zap.String("err", err.Error()))
 			continue
 		}
+		warehouse.Location = scanLocation(latitude, longitude)
 		warehouses = append(warehouses, &warehouse)
 	}
 
@@ -54,11 +58,16 @@ func (db *Postgres) CreateWarehouse(ctx context.Context, warehouse *domain.Wareh
 	log := logger.GetLogger().With(zap.String("op", "repository.Postgres.CreateWarehouse"))
 
 	stmt := fmt.Sprintf(
-		`INSERT INTO warehouse(warehouse_address)
-		VALUES ($1)
+		`INSERT INTO warehouse(warehouse_address, latitude, longitude)
+		VALUES ($1, $2, $3)
 	`)
 
-	_, err := db.pool.Exec(ctx, stmt, warehouse.Address)
+	var latitude, longitude *float64
+	if warehouse.Location != nil {
+		latitude, longitude = &warehouse.Location.Latitude, &warehouse.Location.Longitude
+	}
+
+	_, err := db.pool.Exec(ctx, stmt, warehouse.Address, latitude, longitude)
 	if err != nil {
 		var pgxError *pgconn.PgError
 		if errors.As(err, &pgxError) {
@@ -72,3 +81,13 @@ func (db *Postgres) CreateWarehouse(ctx context.Context, warehouse *domain.Wareh
 
 	return nil
 }
+
+// scanLocation собирает координаты склада из столбцов latitude и longitude.
+// Если координаты не заданы, то возвращает nil.
+func scanLocation(latitude, longitude *float64) *domain.Location {
+	if latitude == nil || longitude == nil {
+		return nil
+	}
+
+	return &domain.Location{Latitude: *latitude, Longitude: *longitude}
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+)
+
+// PriceHistoryRepository - интерфейс для работы с историей цен товаров.
+type PriceHistoryRepository interface {
+	GetPriceHistory(context.Context, *dto.PriceHistoryFilter) ([]*domain.PriceChange, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+)
+
+// PricingRuleRepository - интерфейс для работы с правилами ценообразования.
+type PricingRuleRepository interface {
+	CreatePricingRule(context.Context, *domain.PricingRule) error
+	GetPricingRule(context.Context, string) (*domain.PricingRule, error)
+	GetPricingRules(context.Context, *dto.PricingRuleFilter) ([]*domain.PricingRule, error)
+	UpdatePricingRule(context.Context, *domain.PricingRule) error
+	DeletePricingRule(context.Context, string) error
+	GetActivePricingRules(context.Context, string) ([]*domain.PricingRule, error)
+}
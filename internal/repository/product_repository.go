@@ -4,11 +4,15 @@ import (
 	"context"
 
 	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
 )
 
 // ProductRepository - интерфейс для работы с продуктами.
 type ProductRepository interface {
-	GetProducts(context.Context) ([]*domain.Product, error)
+	GetProducts(context.Context, *dto.ProductFilter) ([]*domain.Product, int, error)
 	AddProduct(context.Context, *domain.Product) error
 	UpdateProduct(context.Context, *domain.Product) error
+	ArchiveProduct(context.Context, *domain.Product) error
+	RestoreProduct(context.Context, *domain.Product) error
+	DeleteProduct(context.Context, *domain.Product) error
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+)
+
+// PromoCodeRepository - интерфейс для работы с промокодами.
+type PromoCodeRepository interface {
+	CreatePromoCode(context.Context, *domain.PromoCode) error
+	GetPromoCode(context.Context, string) (*domain.PromoCode, error)
+	GetPromoCodes(context.Context, *dto.PromoCodeFilter) ([]*domain.PromoCode, error)
+	DeletePromoCode(context.Context, string) error
+	ApplyPromoCode(context.Context, *domain.Cart) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+)
+
+// PurchaseOrderRepository - интерфейс для работы с заказами поставщикам.
+type PurchaseOrderRepository interface {
+	CreatePurchaseOrder(context.Context, *domain.PurchaseOrder) error
+	GetPurchaseOrder(context.Context, string) (*domain.PurchaseOrder, error)
+	GetPurchaseOrders(context.Context, *dto.PurchaseOrderFilter) ([]*domain.PurchaseOrder, error)
+	ReceivePurchaseOrder(context.Context, *domain.PurchaseOrderReceipt) error
+	CancelPurchaseOrder(context.Context, *domain.PurchaseOrder) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// ReservationRepository - интерфейс для работы с резервами товаров.
+type ReservationRepository interface {
+	CreateReservation(context.Context, *domain.Reservation) error
+	ReleaseExpiredReservations(context.Context) (int, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// SerialRepository - интерфейс для работы с серийными номерами товаров.
+type SerialRepository interface {
+	GetSerials(context.Context, string) ([]*domain.ProductSerial, error)
+}
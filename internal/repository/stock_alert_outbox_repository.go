@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// StockAlertOutboxRepository - интерфейс для доставки оповещений об остатках из outbox.
+type StockAlertOutboxRepository interface {
+	DispatchStockAlerts(ctx context.Context, batchSize int, retryDelay time.Duration, send func(context.Context, *domain.StockAlert) error) (int, error)
+}
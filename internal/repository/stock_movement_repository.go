@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+)
+
+// StockMovementRepository - интерфейс для работы с журналом движения товаров.
+type StockMovementRepository interface {
+	GetStockMovements(context.Context, *dto.StockMovementFilter) ([]*domain.StockMovement, error)
+}
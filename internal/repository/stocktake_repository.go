@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/google/uuid"
+)
+
+// StocktakeRepository - интерфейс для работы с пересчетами товаров на складах.
+type StocktakeRepository interface {
+	CreateStocktake(context.Context, *domain.Stocktake) error
+	GetStocktake(context.Context, string) (*domain.Stocktake, error)
+	SubmitStocktakeCounts(context.Context, *domain.Stocktake) error
+	PostStocktake(context.Context, *domain.Stocktake, []uuid.UUID) error
+	CancelStocktake(context.Context, *domain.Stocktake) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// SupplierRepository - интерфейс для работы с поставщиками.
+type SupplierRepository interface {
+	GetSuppliers(context.Context) ([]*domain.Supplier, error)
+	CreateSupplier(context.Context, *domain.Supplier) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+)
+
+// TransferRepository - интерфейс для работы с перемещениями товаров между складами.
+type TransferRepository interface {
+	CreateTransfer(context.Context, *domain.Transfer) error
+	ReceiveTransfer(context.Context, *domain.Transfer) error
+	GetTransfer(context.Context, string) (*domain.Transfer, error)
+}
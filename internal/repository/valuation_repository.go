@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+)
+
+// ValuationRepository - интерфейс для оценки себестоимости остатков товаров.
+type ValuationRepository interface {
+	GetStockValuation(context.Context, string, *dto.Pagination) (*domain.StockValuation, error)
+}
@@ -7,11 +7,14 @@ import (
 	"os"
 	"os/signal"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/handler"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/middleware"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/notifier"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/service"
 	"github.com/PIRSON21/mediasoft-intership2025/pkg/config"
@@ -32,7 +35,7 @@ func CreateServer(version string) {
 
 	// подключение repositories
 	zlog.Debug("trying to connect to repositories")
-	repo := repository.MustInitRepository(context.Background(), cfg.DBConfig)
+	repo := repository.MustInitRepository(context.Background(), cfg.DBConfig, cfg.ValuationConfig)
 	defer repo.Close()
 
 	hostURL := createHostURL(cfg.Address)
@@ -43,18 +46,53 @@ func CreateServer(version string) {
 	warehouseService := service.NewWarehouseService(repo)
 	productService := service.NewProductService(repo, hostURL)
 	analyticsService := service.NewAnalyticsService(repo)
-	inventoryService := service.NewInventoryService(repo, analyticsService, hostURL)
+	inventoryService := service.NewInventoryService(repo, hostURL, cfg.ReservationTTL)
+	discountService := service.NewDiscountService(repo)
+	promoCodeService := service.NewPromoCodeService(repo)
+	pricingRuleService := service.NewPricingRuleService(repo)
+	stockMovementService := service.NewStockMovementService(repo)
+	priceHistoryService := service.NewPriceHistoryService(repo)
+	batchService := service.NewBatchService(repo)
+	serialService := service.NewSerialService(repo)
+	stocktakeService := service.NewStocktakeService(repo)
+	supplierService := service.NewSupplierService(repo)
+	purchaseOrderService := service.NewPurchaseOrderService(repo)
+	valuationService := service.NewValuationService(repo)
+	transferService := service.NewTransferService(repo)
+	orderService := service.NewOrderService(repo)
+	fulfillmentService := service.NewFulfillmentService(repo, mustParseFulfillmentStrategy(cfg.FulfillmentConfig))
+	backorderService := service.NewBackorderService(repo)
 
 	// инициализация handlers
 	zlog.Debug("setting up the handlers")
-	warehouseHandlers := handler.NewWarehouseHandler(warehouseService)
-	productHandlers := handler.NewProductHandler(productService)
-	inventoryHandlers := handler.NewInventoryHandler(inventoryService)
-	analyticsHandler := handler.NewAnalyticsHandler(analyticsService)
+	handlers := &routerHandlers{
+		warehouse:     handler.NewWarehouseHandler(warehouseService),
+		product:       handler.NewProductHandler(productService),
+		inventory:     handler.NewInventoryHandler(inventoryService),
+		discount:      handler.NewDiscountHandler(discountService),
+		promoCode:     handler.NewPromoCodeHandler(promoCodeService),
+		pricingRule:   handler.NewPricingRuleHandler(pricingRuleService),
+		analytics:     handler.NewAnalyticsHandler(analyticsService),
+		stockMovement: handler.NewStockMovementHandler(stockMovementService),
+		priceHistory:  handler.NewPriceHistoryHandler(priceHistoryService),
+		batch:         handler.NewBatchHandler(batchService),
+		serial:        handler.NewSerialHandler(serialService),
+		stocktake:     handler.NewStocktakeHandler(stocktakeService),
+		supplier:      handler.NewSupplierHandler(supplierService),
+		purchaseOrder: handler.NewPurchaseOrderHandler(purchaseOrderService),
+		valuation:     handler.NewValuationHandler(valuationService),
+		transfer:      handler.NewTransferHandler(transferService),
+		order:         handler.NewOrderHandler(orderService),
+		fulfillment:   handler.NewFulfillmentHandler(fulfillmentService),
+		backorder:     handler.NewBackorderHandler(backorderService),
+	}
 
 	// задание роутингов
 	zlog.Debug("creating router")
-	mux := createRouter(warehouseHandlers, productHandlers, inventoryHandlers, analyticsHandler)
+	if cfg.IdempotencyLockTTL <= 0 {
+		zlog.Fatal("idempotency lock TTL must be positive", zap.Duration("ttl", cfg.IdempotencyLockTTL))
+	}
+	mux := createRouter(handlers, repo, cfg.IdempotencyLockTTL)
 
 	// создание сервера
 	zlog.Debug("creating server")
@@ -63,6 +101,52 @@ func CreateServer(version string) {
 		Handler: mux,
 	}
 
+	// запуск фоновых задач
+	zlog.Debug("starting background workers")
+	bgCtx, stopBackground := context.WithCancel(context.Background())
+	var bgWG sync.WaitGroup
+
+	reservationSweeper, err := service.NewReservationSweeper(repo, cfg.ReservationSweepInterval)
+	if err != nil {
+		zlog.Fatal("error while creating reservation sweeper", zap.Error(err))
+	}
+	bgWG.Add(1)
+	go func() {
+		defer bgWG.Done()
+		reservationSweeper.Run(bgCtx)
+	}()
+
+	idempotencySweeper, err := service.NewIdempotencySweeper(repo, cfg.IdempotencySweepInterval, cfg.IdempotencyKeyTTL)
+	if err != nil {
+		zlog.Fatal("error while creating idempotency sweeper", zap.Error(err))
+	}
+	bgWG.Add(1)
+	go func() {
+		defer bgWG.Done()
+		idempotencySweeper.Run(bgCtx)
+	}()
+
+	analyticsDispatcher, err := service.NewAnalyticsDispatcher(repo, cfg.AnalyticsDispatchInterval, cfg.AnalyticsDispatchBatch, cfg.AnalyticsRetryDelay)
+	if err != nil {
+		zlog.Fatal("error while creating analytics dispatcher", zap.Error(err))
+	}
+	bgWG.Add(1)
+	go func() {
+		defer bgWG.Done()
+		analyticsDispatcher.Run(bgCtx)
+	}()
+
+	stockAlertDispatcher, err := service.NewStockAlertDispatcher(repo, createStockAlertNotifier(cfg.StockAlertConfig),
+		cfg.StockAlertDispatchInterval, cfg.StockAlertDispatchBatch, cfg.StockAlertRetryDelay)
+	if err != nil {
+		zlog.Fatal("error while creating stock alert dispatcher", zap.Error(err))
+	}
+	bgWG.Add(1)
+	go func() {
+		defer bgWG.Done()
+		stockAlertDispatcher.Run(bgCtx)
+	}()
+
 	stopCh := make(chan struct{})
 	go func() {
 		sigint := make(chan os.Signal, 1)
@@ -77,6 +161,12 @@ func CreateServer(version string) {
 		if err := srv.Shutdown(ctx); err != nil {
 			zlog.Error("error while shutdown server", zap.Error(err))
 		}
+
+		// фоновые задачи останавливаются после сервера, чтобы диспетчер
+		// аналитики перенес продажи, совершенные последними запросами.
+		stopBackground()
+		bgWG.Wait()
+
 		close(stopCh)
 	}()
 
@@ -89,9 +179,60 @@ func CreateServer(version string) {
 	<-stopCh
 }
 
+// mustParseFulfillmentStrategy проверяет стратегию сборки заказа по умолчанию из конфига.
+//
+// При ошибке завершает приложение.
+func mustParseFulfillmentStrategy(cfg config.FulfillmentConfig) domain.FulfillmentStrategy {
+	strategy, err := domain.ParseFulfillmentStrategy(cfg.FulfillmentStrategy)
+	if err != nil {
+		logger.GetLogger().Fatal("error while parsing fulfillment strategy", zap.Error(err))
+	}
+
+	return strategy
+}
+
+// createStockAlertNotifier выбирает способ доставки оповещений об остатках.
+//
+// Если задан адрес вебхука, то оповещения отправляются на него, иначе записываются в лог.
+func createStockAlertNotifier(cfg config.StockAlertConfig) notifier.Notifier {
+	if cfg.StockAlertWebhookURL != "" {
+		return notifier.NewWebhookNotifier(cfg.StockAlertWebhookURL, cfg.StockAlertWebhookTimeout)
+	}
+
+	return notifier.NewLogNotifier()
+}
+
+// routerHandlers объединяет обработчики, из которых строится маршрутизатор.
+type routerHandlers struct {
+	warehouse     *handler.WarehouseHandler
+	product       *handler.ProductHandler
+	inventory     *handler.InventoryHandler
+	discount      *handler.DiscountHandler
+	promoCode     *handler.PromoCodeHandler
+	pricingRule   *handler.PricingRuleHandler
+	analytics     *handler.AnalyticsHandler
+	stockMovement *handler.StockMovementHandler
+	priceHistory  *handler.PriceHistoryHandler
+	batch         *handler.BatchHandler
+	serial        *handler.SerialHandler
+	stocktake     *handler.StocktakeHandler
+	supplier      *handler.SupplierHandler
+	purchaseOrder *handler.PurchaseOrderHandler
+	valuation     *handler.ValuationHandler
+	transfer      *handler.TransferHandler
+	order         *handler.OrderHandler
+	fulfillment   *handler.FulfillmentHandler
+	backorder     *handler.BackorderHandler
+}
+
 // createRouter создает маршрутизатор с заданными обработчиками и middleware.
-func createRouter(warehouseHandlers *handler.WarehouseHandler, productHandlers *handler.ProductHandler, inventoryHandlers *handler.InventoryHandler, analyticsHandlers *handler.AnalyticsHandler) *http.ServeMux {
+//
+// idempotencyStore используется для хранения ответов на запросы, изменяющие остатки товаров,
+// idempotencyLockTTL - время, после которого незавершенный запрос с ключом идемпотентности
+// можно повторить.
+func createRouter(h *routerHandlers, idempotencyStore middleware.IdempotencyStore, idempotencyLockTTL time.Duration) *http.ServeMux {
 	mux := http.NewServeMux()
+	idempotency := middleware.Idempotency(idempotencyStore, idempotencyLockTTL)
 
 	// health check
 	mux.HandleFunc("/api/health", func(w http.ResponseWriter, r *http.Request) {
@@ -104,7 +245,7 @@ func createRouter(warehouseHandlers *handler.WarehouseHandler, productHandlers *
 
 	// warehouses
 	mux.Handle("/api/warehouses", chainMiddleware(
-		http.HandlerFunc(warehouseHandlers.WarehousesHandler),
+		http.HandlerFunc(h.warehouse.WarehousesHandler),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
@@ -112,14 +253,116 @@ func createRouter(warehouseHandlers *handler.WarehouseHandler, productHandlers *
 
 	// products
 	mux.Handle("/api/products", chainMiddleware(
-		http.HandlerFunc(productHandlers.ProductsHandler),
+		http.HandlerFunc(h.product.ProductsHandler),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
 	))
 
 	mux.Handle("/api/product/", chainMiddleware(
-		http.HandlerFunc(productHandlers.UpdateProduct),
+		http.HandlerFunc(h.product.ProductByIDHandler),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/product/{id}/archive", chainMiddleware(
+		http.HandlerFunc(h.product.ArchiveProduct),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/product/{id}/restore", chainMiddleware(
+		http.HandlerFunc(h.product.RestoreProduct),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	// stocktakes
+	mux.Handle("/api/stocktakes", chainMiddleware(
+		http.HandlerFunc(h.stocktake.CreateStocktake),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/stocktakes/{id}", chainMiddleware(
+		http.HandlerFunc(h.stocktake.GetStocktake),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/stocktakes/{id}/counts", chainMiddleware(
+		http.HandlerFunc(h.stocktake.SubmitStocktakeCounts),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/stocktakes/{id}/variance", chainMiddleware(
+		http.HandlerFunc(h.stocktake.GetStocktakeVariance),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/stocktakes/{id}/post", chainMiddleware(
+		http.HandlerFunc(h.stocktake.PostStocktake),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
+	mux.Handle("/api/stocktakes/{id}/cancel", chainMiddleware(
+		http.HandlerFunc(h.stocktake.CancelStocktake),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	// suppliers and purchase orders
+	mux.Handle("/api/suppliers", chainMiddleware(
+		http.HandlerFunc(h.supplier.SuppliersHandler),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/purchase_orders", chainMiddleware(
+		http.HandlerFunc(h.purchaseOrder.PurchaseOrdersHandler),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/purchase_orders/{id}", chainMiddleware(
+		http.HandlerFunc(h.purchaseOrder.GetPurchaseOrder),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/purchase_orders/{id}/receive", chainMiddleware(
+		http.HandlerFunc(h.purchaseOrder.ReceivePurchaseOrder),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
+	mux.Handle("/api/purchase_orders/{id}/cancel", chainMiddleware(
+		http.HandlerFunc(h.purchaseOrder.CancelPurchaseOrder),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/serials/{serial}", chainMiddleware(
+		http.HandlerFunc(h.serial.GetSerial),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
@@ -127,57 +370,281 @@ func createRouter(warehouseHandlers *handler.WarehouseHandler, productHandlers *
 
 	// inventory
 	mux.Handle("/api/inventory/change_count", chainMiddleware(
-		http.HandlerFunc(inventoryHandlers.ChangeProductCount),
+		http.HandlerFunc(h.inventory.ChangeProductCount),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
+	mux.Handle("/api/inventory/stock_thresholds", chainMiddleware(
+		http.HandlerFunc(h.inventory.SetStockThresholds),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/inventory/backorder_limit", chainMiddleware(
+		http.HandlerFunc(h.backorder.SetBackorderLimit),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/inventory/batches", chainMiddleware(
+		http.HandlerFunc(h.inventory.ReceiveBatch),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
+	mux.Handle("/api/inventory/change_price", chainMiddleware(
+		http.HandlerFunc(h.inventory.ChangeProductPrice),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
 	))
 
 	mux.Handle("/api/inventory/add_discount", chainMiddleware(
-		http.HandlerFunc(inventoryHandlers.AddDiscountToProduct),
+		http.HandlerFunc(h.inventory.AddDiscountToProduct),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
 	))
 
 	mux.Handle("/api/inventory/check_cart", chainMiddleware(
-		http.HandlerFunc(inventoryHandlers.CalculateCart),
+		http.HandlerFunc(h.inventory.CalculateCart),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
 	))
 
 	mux.Handle("/api/inventory/buy", chainMiddleware(
-		http.HandlerFunc(inventoryHandlers.BuyProducts),
+		http.HandlerFunc(h.inventory.BuyProducts),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
+	mux.Handle("/api/fulfillment/plan", chainMiddleware(
+		http.HandlerFunc(h.fulfillment.PlanFulfillment),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
 	))
 
+	mux.Handle("/api/fulfillment/buy", chainMiddleware(
+		http.HandlerFunc(h.fulfillment.Fulfill),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
+	mux.Handle("/api/inventory/transfer", chainMiddleware(
+		http.HandlerFunc(h.transfer.CreateTransfer),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
+	mux.Handle("/api/inventory/transfer/{id}", chainMiddleware(
+		http.HandlerFunc(h.transfer.GetTransfer),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/inventory/transfer/{id}/receive", chainMiddleware(
+		http.HandlerFunc(h.transfer.ReceiveTransfer),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
 	mux.Handle("/api/warehouse/", chainMiddleware(
-		http.HandlerFunc(inventoryHandlers.GetProductFromWarehouse),
+		http.HandlerFunc(h.inventory.GetProductFromWarehouse),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/warehouse/{id}/movements", chainMiddleware(
+		http.HandlerFunc(h.stockMovement.GetStockMovements),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/warehouse/{id}/low_stock", chainMiddleware(
+		http.HandlerFunc(h.inventory.GetLowStockProducts),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/warehouse/{id}/backorders", chainMiddleware(
+		http.HandlerFunc(h.backorder.GetBackorders),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/warehouse/{id}/batches", chainMiddleware(
+		http.HandlerFunc(h.batch.GetBatches),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/warehouse/{id}/expiring", chainMiddleware(
+		http.HandlerFunc(h.batch.GetExpiringBatches),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/warehouse/{id}/valuation", chainMiddleware(
+		http.HandlerFunc(h.valuation.GetStockValuation),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/warehouse/{id}/price_history", chainMiddleware(
+		http.HandlerFunc(h.priceHistory.GetPriceHistory),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
 	))
 
 	mux.Handle("/api/inventory", chainMiddleware(
-		http.HandlerFunc(inventoryHandlers.CreateInventory),
+		http.HandlerFunc(h.inventory.CreateInventory),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
+	// discounts
+	mux.Handle("/api/discounts", chainMiddleware(
+		http.HandlerFunc(h.discount.DiscountsHandler),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/discounts/{id}", chainMiddleware(
+		http.HandlerFunc(h.discount.DiscountRuleHandler),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	// promo codes
+	mux.Handle("/api/promo_codes", chainMiddleware(
+		http.HandlerFunc(h.promoCode.PromoCodesHandler),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/promo_codes/{id}", chainMiddleware(
+		http.HandlerFunc(h.promoCode.PromoCodeHandler),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	// pricing rules
+	mux.Handle("/api/pricing_rules", chainMiddleware(
+		http.HandlerFunc(h.pricingRule.PricingRulesHandler),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/pricing_rules/{id}", chainMiddleware(
+		http.HandlerFunc(h.pricingRule.PricingRuleHandler),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	// orders
+	mux.Handle("/api/orders", chainMiddleware(
+		http.HandlerFunc(h.order.GetOrders),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/orders/{id}", chainMiddleware(
+		http.HandlerFunc(h.order.GetOrder),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/orders/{id}/status", chainMiddleware(
+		http.HandlerFunc(h.order.UpdateOrderStatus),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
+	mux.Handle("/api/orders/{id}/cancel", chainMiddleware(
+		http.HandlerFunc(h.order.CancelOrder),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
+	))
+
+	mux.Handle("/api/orders/{id}/return", chainMiddleware(
+		http.HandlerFunc(h.order.ReturnOrderProducts),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+		idempotency,
 	))
 
 	// analytics
 	mux.Handle("/api/analytics/", chainMiddleware(
-		http.HandlerFunc(analyticsHandlers.GetWarehouseAnalytics),
+		http.HandlerFunc(h.analytics.GetWarehouseAnalytics),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
 	))
 
 	mux.Handle("/api/analytics/top_warehouses", chainMiddleware(
-		http.HandlerFunc(analyticsHandlers.GetTopWarehouses),
+		http.HandlerFunc(h.analytics.GetTopWarehouses),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/analytics/timeseries", chainMiddleware(
+		http.HandlerFunc(h.analytics.GetSalesTimeSeries),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/analytics/top_products", chainMiddleware(
+		http.HandlerFunc(h.analytics.GetTopProducts),
+		middleware.Recoverer,
+		middleware.RequestID,
+		middleware.LoggingMiddleware,
+	))
+
+	mux.Handle("/api/analytics/product/{id}", chainMiddleware(
+		http.HandlerFunc(h.analytics.GetProductAnalytics),
 		middleware.Recoverer,
 		middleware.RequestID,
 		middleware.LoggingMiddleware,
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// analyticsDrainTimeout - максимальное время, за которое диспетчер переносит
+// оставшиеся события при остановке приложения.
+const analyticsDrainTimeout = 10 * time.Second
+
+// AnalyticsDispatcher периодически переносит события продаж из outbox в аналитику.
+type AnalyticsDispatcher struct {
+	repo       repository.AnalyticsOutboxRepository
+	interval   time.Duration
+	batchSize  int
+	retryDelay time.Duration
+}
+
+// NewAnalyticsDispatcher создает новый экземпляр AnalyticsDispatcher.
+//
+// interval задает периодичность переноса, batchSize - количество событий,
+// переносимых за одну транзакцию, retryDelay - задержку перед повторной попыткой
+// перенести событие, которое не удалось записать.
+//
+// Если interval, batchSize или retryDelay не положительные, то возвращает ошибку.
+func NewAnalyticsDispatcher(repo repository.AnalyticsOutboxRepository, interval time.Duration, batchSize int, retryDelay time.Duration) (*AnalyticsDispatcher, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("analytics dispatch interval must be positive, got %s", interval)
+	}
+
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("analytics dispatch batch size must be positive, got %d", batchSize)
+	}
+
+	if retryDelay <= 0 {
+		return nil, fmt.Errorf("analytics retry delay must be positive, got %s", retryDelay)
+	}
+
+	return &AnalyticsDispatcher{
+		repo:       repo,
+		interval:   interval,
+		batchSize:  batchSize,
+		retryDelay: retryDelay,
+	}, nil
+}
+
+// Run переносит события в аналитику, пока не будет отменен контекст.
+//
+// После отмены контекста переносит оставшиеся события, чтобы продажи,
+// совершенные до остановки приложения, попали в аналитику.
+func (d *AnalyticsDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.drain()
+			return
+		case <-ticker.C:
+			d.dispatch(ctx)
+		}
+	}
+}
+
+// drain переносит оставшиеся события при остановке приложения.
+func (d *AnalyticsDispatcher) drain() {
+	ctx, cancel := context.WithTimeout(context.Background(), analyticsDrainTimeout)
+	defer cancel()
+
+	d.dispatch(ctx)
+}
+
+// dispatch переносит готовые события партиями, пока они не закончатся.
+func (d *AnalyticsDispatcher) dispatch(ctx context.Context) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.AnalyticsDispatcher.dispatch"),
+	)
+
+	total := 0
+	for ctx.Err() == nil {
+		dispatched, err := d.repo.DispatchAnalyticsEvents(ctx, d.batchSize, d.retryDelay)
+		if err != nil {
+			log.Error("error while dispatching analytics events", zap.Error(err))
+			break
+		}
+
+		total += dispatched
+		if dispatched < d.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Debug("analytics events dispatched", zap.Int("count", total))
+	}
+}
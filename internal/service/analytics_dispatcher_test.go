@@ -0,0 +1,39 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnalyticsDispatcher(t *testing.T) {
+	tests := []struct {
+		name       string
+		interval   time.Duration
+		batchSize  int
+		retryDelay time.Duration
+		wantErr    bool
+	}{
+		{name: "valid settings", interval: time.Second, batchSize: 100, retryDelay: time.Minute},
+		{name: "zero interval", interval: 0, batchSize: 100, retryDelay: time.Minute, wantErr: true},
+		{name: "negative interval", interval: -time.Second, batchSize: 100, retryDelay: time.Minute, wantErr: true},
+		{name: "zero batch size", interval: time.Second, batchSize: 0, retryDelay: time.Minute, wantErr: true},
+		{name: "negative batch size", interval: time.Second, batchSize: -1, retryDelay: time.Minute, wantErr: true},
+		{name: "zero retry delay", interval: time.Second, batchSize: 100, retryDelay: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dispatcher, err := NewAnalyticsDispatcher(nil, tt.interval, tt.batchSize, tt.retryDelay)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, dispatcher)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, dispatcher)
+		})
+	}
+}
@@ -24,27 +24,14 @@ func NewAnalyticsService(repo repository.AnalyticsRepository) *AnalyticsService
 	}
 }
 
-// AddProductSell добавляет информацию о продаже продукта в аналитику.
-func (s *AnalyticsService) AddProductSell(invs []*domain.Inventory) {
-	log := logger.GetLogger().With(
-		zap.String("op", "service.AnalyticsService.AddProductSell"),
-	)
-
-	err := s.repo.AddProductSell(invs)
-	if err != nil {
-		log.Error("error while adding product sell to analytics", zap.Error(err))
-		return
-	}
-}
-
 // GetWarehouseAnalytics возвращает аналитику по складу.
-// Принимает идентификатор склада и возвращает информацию о продажах продуктов на этом складе.
-func (s *AnalyticsService) GetWarehouseAnalytics(ctx context.Context, warehouseID string) (*dto.WarehouseAnalyticsResponse, error) {
+// Принимает идентификатор склада и возвращает информацию о продажах продуктов на этом складе за период.
+func (s *AnalyticsService) GetWarehouseAnalytics(ctx context.Context, warehouseID string, period *dto.AnalyticsPeriod) (*dto.WarehouseAnalyticsResponse, error) {
 	log := logger.GetLogger().With(
 		zap.String("op", "service.GetWarehouseAnalytics"),
 	)
 
-	analytics, err := s.repo.GetWarehouseAnalytics(ctx, warehouseID)
+	analytics, err := s.repo.GetWarehouseAnalytics(ctx, warehouseID, period)
 	if err != nil {
 		log.Error("error while getting warehouse analytics from repository", zap.Error(err))
 		return nil, err
@@ -56,6 +43,10 @@ func (s *AnalyticsService) GetWarehouseAnalytics(ctx context.Context, warehouseI
 }
 
 // parseWarehouseAnalyticsToResponse преобразует аналитику склада в ответный DTO.
+//
+// Суммой продаж считается выручка строк аналитики со всеми скидками.
+// Возвраты имеют отрицательные количество, выручку и себестоимость
+// и уменьшают итоговые значения.
 func parseWarehouseAnalyticsToResponse(warehouseID string, analytics []*domain.Analytics) *dto.WarehouseAnalyticsResponse {
 	analMap := make(map[uuid.UUID]*dto.ProductAnalytic)
 	resp := dto.WarehouseAnalyticsResponse{
@@ -63,34 +54,46 @@ func parseWarehouseAnalyticsToResponse(warehouseID string, analytics []*domain.A
 	}
 
 	for _, analytic := range analytics {
+		sum := analytic.Revenue
+		resp.TotalSum += sum
+		resp.TotalCOGS += analytic.Cost
+
 		anal, ok := analMap[analytic.Product.ID]
 		if ok {
 			anal.ProductCount += analytic.ProductCount
-			anal.ProductPrice += analytic.ProductPrice
-			resp.TotalSum += analytic.ProductPrice
+			anal.ProductPrice += sum
+			anal.COGS += analytic.Cost
 			continue
 		}
 		anal = &dto.ProductAnalytic{
 			ProductID:    analytic.Product.ID.String(),
 			ProductName:  analytic.Product.Name,
 			ProductCount: analytic.ProductCount,
-			ProductPrice: analytic.ProductPrice,
+			ProductPrice: sum,
+			COGS:         analytic.Cost,
 		}
 		analMap[analytic.Product.ID] = anal
-		resp.TotalSum += anal.ProductPrice
 		resp.Products = append(resp.Products, anal)
 	}
 
+	for _, anal := range resp.Products {
+		anal.GrossMargin = anal.ProductPrice - anal.COGS
+		anal.MarginPercent = domain.MarginPercent(anal.ProductPrice, anal.COGS)
+	}
+
+	resp.TotalGrossMargin = resp.TotalSum - resp.TotalCOGS
+	resp.TotalMarginPercent = domain.MarginPercent(resp.TotalSum, resp.TotalCOGS)
+
 	return &resp
 }
 
 // GetTopWarehouses возвращает список топ-складов по количеству продаж продуктов.
-func (s *AnalyticsService) GetTopWarehouses(ctx context.Context, limit int) ([]*dto.WarehouseAnalyticsAtListResponse, error) {
+func (s *AnalyticsService) GetTopWarehouses(ctx context.Context, limit int, period *dto.AnalyticsPeriod) ([]*dto.WarehouseAnalyticsAtListResponse, error) {
 	log := logger.GetLogger().With(
 		zap.String("op", "service.AnalyticsService.GetTopWarehouse"),
 	)
 
-	response, err := s.repo.GetTopWarehouses(ctx, limit)
+	response, err := s.repo.GetTopWarehouses(ctx, limit, period)
 	if err != nil {
 		log.Error("error while getting top warehouses from repository", zap.Error(err))
 		return nil, err
@@ -98,3 +101,90 @@ func (s *AnalyticsService) GetTopWarehouses(ctx context.Context, limit int) ([]*
 
 	return response, nil
 }
+
+// GetSalesTimeSeries возвращает выручку и количество проданных единиц по дням, неделям или месяцам.
+func (s *AnalyticsService) GetSalesTimeSeries(ctx context.Context, filter *dto.SalesTimeSeriesFilter) (*dto.SalesTimeSeriesResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.AnalyticsService.GetSalesTimeSeries"),
+	)
+
+	points, err := s.repo.GetSalesTimeSeries(ctx, filter)
+	if err != nil {
+		log.Error("error while getting sales time series from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.SalesTimeSeriesResponse{
+		WarehouseID: filter.WarehouseID,
+		ProductID:   filter.ProductID,
+		Bucket:      filter.Bucket,
+		Points:      make([]*dto.SalesPointResponse, 0, len(points)),
+	}
+
+	for _, point := range points {
+		resp.Points = append(resp.Points, &dto.SalesPointResponse{
+			PeriodStart: point.PeriodStart,
+			Units:       point.ProductCount,
+			Revenue:     point.Revenue,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetTopProducts возвращает самые продаваемые продукты по выручке или количеству проданных единиц.
+func (s *AnalyticsService) GetTopProducts(ctx context.Context, filter *dto.TopProductsFilter) ([]*dto.TopProductResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.AnalyticsService.GetTopProducts"),
+	)
+
+	totals, err := s.repo.GetTopProducts(ctx, filter)
+	if err != nil {
+		log.Error("error while getting top products from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := make([]*dto.TopProductResponse, 0, len(totals))
+	for _, total := range totals {
+		resp = append(resp, &dto.TopProductResponse{
+			ProductID:   total.Product.ID.String(),
+			ProductName: total.Product.Name,
+			Units:       total.ProductCount,
+			Revenue:     total.Revenue,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetProductAnalytics возвращает продажи продукта на каждом складе за период.
+func (s *AnalyticsService) GetProductAnalytics(ctx context.Context, productID uuid.UUID, period *dto.AnalyticsPeriod) (*dto.ProductAnalyticsResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.AnalyticsService.GetProductAnalytics"),
+	)
+
+	product, totals, err := s.repo.GetProductAnalytics(ctx, productID.String(), period)
+	if err != nil {
+		log.Error("error while getting product analytics from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.ProductAnalyticsResponse{
+		ProductID:   product.ID.String(),
+		ProductName: product.Name,
+		Warehouses:  make([]*dto.ProductAtWarehouseAnalytic, 0, len(totals)),
+	}
+
+	for _, total := range totals {
+		resp.Warehouses = append(resp.Warehouses, &dto.ProductAtWarehouseAnalytic{
+			WarehouseID:      total.Warehouse.ID.String(),
+			WarehouseAddress: total.Warehouse.Address,
+			Units:            total.ProductCount,
+			Revenue:          total.Revenue,
+		})
+		resp.TotalUnits += total.ProductCount
+		resp.TotalRevenue += total.Revenue
+	}
+
+	return resp, nil
+}
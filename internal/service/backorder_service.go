@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// BackorderService предоставляет методы для работы с предзаказами товаров.
+type BackorderService struct {
+	repo repository.BackorderRepository
+}
+
+// NewBackorderService создает новый экземпляр BackorderService.
+func NewBackorderService(repo repository.BackorderRepository) *BackorderService {
+	return &BackorderService{
+		repo: repo,
+	}
+}
+
+// SetBackorderLimit задает, сколько товара можно продать сверх остатка склада.
+func (s *BackorderService) SetBackorderLimit(ctx context.Context, request *dto.BackorderLimitRequest) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.BackorderService.SetBackorderLimit"),
+	)
+
+	productID, err := uuid.Parse(request.ProductID)
+	if err != nil {
+		log.Error("error while parsing product ID", zap.Error(err))
+		return err
+	}
+
+	warehouseID, err := uuid.Parse(request.WarehouseID)
+	if err != nil {
+		log.Error("error while parsing warehouse ID", zap.Error(err))
+		return err
+	}
+
+	inventory := &domain.Inventory{
+		Product: &domain.Product{
+			ID: productID,
+		},
+		Warehouse: &domain.Warehouse{
+			ID: warehouseID,
+		},
+		BackorderLimit: *request.BackorderLimit,
+	}
+
+	err = s.repo.SetBackorderLimit(ctx, inventory)
+	if err != nil {
+		log.Error("error while setting backorder limit in repository", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetBackorders возвращает открытые предзаказы склада с пагинацией.
+func (s *BackorderService) GetBackorders(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.BackordersResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.BackorderService.GetBackorders"),
+	)
+
+	backorders, err := s.repo.GetBackorders(ctx, warehouseID, params)
+	if err != nil {
+		log.Error("error while getting backorders from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.BackordersResponse{
+		Page:       params.Page,
+		Limit:      params.Limit,
+		Backorders: make([]*dto.BackorderResponse, 0, len(backorders)),
+	}
+
+	for _, b := range backorders {
+		resp.Backorders = append(resp.Backorders, &dto.BackorderResponse{
+			BackorderID:    b.ID.String(),
+			OrderID:        b.OrderID.String(),
+			ProductID:      b.Product.ID.String(),
+			ProductName:    b.Product.Name,
+			BackorderCount: b.Count,
+			AllocatedCount: b.Allocated,
+			RemainingCount: b.Remaining(),
+			CreatedAt:      b.CreatedAt,
+		})
+	}
+
+	return resp, nil
+}
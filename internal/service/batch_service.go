@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// BatchService предоставляет методы для работы с партиями товаров.
+type BatchService struct {
+	repo repository.BatchRepository
+}
+
+// NewBatchService создает новый экземпляр BatchService.
+func NewBatchService(repo repository.BatchRepository) *BatchService {
+	return &BatchService{
+		repo: repo,
+	}
+}
+
+// GetBatches возвращает партии товаров на складе с учетом фильтров и пагинации.
+func (s *BatchService) GetBatches(ctx context.Context, filter *dto.BatchFilter) (*dto.BatchesResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.BatchService.GetBatches"),
+	)
+
+	batches, err := s.repo.GetBatches(ctx, filter)
+	if err != nil {
+		log.Error("error while getting batches from repository", zap.Error(err))
+		return nil, err
+	}
+
+	now := time.Now()
+	resp := &dto.BatchesResponse{
+		Page:    filter.Pagination.Page,
+		Limit:   filter.Pagination.Limit,
+		Batches: make([]*dto.BatchResponse, 0, len(batches)),
+	}
+
+	for _, batch := range batches {
+		resp.Batches = append(resp.Batches, parseBatchToResponse(batch, now))
+	}
+
+	return resp, nil
+}
+
+// GetExpiringBatches возвращает партии товаров на складе, срок годности которых истекает в течение days дней.
+func (s *BatchService) GetExpiringBatches(ctx context.Context, params *dto.Pagination, warehouseID string, days int) (*dto.ExpiringBatchesResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.BatchService.GetExpiringBatches"),
+	)
+
+	now := time.Now()
+	batches, err := s.repo.GetExpiringBatches(ctx, warehouseID, now.AddDate(0, 0, days), params)
+	if err != nil {
+		log.Error("error while getting expiring batches from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.ExpiringBatchesResponse{
+		Page:    params.Page,
+		Limit:   params.Limit,
+		Days:    days,
+		Batches: make([]*dto.BatchResponse, 0, len(batches)),
+	}
+
+	for _, batch := range batches {
+		resp.Batches = append(resp.Batches, parseBatchToResponse(batch, now))
+	}
+
+	return resp, nil
+}
+
+// parseBatchToResponse преобразует партию товара в DTO. Срок годности проверяется на момент now.
+func parseBatchToResponse(batch *domain.Batch, now time.Time) *dto.BatchResponse {
+	return &dto.BatchResponse{
+		BatchID:     batch.ID.String(),
+		WarehouseID: batch.Warehouse.ID.String(),
+		ProductID:   batch.Product.ID.String(),
+		LotNumber:   batch.LotNumber,
+		Quantity:    batch.Quantity,
+		ExpiresAt:   batch.ExpiresAt,
+		ReceivedAt:  batch.ReceivedAt,
+		Expired:     batch.Expired(now),
+	}
+}
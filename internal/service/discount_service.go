@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DiscountService предоставляет методы для работы с правилами скидок.
+type DiscountService struct {
+	repo repository.DiscountRepository
+}
+
+// NewDiscountService создает новый экземпляр DiscountService.
+func NewDiscountService(repo repository.DiscountRepository) *DiscountService {
+	return &DiscountService{
+		repo: repo,
+	}
+}
+
+// CreateDiscountRule создает правило скидки на товар на складе.
+func (s *DiscountService) CreateDiscountRule(ctx context.Context, request *dto.DiscountRuleRequest) (*dto.DiscountRuleResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.DiscountService.CreateDiscountRule"),
+	)
+
+	rule, err := parseDiscountRuleRequestToDomain(uuid.Nil, request)
+	if err != nil {
+		log.Error("error while parsing discount rule request", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.CreateDiscountRule(ctx, rule)
+	if err != nil {
+		log.Error("error while creating discount rule in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseDiscountRuleToResponse(rule, time.Now()), nil
+}
+
+// GetDiscountRule возвращает правило скидки по его идентификатору.
+func (s *DiscountService) GetDiscountRule(ctx context.Context, discountID uuid.UUID) (*dto.DiscountRuleResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.DiscountService.GetDiscountRule"),
+	)
+
+	rule, err := s.repo.GetDiscountRule(ctx, discountID.String())
+	if err != nil {
+		log.Error("error while getting discount rule from repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseDiscountRuleToResponse(rule, time.Now()), nil
+}
+
+// GetDiscountRules возвращает правила скидок с учетом фильтров и пагинации.
+func (s *DiscountService) GetDiscountRules(ctx context.Context, filter *dto.DiscountRuleFilter) (*dto.DiscountRulesResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.DiscountService.GetDiscountRules"),
+	)
+
+	rules, err := s.repo.GetDiscountRules(ctx, filter)
+	if err != nil {
+		log.Error("error while getting discount rules from repository", zap.Error(err))
+		return nil, err
+	}
+
+	now := time.Now()
+	resp := &dto.DiscountRulesResponse{
+		Page:  filter.Pagination.Page,
+		Limit: filter.Pagination.Limit,
+		Rules: make([]*dto.DiscountRuleResponse, 0, len(rules)),
+	}
+
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, parseDiscountRuleToResponse(rule, now))
+	}
+
+	return resp, nil
+}
+
+// UpdateDiscountRule заменяет условия правила скидки.
+func (s *DiscountService) UpdateDiscountRule(ctx context.Context, discountID uuid.UUID, request *dto.DiscountRuleRequest) (*dto.DiscountRuleResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.DiscountService.UpdateDiscountRule"),
+	)
+
+	rule, err := parseDiscountRuleRequestToDomain(discountID, request)
+	if err != nil {
+		log.Error("error while parsing discount rule request", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.UpdateDiscountRule(ctx, rule)
+	if err != nil {
+		log.Error("error while updating discount rule in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseDiscountRuleToResponse(rule, time.Now()), nil
+}
+
+// DeleteDiscountRule удаляет правило скидки.
+func (s *DiscountService) DeleteDiscountRule(ctx context.Context, discountID uuid.UUID) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.DiscountService.DeleteDiscountRule"),
+	)
+
+	err := s.repo.DeleteDiscountRule(ctx, discountID.String())
+	if err != nil {
+		log.Error("error while deleting discount rule from repository", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// parseDiscountRuleRequestToDomain преобразует запрос правила скидки в доменный объект.
+func parseDiscountRuleRequestToDomain(discountID uuid.UUID, req *dto.DiscountRuleRequest) (*domain.DiscountRule, error) {
+	warehouseID, err := uuid.Parse(req.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	productID, err := uuid.Parse(req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &domain.DiscountRule{
+		ID:        discountID,
+		Warehouse: &domain.Warehouse{ID: warehouseID},
+		Product:   &domain.Product{ID: productID},
+		Type:      domain.DiscountType(req.Type),
+		Priority:  req.Priority,
+		Stackable: req.Stackable,
+		ValidFrom: req.ValidFrom,
+		ValidTo:   req.ValidTo,
+	}
+
+	if req.Percent != nil {
+		rule.Percent = *req.Percent
+	}
+	if req.Amount != nil {
+		rule.Amount = *req.Amount
+	}
+
+	return rule, nil
+}
+
+// parseDiscountRuleToResponse преобразует правило скидки в DTO.
+// now используется, чтобы показать, действует ли правило сейчас.
+func parseDiscountRuleToResponse(rule *domain.DiscountRule, now time.Time) *dto.DiscountRuleResponse {
+	resp := &dto.DiscountRuleResponse{
+		DiscountID:  rule.ID.String(),
+		WarehouseID: rule.Warehouse.ID.String(),
+		ProductID:   rule.Product.ID.String(),
+		Type:        string(rule.Type),
+		Priority:    rule.Priority,
+		Stackable:   rule.Stackable,
+		ValidFrom:   rule.ValidFrom,
+		ValidTo:     rule.ValidTo,
+		Active:      rule.ActiveAt(now),
+		CreatedAt:   rule.CreatedAt,
+	}
+
+	switch rule.Type {
+	case domain.DiscountPercent:
+		resp.Percent = &rule.Percent
+	case domain.DiscountFixed:
+		resp.Amount = &rule.Amount
+	}
+
+	return resp
+}
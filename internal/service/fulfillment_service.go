@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// FulfillmentService предоставляет методы для сборки заказа с нескольких складов.
+type FulfillmentService struct {
+	repo            repository.FulfillmentRepository
+	defaultStrategy domain.FulfillmentStrategy
+}
+
+// NewFulfillmentService создает новый экземпляр FulfillmentService.
+//
+// defaultStrategy используется, если стратегия не указана в запросе.
+func NewFulfillmentService(repo repository.FulfillmentRepository, defaultStrategy domain.FulfillmentStrategy) *FulfillmentService {
+	return &FulfillmentService{
+		repo:            repo,
+		defaultStrategy: defaultStrategy,
+	}
+}
+
+// PlanFulfillment рассчитывает, с каких складов будет собран заказ, и стоимость каждой части
+// с учетом скидок и правил ценообразования складов. Товар не покупается и не удерживается.
+func (s *FulfillmentService) PlanFulfillment(ctx context.Context, req *dto.FulfillmentRequest) (*dto.FulfillmentResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.FulfillmentService.PlanFulfillment"),
+	)
+
+	f, err := s.parseFulfillmentRequestToDomain(req)
+	if err != nil {
+		log.Error("error while parsing fulfillment request to domain", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.PlanFulfillment(ctx, f)
+	if err != nil {
+		log.Error("error while planning fulfillment in repository", zap.Error(err))
+		return nil, err
+	}
+
+	for _, cart := range f.Shipments {
+		rules, err := s.repo.GetActivePricingRules(ctx, cart.Warehouse.ID.String())
+		if err != nil {
+			log.Error("error while getting pricing rules from repository", zap.Error(err))
+			return nil, err
+		}
+		cart.ApplyPricingRules(rules)
+	}
+
+	return parseDomainToFulfillmentResponse(f), nil
+}
+
+// Fulfill распределяет заказ по складам и покупает все его части в одной транзакции.
+// Для каждой части создается отдельный заказ.
+func (s *FulfillmentService) Fulfill(ctx context.Context, req *dto.FulfillmentRequest) (*dto.FulfillmentResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.FulfillmentService.Fulfill"),
+	)
+
+	f, err := s.parseFulfillmentRequestToDomain(req)
+	if err != nil {
+		log.Error("error while parsing fulfillment request to domain", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.Fulfill(ctx, f)
+	if err != nil {
+		log.Error("error while fulfilling order in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseDomainToFulfillmentResponse(f), nil
+}
+
+// parseFulfillmentRequestToDomain преобразует запрос на сборку заказа в домен.
+func (s *FulfillmentService) parseFulfillmentRequestToDomain(req *dto.FulfillmentRequest) (*domain.Fulfillment, error) {
+	f := &domain.Fulfillment{
+		Strategy: s.defaultStrategy,
+	}
+
+	if req.Strategy != "" {
+		strategy, err := domain.ParseFulfillmentStrategy(req.Strategy)
+		if err != nil {
+			return nil, err
+		}
+		f.Strategy = strategy
+	}
+
+	if req.PreferredWarehouseID != "" {
+		warehouseID, err := uuid.Parse(req.PreferredWarehouseID)
+		if err != nil {
+			return nil, err
+		}
+		f.Preferred = &domain.Warehouse{ID: warehouseID}
+	}
+
+	if req.Latitude != nil && req.Longitude != nil {
+		f.Destination = &domain.Location{
+			Latitude:  *req.Latitude,
+			Longitude: *req.Longitude,
+		}
+	}
+
+	for _, v := range req.Products {
+		item, err := parseProductFromCartToDomain(v, nil)
+		if err != nil {
+			return nil, err
+		}
+		f.Items = append(f.Items, item)
+	}
+
+	return f, nil
+}
+
+// parseDomainToFulfillmentResponse преобразует план сборки заказа в ответ.
+//
+// Каждая часть заказа выводится как корзина склада, итог к оплате суммируется по всем частям.
+func parseDomainToFulfillmentResponse(f *domain.Fulfillment) *dto.FulfillmentResponse {
+	resp := &dto.FulfillmentResponse{
+		Strategy:  string(f.Strategy),
+		Shipments: make([]*dto.ShipmentResponse, 0, len(f.Shipments)),
+	}
+
+	for _, cart := range f.Shipments {
+		shipment := &dto.ShipmentResponse{
+			WarehouseID:      cart.Warehouse.ID.String(),
+			WarehouseAddress: cart.Warehouse.Address,
+			CartResponse:     parseDomainToCartResponse(cart),
+		}
+
+		if cart.OrderID != uuid.Nil {
+			shipment.OrderID = cart.OrderID.String()
+		}
+
+		if f.Destination != nil && cart.Warehouse.Location != nil {
+			distance := math.Round(f.Destination.DistanceTo(cart.Warehouse.Location)*100) / 100
+			shipment.DistanceKM = &distance
+		}
+
+		resp.Shipments = append(resp.Shipments, shipment)
+		resp.TotalToPay += shipment.TotalToPay
+	}
+
+	return resp
+}
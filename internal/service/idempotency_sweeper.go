@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// IdempotencySweeper периодически удаляет устаревшие ключи идемпотентности.
+type IdempotencySweeper struct {
+	repo     repository.IdempotencyRepository
+	interval time.Duration
+	keyTTL   time.Duration
+}
+
+// NewIdempotencySweeper создает новый экземпляр IdempotencySweeper.
+// interval задает периодичность проверки, keyTTL - время хранения ключа после захвата.
+//
+// Если interval или keyTTL не положительные, то возвращает ошибку.
+func NewIdempotencySweeper(repo repository.IdempotencyRepository, interval, keyTTL time.Duration) (*IdempotencySweeper, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("idempotency sweep interval must be positive, got %s", interval)
+	}
+
+	if keyTTL <= 0 {
+		return nil, fmt.Errorf("idempotency key TTL must be positive, got %s", keyTTL)
+	}
+
+	return &IdempotencySweeper{
+		repo:     repo,
+		interval: interval,
+		keyTTL:   keyTTL,
+	}, nil
+}
+
+// Run удаляет устаревшие ключи идемпотентности, пока не будет отменен контекст.
+func (s *IdempotencySweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sweep(ctx)
+		}
+	}
+}
+
+// sweep удаляет устаревшие ключи идемпотентности один раз.
+func (s *IdempotencySweeper) sweep(ctx context.Context) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.IdempotencySweeper.sweep"),
+	)
+
+	purged, err := s.repo.PurgeIdempotencyKeys(ctx, s.keyTTL)
+	if err != nil {
+		log.Error("error while purging idempotency keys", zap.Error(err))
+		return
+	}
+
+	if purged > 0 {
+		log.Info("idempotency keys purged", zap.Int("count", purged))
+	}
+}
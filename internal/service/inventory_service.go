@@ -3,10 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
-	"github.com/PIRSON21/mediasoft-intership2025/internal/handler"
 	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
 	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
 	"github.com/google/uuid"
@@ -15,17 +15,19 @@ import (
 
 // InventoryService предоставляет методы для работы с инвентаризацией.
 type InventoryService struct {
-	analytics handler.AnalyticsService
-	repo      repository.InventoryRepository
-	host      string
+	repo           repository.InventoryRepository
+	host           string
+	reservationTTL time.Duration
 }
 
 // NewInventoryService создает новый экземпляр InventoryService.
-func NewInventoryService(repo repository.InventoryRepository, analytics handler.AnalyticsService, host string) *InventoryService {
+//
+// reservationTTL задает срок, на который удерживаются товары при расчете корзины.
+func NewInventoryService(repo repository.InventoryRepository, host string, reservationTTL time.Duration) *InventoryService {
 	return &InventoryService{
-		analytics: analytics,
-		repo:      repo,
-		host:      host,
+		repo:           repo,
+		host:           host,
+		reservationTTL: reservationTTL,
 	}
 }
 
@@ -69,6 +71,8 @@ func parseInventoryRequestToDomain(req *dto.InventoryCreateRequest) (*domain.Inv
 		},
 		ProductCount: *req.Count,
 		ProductPrice: *req.Price,
+		Serials:      req.Serials,
+		UnitCost:     req.UnitCost,
 	}, nil
 }
 
@@ -111,9 +115,179 @@ func parseChangeProductCountRequestToDomain(req *dto.ChangeProductCountRequest)
 			ID: warehouseID,
 		},
 		ProductCount: *req.Count,
+		Serials:      req.Serials,
+		UnitCost:     req.UnitCost,
 	}, nil
 }
 
+// SetStockThresholds задает минимальное количество товара на складе и количество для дозаказа.
+func (s *InventoryService) SetStockThresholds(ctx context.Context, request *dto.StockThresholdsRequest) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.InventoryService.SetStockThresholds"),
+	)
+
+	productID, err := uuid.Parse(request.ProductID)
+	if err != nil {
+		log.Error("error while parsing product ID", zap.Error(err))
+		return err
+	}
+
+	warehouseID, err := uuid.Parse(request.WarehouseID)
+	if err != nil {
+		log.Error("error while parsing warehouse ID", zap.Error(err))
+		return err
+	}
+
+	inventory := &domain.Inventory{
+		Product: &domain.Product{
+			ID: productID,
+		},
+		Warehouse: &domain.Warehouse{
+			ID: warehouseID,
+		},
+		MinQuantity:     *request.MinQuantity,
+		ReorderQuantity: *request.ReorderQuantity,
+	}
+
+	err = s.repo.SetStockThresholds(ctx, inventory)
+	if err != nil {
+		log.Error("error while setting stock thresholds in repository", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetLowStockProducts возвращает товары склада, которые нужно дозаказать, с пагинацией.
+func (s *InventoryService) GetLowStockProducts(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.LowStockResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.InventoryService.GetLowStockProducts"),
+	)
+
+	products, err := s.repo.GetLowStockProducts(ctx, params, warehouseID)
+	if err != nil {
+		log.Error("error while getting low stock products from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.LowStockResponse{
+		Page:     params.Page,
+		Limit:    params.Limit,
+		Products: make([]*dto.LowStockProductResponse, 0, len(products)),
+	}
+
+	for _, inv := range products {
+		resp.Products = append(resp.Products, &dto.LowStockProductResponse{
+			ProductID:       inv.Product.ID.String(),
+			ProductName:     inv.Product.Name,
+			ProductCount:    inv.ProductCount,
+			MinQuantity:     inv.MinQuantity,
+			ReorderQuantity: inv.ReorderQuantity,
+		})
+	}
+
+	return resp, nil
+}
+
+// ChangeProductPrice изменяет цену и скидку товара на складе и возвращает запись истории цен.
+func (s *InventoryService) ChangeProductPrice(ctx context.Context, request *dto.ChangeProductPriceRequest) (*dto.PriceChangeResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.InventoryService.ChangeProductPrice"),
+	)
+
+	change, err := parseChangeProductPriceRequestToDomain(request)
+	if err != nil {
+		log.Error("error while parsing request", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.ChangeProductPrice(ctx, change, request.Sale)
+	if err != nil {
+		log.Error("error while changing product price in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parsePriceChangeToResponse(change), nil
+}
+
+// parseChangeProductPriceRequestToDomain преобразует запрос на изменение цены товара в доменный объект.
+func parseChangeProductPriceRequestToDomain(req *dto.ChangeProductPriceRequest) (*domain.PriceChange, error) {
+	productID, err := uuid.Parse(req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	warehouseID, err := uuid.Parse(req.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PriceChange{
+		Product: &domain.Product{
+			ID: productID,
+		},
+		Warehouse: &domain.Warehouse{
+			ID: warehouseID,
+		},
+		NewPrice:  *req.Price,
+		ChangedBy: req.ChangedBy,
+	}, nil
+}
+
+// ReceiveBatch принимает партию товара на склад и возвращает принятую партию.
+func (s *InventoryService) ReceiveBatch(ctx context.Context, request *dto.BatchRequest) (*dto.BatchResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.InventoryService.ReceiveBatch"),
+	)
+
+	batch, err := parseBatchRequestToDomain(request)
+	if err != nil {
+		log.Error("error while parsing request", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.ReceiveBatch(ctx, batch)
+	if err != nil {
+		log.Error("error while receiving batch in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseBatchToResponse(batch, time.Now()), nil
+}
+
+// parseBatchRequestToDomain преобразует запрос на приемку партии товара в доменный объект.
+func parseBatchRequestToDomain(req *dto.BatchRequest) (*domain.Batch, error) {
+	productID, err := uuid.Parse(req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	warehouseID, err := uuid.Parse(req.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &domain.Batch{
+		Product: &domain.Product{
+			ID: productID,
+		},
+		Warehouse: &domain.Warehouse{
+			ID: warehouseID,
+		},
+		LotNumber: req.LotNumber,
+		Quantity:  *req.Quantity,
+		ExpiresAt: req.ExpiresAt,
+		Serials:   req.Serials,
+		UnitCost:  req.UnitCost,
+	}
+
+	if req.ReceivedAt != nil {
+		batch.ReceivedAt = *req.ReceivedAt
+	}
+
+	return batch, nil
+}
+
 // AddDiscountToProduct добавляет скидки на товары в инвентаре.
 func (s *InventoryService) AddDiscountToProduct(ctx context.Context, request *dto.DiscountToProductRequest) error {
 	log := logger.GetLogger().With(
@@ -229,16 +403,16 @@ func (s *InventoryService) parseProductFromWarehouseToResponse(inv *domain.Inven
 
 	response.ProductParams = copyMap(inv.Product.Params)
 
-	if inv.ProductSale == 0 {
-		response.ProductPriceWithSale = inv.ProductPrice
-	} else {
-		response.ProductPriceWithSale = inv.ProductPrice * (1 - float64(inv.ProductSale)/100)
-	}
+	response.ProductPriceWithSale = inv.PriceWithDiscount()
 
 	return response
 }
 
-// CalculateCart рассчитывает стоимость товаров в корзине с учетом скидок.
+// CalculateCart рассчитывает стоимость товаров в корзине с учетом скидок,
+// действующих в момент расчета, и правил ценообразования склада.
+//
+// Если в запросе указано резервирование, то товары удерживаются на складе
+// до истечения срока резерва, а его идентификатор возвращается в ответе.
 func (s *InventoryService) CalculateCart(ctx context.Context, cartReq *dto.CartRequest) (*dto.CartResponse, error) {
 	log := logger.GetLogger().With(
 		zap.String("op", "service.InventoryService.CalculateCart"),
@@ -251,41 +425,87 @@ func (s *InventoryService) CalculateCart(ctx context.Context, cartReq *dto.CartR
 	}
 	log.Debug("parsed cart request to domain", zap.Any("cart", cart))
 
-	err = s.repo.GetPriceAndDiscount(ctx, cart)
+	err = s.repo.GetPriceAndDiscount(ctx, cart.Items)
 	if err != nil {
 		log.Error("error while getting price and discount from repository", zap.Error(err))
 		return nil, err
 	}
 	log.Debug("got price and discount for cart", zap.Any("cart", cart))
 
+	rules, err := s.repo.GetActivePricingRules(ctx, cart.Warehouse.ID.String())
+	if err != nil {
+		log.Error("error while getting pricing rules from repository", zap.Error(err))
+		return nil, err
+	}
+	cart.ApplyPricingRules(rules)
+
+	if cart.PromoCode != nil {
+		err = s.repo.ApplyPromoCode(ctx, cart)
+		if err != nil {
+			log.Error("error while applying promo code", zap.Error(err))
+			return nil, err
+		}
+	}
+
 	resp := parseDomainToCartResponse(cart)
 	log.Debug("parsed domain to cart response", zap.Any("response", resp))
 
+	if cartReq.Reserve {
+		reservation := &domain.Reservation{
+			Warehouse: cart.Warehouse,
+			Items:     cart.Items,
+			ExpiresAt: time.Now().Add(s.reservationTTL),
+		}
+
+		err = s.repo.CreateReservation(ctx, reservation)
+		if err != nil {
+			log.Error("error while creating reservation in repository", zap.Error(err))
+			return nil, err
+		}
+
+		resp.ReservationID = reservation.ID.String()
+		resp.ReservationExpiresAt = &reservation.ExpiresAt
+	}
+
 	return resp, nil
 }
 
-// parseCartRequestToDomain преобразует запрос корзины в список доменов.
-func parseCartRequestToDomain(req *dto.CartRequest) ([]*domain.Inventory, error) {
-	var inv []*domain.Inventory
-
+// parseCartRequestToDomain преобразует запрос корзины в доменную корзину.
+func parseCartRequestToDomain(req *dto.CartRequest) (*domain.Cart, error) {
 	warehouseID, err := uuid.Parse(req.WarehouseID)
 	if err != nil {
 		return nil, err
 	}
-	warehouse := &domain.Warehouse{
-		ID: warehouseID,
+
+	cart := &domain.Cart{
+		Warehouse: &domain.Warehouse{
+			ID: warehouseID,
+		},
+	}
+
+	if req.ReservationID != "" {
+		cart.ReservationID, err = uuid.Parse(req.ReservationID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.PromoCode != "" {
+		cart.PromoCode = &domain.PromoCode{
+			Code: req.PromoCode,
+		}
 	}
 
 	for _, v := range req.Products {
-		product, err := parseProductFromCartToDomain(v, warehouse)
+		product, err := parseProductFromCartToDomain(v, cart.Warehouse)
 		if err != nil {
 			return nil, err
 		}
 
-		inv = append(inv, product)
+		cart.Items = append(cart.Items, product)
 	}
 
-	return inv, nil
+	return cart, nil
 }
 
 // parseProductFromCartToDomain преобразует корзину запроса в домен.
@@ -304,28 +524,40 @@ func parseProductFromCartToDomain(prod *dto.ProductInCartRequest, warehouse *dom
 	}, nil
 }
 
-// parseDomainToCartResponse преобразует список инвентарей в ответ корзины.
-func parseDomainToCartResponse(invs []*domain.Inventory) *dto.CartResponse {
+// parseDomainToCartResponse преобразует корзину в ответ.
+//
+// Экономия на скидках товаров и правилах ценообразования и экономия на промокоде выводятся отдельно.
+// Для каждого товара перечисляются сработавшие правила ценообразования.
+func parseDomainToCartResponse(cart *domain.Cart) *dto.CartResponse {
 	var (
 		resp               dto.CartResponse
-		totalPrice         float64
-		totalDiscountPrice float64
+		totalPrice         domain.Money
+		totalDiscountPrice domain.Money
 	)
 
-	for _, inv := range invs {
-		discountPrice := inv.ProductPrice
-		if inv.ProductSale != 0 {
-			discountPrice = inv.ProductPrice - (inv.ProductPrice * float64(inv.ProductSale) / 100)
-		}
-
-		fullPrice := inv.ProductPrice * float64(inv.ProductCount)
-		discountFullPrice := discountPrice * float64(inv.ProductCount)
+	for _, inv := range cart.Items {
+		fullPrice := inv.ProductPrice.Mul(inv.ProductCount)
+		discountFullPrice := inv.PriceWithDiscount().Mul(inv.ProductCount) - inv.RuleDiscount()
 
 		prod := &dto.ProductInCartResponse{
 			ProductID:         inv.Product.ID.String(),
 			Count:             inv.ProductCount,
 			FullPrice:         fullPrice,
 			PriceWithDiscount: discountFullPrice,
+			RuleDiscount:      inv.RuleDiscount(),
+			Serials:           inv.Serials,
+			BackorderedCount:  inv.Backordered,
+		}
+		for _, rule := range inv.AppliedDiscounts() {
+			prod.AppliedDiscounts = append(prod.AppliedDiscounts, rule.ID.String())
+		}
+		for _, adj := range inv.Adjustments {
+			prod.PricingRules = append(prod.PricingRules, &dto.AppliedPricingRuleResponse{
+				RuleID:   adj.Rule.ID.String(),
+				Name:     adj.Rule.Name,
+				Type:     string(adj.Rule.Type),
+				Discount: adj.Discount,
+			})
 		}
 		resp.Products = append(resp.Products, prod)
 
@@ -335,46 +567,66 @@ func parseDomainToCartResponse(invs []*domain.Inventory) *dto.CartResponse {
 
 	resp.TotalProductPrice = totalPrice
 	resp.TotalProductPriceWithDiscount = totalDiscountPrice
+	resp.DiscountSavings = totalPrice - totalDiscountPrice
+
+	if cart.PromoCode != nil {
+		resp.PromoCode = cart.PromoCode.Code
+		resp.PromoSavings = cart.PromoDiscount
+	}
+	resp.TotalToPay = totalDiscountPrice - resp.PromoSavings
 
 	return &resp
 }
 
-// GetProductsAtWarehouse получает список товаров на складе с пагинацией.
-func (s *InventoryService) GetProductsAtWarehouse(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.ProductsResponse, error) {
+// GetProductsAtWarehouse получает страницу товаров на складе с фильтрами и сортировкой.
+func (s *InventoryService) GetProductsAtWarehouse(ctx context.Context, filter *dto.StockFilter) (*dto.ProductsResponse, error) {
 	log := logger.GetLogger().With(
 		zap.String("op", "service.InventoryService.GetProducts"),
 	)
 
-	products, err := s.repo.GetProductsAtWarehouse(ctx, params, warehouseID)
+	listing := &domain.StockListing{
+		Sort:       filter.Sort,
+		Desc:       filter.Desc,
+		InStock:    filter.InStock,
+		Discounted: filter.Discounted,
+		MinPrice:   filter.MinPrice,
+		MaxPrice:   filter.MaxPrice,
+		After:      filter.Cursor,
+		Offset:     filter.Pagination.Offset,
+		Limit:      filter.Pagination.Limit,
+	}
+
+	page, err := s.repo.GetProductsAtWarehouse(ctx, filter.WarehouseID, listing)
 	if err != nil {
 		log.Error("error while getting products from repository", zap.Error(err))
 		return nil, err
 	}
 
-	resp := parseProductsToResponse(products, params)
+	resp := parseProductsToResponse(page, filter.Pagination)
 
 	return resp, nil
 }
 
-// parseProductsToResponse преобразует список инвентарей в ответ с пагинацией.
-func parseProductsToResponse(prods []*domain.Inventory, params *dto.Pagination) *dto.ProductsResponse {
+// parseProductsToResponse преобразует страницу товаров склада в ответ с пагинацией.
+func parseProductsToResponse(page *domain.StockPage, params *dto.Pagination) *dto.ProductsResponse {
 	resp := &dto.ProductsResponse{
 		Page:     params.Page,
 		Limit:    params.Limit,
-		Products: make([]*dto.ProductAtList, 0),
+		Total:    page.Total,
+		Products: make([]*dto.ProductAtList, 0, len(page.Items)),
 	}
 
-	for _, inv := range prods {
-		discountPrice := inv.ProductPrice
-		if inv.ProductSale != 0 {
-			discountPrice = inv.ProductPrice - (inv.ProductPrice * float64(inv.ProductSale) / 100)
-		}
+	if page.Next != nil {
+		resp.NextCursor = page.Next.Encode()
+	}
 
+	for _, inv := range page.Items {
 		prod := dto.ProductAtList{
 			ProductID:                inv.Product.ID.String(),
 			ProductName:              inv.Product.Name,
+			ProductCount:             inv.ProductCount,
 			ProductPrice:             inv.ProductPrice,
-			ProductPriceWithDiscount: discountPrice,
+			ProductPriceWithDiscount: inv.PriceWithDiscount(),
 		}
 		resp.Products = append(resp.Products, &prod)
 	}
@@ -388,21 +640,20 @@ func (s *InventoryService) BuyProducts(ctx context.Context, cart *dto.CartReques
 		zap.String("op", "service.InventoryService.BuyProducts"),
 	)
 
-	invs, err := parseCartRequestToDomain(cart)
+	domainCart, err := parseCartRequestToDomain(cart)
 	if err != nil {
 		log.Error("error while parsing cart to domain", zap.Error(err))
 		return nil, err
 	}
 
-	err = s.repo.BuyProducts(ctx, invs)
+	err = s.repo.BuyProducts(ctx, domainCart)
 	if err != nil {
 		log.Error("error while changing products count in repository", zap.Error(err))
 		return nil, err
 	}
 
-	go s.analytics.AddProductSell(invs)
-
-	response := parseDomainToCartResponse(invs)
+	response := parseDomainToCartResponse(domainCart)
+	response.OrderID = domainCart.OrderID.String()
 
 	return response, nil
 }
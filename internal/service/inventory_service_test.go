@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	custErr "github.com/PIRSON21/mediasoft-intership2025/internal/errors"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// priceRepository подменяет изменение цены в репозитории инвентаря:
+// запоминает переданное изменение и заполняет его как репозиторий.
+type priceRepository struct {
+	repository.InventoryRepository
+	err    error
+	change *domain.PriceChange
+	sale   *int
+}
+
+func (r *priceRepository) ChangeProductPrice(_ context.Context, change *domain.PriceChange, sale *int) error {
+	r.change, r.sale = change, sale
+	if r.err != nil {
+		return r.err
+	}
+
+	change.OldPrice = 10000
+	change.NewSale = *sale
+	change.ID = uuid.MustParse("1f0c3f4e-8a52-4c1b-9d3e-6b7a8c9d0e1f")
+	change.ChangedAt = time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	return nil
+}
+
+func TestChangeProductPrice(t *testing.T) {
+	logger.CreateNOPLogger()
+
+	productID := uuid.New()
+	warehouseID := uuid.New()
+	price := domain.Money(12050)
+	sale := 5
+
+	request := &dto.ChangeProductPriceRequest{
+		WarehouseID: warehouseID.String(),
+		ProductID:   productID.String(),
+		Price:       &price,
+		Sale:        &sale,
+		ChangedBy:   "manager",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		repo := &priceRepository{}
+
+		resp, err := NewInventoryService(repo, "", time.Minute).ChangeProductPrice(context.Background(), request)
+		require.NoError(t, err)
+
+		require.Equal(t, productID, repo.change.Product.ID)
+		require.Equal(t, warehouseID, repo.change.Warehouse.ID)
+		require.Equal(t, price, repo.change.NewPrice)
+		require.Equal(t, "manager", repo.change.ChangedBy)
+		require.Equal(t, &sale, repo.sale)
+
+		changedAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+		require.Equal(t, &dto.PriceChangeResponse{
+			HistoryID: "1f0c3f4e-8a52-4c1b-9d3e-6b7a8c9d0e1f",
+			ProductID: productID.String(),
+			OldPrice:  10000,
+			NewPrice:  price,
+			NewSale:   sale,
+			ChangedBy: "manager",
+			ChangedAt: &changedAt,
+		}, resp)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo := &priceRepository{err: custErr.ErrInventoryNotFound}
+
+		resp, err := NewInventoryService(repo, "", time.Minute).ChangeProductPrice(context.Background(), request)
+		require.ErrorIs(t, err, custErr.ErrInventoryNotFound)
+		require.Nil(t, resp)
+	})
+
+	t.Run("wrong product ID", func(t *testing.T) {
+		repo := &priceRepository{}
+		wrong := *request
+		wrong.ProductID = "product"
+
+		_, err := NewInventoryService(repo, "", time.Minute).ChangeProductPrice(context.Background(), &wrong)
+		require.Error(t, err)
+		require.Nil(t, repo.change)
+	})
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// OrderService предоставляет методы для работы с заказами.
+type OrderService struct {
+	repo repository.OrderRepository
+}
+
+// NewOrderService создает новый экземпляр OrderService.
+func NewOrderService(repo repository.OrderRepository) *OrderService {
+	return &OrderService{
+		repo: repo,
+	}
+}
+
+// GetOrder возвращает заказ по его идентификатору.
+func (s *OrderService) GetOrder(ctx context.Context, orderID uuid.UUID) (*dto.OrderResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.OrderService.GetOrder"),
+	)
+
+	order, err := s.repo.GetOrder(ctx, orderID.String())
+	if err != nil {
+		log.Error("error while getting order from repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseOrderToResponse(order), nil
+}
+
+// GetOrders возвращает заказы с учетом фильтров и пагинации.
+func (s *OrderService) GetOrders(ctx context.Context, filter *dto.OrderFilter) (*dto.OrdersResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.OrderService.GetOrders"),
+	)
+
+	orders, err := s.repo.GetOrders(ctx, filter)
+	if err != nil {
+		log.Error("error while getting orders from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.OrdersResponse{
+		Page:   filter.Pagination.Page,
+		Limit:  filter.Pagination.Limit,
+		Orders: make([]*dto.OrderResponse, 0, len(orders)),
+	}
+
+	for _, order := range orders {
+		resp.Orders = append(resp.Orders, parseOrderToResponse(order))
+	}
+
+	return resp, nil
+}
+
+// UpdateOrderStatus переводит заказ в новое состояние.
+func (s *OrderService) UpdateOrderStatus(ctx context.Context, orderID uuid.UUID, request *dto.OrderStatusRequest) (*dto.OrderResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.OrderService.UpdateOrderStatus"),
+	)
+
+	order := &domain.Order{
+		ID:     orderID,
+		Status: domain.OrderStatus(request.Status),
+	}
+
+	err := s.repo.UpdateOrderStatus(ctx, order)
+	if err != nil {
+		log.Error("error while updating order status in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseOrderToResponse(order), nil
+}
+
+// parseOrderToResponse преобразует заказ в DTO.
+func parseOrderToResponse(order *domain.Order) *dto.OrderResponse {
+	resp := &dto.OrderResponse{
+		OrderID:     order.ID.String(),
+		WarehouseID: order.Warehouse.ID.String(),
+		Status:      string(order.Status),
+		Lines:       make([]*dto.OrderLineResponse, 0, len(order.Lines)),
+		CreatedAt:   order.CreatedAt,
+		UpdatedAt:   order.UpdatedAt,
+	}
+
+	for _, line := range order.Lines {
+		fullPrice, discountFullPrice := orderLinePrices(line)
+
+		resp.Lines = append(resp.Lines, &dto.OrderLineResponse{
+			ProductID:             line.Product.ID.String(),
+			Count:                 line.ProductCount,
+			UnitPrice:             line.ProductPrice,
+			UnitPriceWithDiscount: line.DiscountPrice,
+			Discount:              line.ProductSale,
+			FullPrice:             fullPrice,
+			RuleDiscount:          line.RuleDiscount,
+			PriceWithDiscount:     discountFullPrice,
+			ReturnedCount:         line.ReturnedCount,
+			BackorderedCount:      line.Backordered,
+		})
+
+		resp.TotalPrice += fullPrice
+		resp.TotalPriceWithDiscount += discountFullPrice
+	}
+
+	resp.PromoCode = order.PromoCode
+	resp.PromoDiscount = order.PromoDiscount
+	resp.TotalToPay = resp.TotalPriceWithDiscount - order.PromoDiscount
+
+	return resp
+}
+
+// orderLinePrices возвращает полную стоимость строки заказа и стоимость с учетом скидок
+// и правил ценообразования.
+func orderLinePrices(line *domain.OrderLine) (domain.Money, domain.Money) {
+	return line.ProductPrice.Mul(line.ProductCount), line.Total()
+}
+
+// defaultCancelReason - причина, которая записывается при отмене заказа без указания причины.
+const defaultCancelReason = "order cancelled"
+
+// CancelOrder отменяет заказ и возвращает на склад его товары.
+//
+// Если причина отмены не указана, то записывается причина по умолчанию.
+func (s *OrderService) CancelOrder(ctx context.Context, orderID uuid.UUID, request *dto.OrderCancelRequest) (*dto.OrderReturnResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.OrderService.CancelOrder"),
+	)
+
+	ret := &domain.OrderReturn{
+		Order:      &domain.Order{ID: orderID},
+		Reason:     request.Reason,
+		Quarantine: request.Quarantine,
+	}
+	if ret.Reason == "" {
+		ret.Reason = defaultCancelReason
+	}
+
+	err := s.repo.CancelOrder(ctx, ret)
+	if err != nil {
+		log.Error("error while cancelling order in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseOrderReturnToResponse(ret), nil
+}
+
+// ReturnOrderProducts возвращает часть товаров заказа на склад.
+func (s *OrderService) ReturnOrderProducts(ctx context.Context, orderID uuid.UUID, request *dto.OrderReturnRequest) (*dto.OrderReturnResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.OrderService.ReturnOrderProducts"),
+	)
+
+	ret, err := parseOrderReturnRequestToDomain(orderID, request)
+	if err != nil {
+		log.Error("error while parsing return request", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.ReturnOrderProducts(ctx, ret)
+	if err != nil {
+		log.Error("error while returning products in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseOrderReturnToResponse(ret), nil
+}
+
+// parseOrderReturnRequestToDomain преобразует запрос на возврат в доменный объект.
+func parseOrderReturnRequestToDomain(orderID uuid.UUID, req *dto.OrderReturnRequest) (*domain.OrderReturn, error) {
+	ret := &domain.OrderReturn{
+		Order:      &domain.Order{ID: orderID},
+		Reason:     req.Reason,
+		Quarantine: req.Quarantine,
+		Lines:      make([]*domain.OrderLine, 0, len(req.Products)),
+	}
+
+	for _, p := range req.Products {
+		productID, err := uuid.Parse(p.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		ret.Lines = append(ret.Lines, &domain.OrderLine{
+			Product:      &domain.Product{ID: productID},
+			ProductCount: *p.Count,
+			Serials:      p.Serials,
+		})
+	}
+
+	return ret, nil
+}
+
+// parseOrderReturnToResponse преобразует возврат в DTO.
+func parseOrderReturnToResponse(ret *domain.OrderReturn) *dto.OrderReturnResponse {
+	resp := &dto.OrderReturnResponse{
+		ReturnID:   ret.ID.String(),
+		Reason:     ret.Reason,
+		Quarantine: ret.Quarantine,
+		Products:   make([]*dto.OrderReturnProductResponse, 0, len(ret.Lines)),
+		CreatedAt:  ret.CreatedAt,
+		Order:      parseOrderToResponse(ret.Order),
+	}
+
+	for _, line := range ret.Lines {
+		_, refund := orderLinePrices(line)
+
+		resp.Products = append(resp.Products, &dto.OrderReturnProductResponse{
+			ProductID: line.Product.ID.String(),
+			Count:     line.ProductCount,
+			Refund:    refund,
+			Serials:   line.Serials,
+		})
+		resp.RefundAmount += refund
+	}
+
+	// скидка по промокоду распределяется между товарами заказа, поэтому возвращается
+	// стоимость товаров за вычетом их части скидки.
+	resp.PromoDeduction = ret.Order.PromoShare(resp.RefundAmount)
+	resp.RefundAmount -= resp.PromoDeduction
+
+	return resp
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PriceHistoryService предоставляет методы для работы с историей цен товаров.
+type PriceHistoryService struct {
+	repo repository.PriceHistoryRepository
+}
+
+// NewPriceHistoryService создает новый экземпляр PriceHistoryService.
+func NewPriceHistoryService(repo repository.PriceHistoryRepository) *PriceHistoryService {
+	return &PriceHistoryService{
+		repo: repo,
+	}
+}
+
+// GetPriceHistory возвращает изменения цен товаров на складе с учетом фильтров и пагинации.
+func (s *PriceHistoryService) GetPriceHistory(ctx context.Context, filter *dto.PriceHistoryFilter) (*dto.PriceHistoryResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PriceHistoryService.GetPriceHistory"),
+	)
+
+	changes, err := s.repo.GetPriceHistory(ctx, filter)
+	if err != nil {
+		log.Error("error while getting price history from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.PriceHistoryResponse{
+		Page:    filter.Pagination.Page,
+		Limit:   filter.Pagination.Limit,
+		Changes: make([]*dto.PriceChangeResponse, 0, len(changes)),
+	}
+
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, parsePriceChangeToResponse(change))
+	}
+
+	return resp, nil
+}
+
+// parsePriceChangeToResponse преобразует изменение цены в DTO.
+func parsePriceChangeToResponse(change *domain.PriceChange) *dto.PriceChangeResponse {
+	resp := &dto.PriceChangeResponse{
+		ProductID: change.Product.ID.String(),
+		OldPrice:  change.OldPrice,
+		NewPrice:  change.NewPrice,
+		OldSale:   change.OldSale,
+		NewSale:   change.NewSale,
+		ChangedBy: change.ChangedBy,
+		RequestID: change.RequestID,
+	}
+
+	if change.ID != uuid.Nil {
+		resp.HistoryID = change.ID.String()
+		resp.ChangedAt = &change.ChangedAt
+	}
+
+	return resp
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PricingRuleService предоставляет методы для работы с правилами ценообразования.
+type PricingRuleService struct {
+	repo repository.PricingRuleRepository
+}
+
+// NewPricingRuleService создает новый экземпляр PricingRuleService.
+func NewPricingRuleService(repo repository.PricingRuleRepository) *PricingRuleService {
+	return &PricingRuleService{
+		repo: repo,
+	}
+}
+
+// CreatePricingRule создает правило ценообразования.
+func (s *PricingRuleService) CreatePricingRule(ctx context.Context, request *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PricingRuleService.CreatePricingRule"),
+	)
+
+	rule, err := parsePricingRuleRequestToDomain(uuid.Nil, request)
+	if err != nil {
+		log.Error("error while parsing pricing rule request", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.CreatePricingRule(ctx, rule)
+	if err != nil {
+		log.Error("error while creating pricing rule in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parsePricingRuleToResponse(rule), nil
+}
+
+// GetPricingRule возвращает правило ценообразования по его идентификатору.
+func (s *PricingRuleService) GetPricingRule(ctx context.Context, ruleID uuid.UUID) (*dto.PricingRuleResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PricingRuleService.GetPricingRule"),
+	)
+
+	rule, err := s.repo.GetPricingRule(ctx, ruleID.String())
+	if err != nil {
+		log.Error("error while getting pricing rule from repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parsePricingRuleToResponse(rule), nil
+}
+
+// GetPricingRules возвращает правила ценообразования с учетом фильтров и пагинации.
+func (s *PricingRuleService) GetPricingRules(ctx context.Context, filter *dto.PricingRuleFilter) (*dto.PricingRulesResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PricingRuleService.GetPricingRules"),
+	)
+
+	rules, err := s.repo.GetPricingRules(ctx, filter)
+	if err != nil {
+		log.Error("error while getting pricing rules from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.PricingRulesResponse{
+		Page:         filter.Pagination.Page,
+		Limit:        filter.Pagination.Limit,
+		PricingRules: make([]*dto.PricingRuleResponse, 0, len(rules)),
+	}
+
+	for _, rule := range rules {
+		resp.PricingRules = append(resp.PricingRules, parsePricingRuleToResponse(rule))
+	}
+
+	return resp, nil
+}
+
+// UpdatePricingRule заменяет условия правила ценообразования.
+func (s *PricingRuleService) UpdatePricingRule(ctx context.Context, ruleID uuid.UUID, request *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PricingRuleService.UpdatePricingRule"),
+	)
+
+	rule, err := parsePricingRuleRequestToDomain(ruleID, request)
+	if err != nil {
+		log.Error("error while parsing pricing rule request", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.UpdatePricingRule(ctx, rule)
+	if err != nil {
+		log.Error("error while updating pricing rule in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parsePricingRuleToResponse(rule), nil
+}
+
+// DeletePricingRule удаляет правило ценообразования.
+func (s *PricingRuleService) DeletePricingRule(ctx context.Context, ruleID uuid.UUID) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PricingRuleService.DeletePricingRule"),
+	)
+
+	err := s.repo.DeletePricingRule(ctx, ruleID.String())
+	if err != nil {
+		log.Error("error while deleting pricing rule from repository", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// parsePricingRuleRequestToDomain преобразует запрос правила ценообразования в доменный объект.
+//
+// Если в запросе не указано, включено ли правило, то правило включается.
+func parsePricingRuleRequestToDomain(ruleID uuid.UUID, req *dto.PricingRuleRequest) (*domain.PricingRule, error) {
+	rule := &domain.PricingRule{
+		ID:       ruleID,
+		Name:     req.Name,
+		Type:     domain.PricingRuleType(req.Type),
+		Priority: req.Priority,
+		Active:   true,
+	}
+
+	if req.Active != nil {
+		rule.Active = *req.Active
+	}
+
+	if req.WarehouseID != "" {
+		warehouseID, err := uuid.Parse(req.WarehouseID)
+		if err != nil {
+			return nil, err
+		}
+		rule.Warehouse = &domain.Warehouse{ID: warehouseID}
+	}
+
+	if req.ProductID != "" {
+		productID, err := uuid.Parse(req.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		rule.ProductID = productID
+	}
+
+	for _, id := range req.ProductIDs {
+		productID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		rule.ProductIDs = append(rule.ProductIDs, productID)
+	}
+
+	if req.MinCount != nil {
+		rule.MinCount = *req.MinCount
+	}
+	if req.UnitPrice != nil {
+		rule.UnitPrice = *req.UnitPrice
+	}
+	if req.Buy != nil {
+		rule.Buy = *req.Buy
+	}
+	if req.Pay != nil {
+		rule.Pay = *req.Pay
+	}
+	if req.Percent != nil {
+		rule.Percent = *req.Percent
+	}
+
+	return rule, nil
+}
+
+// parsePricingRuleToResponse преобразует правило ценообразования в DTO.
+func parsePricingRuleToResponse(rule *domain.PricingRule) *dto.PricingRuleResponse {
+	resp := &dto.PricingRuleResponse{
+		RuleID:    rule.ID.String(),
+		Name:      rule.Name,
+		Type:      string(rule.Type),
+		Priority:  rule.Priority,
+		Active:    rule.Active,
+		CreatedAt: rule.CreatedAt,
+	}
+
+	if rule.Warehouse != nil {
+		resp.WarehouseID = rule.Warehouse.ID.String()
+	}
+	if rule.ProductID != uuid.Nil {
+		resp.ProductID = rule.ProductID.String()
+	}
+	for _, productID := range rule.ProductIDs {
+		resp.ProductIDs = append(resp.ProductIDs, productID.String())
+	}
+
+	switch rule.Type {
+	case domain.PricingTier:
+		resp.MinCount = &rule.MinCount
+		resp.UnitPrice = &rule.UnitPrice
+	case domain.PricingNForM:
+		resp.Buy = &rule.Buy
+		resp.Pay = &rule.Pay
+	case domain.PricingBundle:
+		resp.Percent = &rule.Percent
+	}
+
+	return resp
+}
@@ -25,24 +25,27 @@ func NewProductService(repo repository.ProductRepository, host string) *ProductS
 	return &ProductService{repo: repo, host: host}
 }
 
-// GetProducts возвращает список продуктов с их параметрами.
-func (s *ProductService) GetProducts(ctx context.Context) ([]*dto.ProductAtListResponse, error) {
+// GetProducts возвращает страницу каталога продуктов, подходящих под фильтры, с их параметрами.
+func (s *ProductService) GetProducts(ctx context.Context, filter *dto.ProductFilter) (*dto.ProductListResponse, error) {
 	log := logger.GetLogger().With(zap.String("op", "service.ProductService.GetProduct"))
 
-	products, err := s.repo.GetProducts(ctx)
+	products, total, err := s.repo.GetProducts(ctx, filter)
 	if err != nil {
 		log.Error("error while getting products from repo", zap.String("err", err.Error()))
 		return nil, err
 	}
 
-	productsResponse := s.createProductsResponse(products)
-
-	return productsResponse, nil
+	return &dto.ProductListResponse{
+		Page:     filter.Pagination.Page,
+		Limit:    filter.Pagination.Limit,
+		Total:    total,
+		Products: s.createProductsResponse(products),
+	}, nil
 }
 
 // createProductsResponse преобразует список продуктов в ответ с параметрами.
 func (s *ProductService) createProductsResponse(products []*domain.Product) []*dto.ProductAtListResponse {
-	var response []*dto.ProductAtListResponse
+	response := make([]*dto.ProductAtListResponse, 0, len(products))
 	for _, v := range products {
 		params := copyMap(v.Params)
 		response = append(response, &dto.ProductAtListResponse{
@@ -52,6 +55,8 @@ func (s *ProductService) createProductsResponse(products []*domain.Product) []*d
 			Description: v.Description,
 			Barcode:     s.host + "/static/" + v.Barcode,
 			Params:      params,
+			Serialized:  v.Serialized,
+			ArchivedAt:  v.ArchivedAt,
 		})
 	}
 
@@ -119,6 +124,7 @@ func parseProductFromRequest(req *dto.ProductRequest, filename string) *domain.P
 		Weight:      *req.Weight,
 		Params:      req.Params,
 		Barcode:     filename,
+		Serialized:  req.Serialized,
 	}
 }
 
@@ -174,3 +180,53 @@ func parseProductFromUpdateRequest(req *dto.ProductRequest, filename string) *do
 
 	return &product
 }
+
+// ArchiveProduct переносит продукт в архив. Архивный продукт скрыт из каталога и корзин.
+func (s *ProductService) ArchiveProduct(ctx context.Context, productID uuid.UUID) error {
+	log := logger.GetLogger().With(zap.String("op", "service.ProductService.ArchiveProduct"))
+
+	err := s.repo.ArchiveProduct(ctx, &domain.Product{ID: productID})
+	if err != nil {
+		log.Error("error while archiving product at repository", zap.String("err", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// RestoreProduct возвращает продукт из архива.
+func (s *ProductService) RestoreProduct(ctx context.Context, productID uuid.UUID) error {
+	log := logger.GetLogger().With(zap.String("op", "service.ProductService.RestoreProduct"))
+
+	err := s.repo.RestoreProduct(ctx, &domain.Product{ID: productID})
+	if err != nil {
+		log.Error("error while restoring product at repository", zap.String("err", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// DeleteProduct удаляет продукт и файл его штрихкода.
+//
+// Продукт уже удален, когда удаляется файл, поэтому ошибка удаления файла только записывается в лог.
+func (s *ProductService) DeleteProduct(ctx context.Context, productID uuid.UUID) error {
+	log := logger.GetLogger().With(zap.String("op", "service.ProductService.DeleteProduct"))
+
+	product := &domain.Product{ID: productID}
+
+	err := s.repo.DeleteProduct(ctx, product)
+	if err != nil {
+		log.Error("error while deleting product at repository", zap.String("err", err.Error()))
+		return err
+	}
+
+	if product.Barcode != "" {
+		err = os.Remove(filepath.Join("static", filepath.Base(product.Barcode)))
+		if err != nil && !os.IsNotExist(err) {
+			log.Warn("error while removing barcode file", zap.String("err", err.Error()))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PromoCodeService предоставляет методы для работы с промокодами.
+type PromoCodeService struct {
+	repo repository.PromoCodeRepository
+}
+
+// NewPromoCodeService создает новый экземпляр PromoCodeService.
+func NewPromoCodeService(repo repository.PromoCodeRepository) *PromoCodeService {
+	return &PromoCodeService{
+		repo: repo,
+	}
+}
+
+// CreatePromoCode создает промокод.
+func (s *PromoCodeService) CreatePromoCode(ctx context.Context, request *dto.PromoCodeRequest) (*dto.PromoCodeResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PromoCodeService.CreatePromoCode"),
+	)
+
+	promo, err := parsePromoCodeRequestToDomain(request)
+	if err != nil {
+		log.Error("error while parsing promo code request", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.CreatePromoCode(ctx, promo)
+	if err != nil {
+		log.Error("error while creating promo code in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parsePromoCodeToResponse(promo), nil
+}
+
+// GetPromoCode возвращает промокод по его идентификатору.
+func (s *PromoCodeService) GetPromoCode(ctx context.Context, promoCodeID uuid.UUID) (*dto.PromoCodeResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PromoCodeService.GetPromoCode"),
+	)
+
+	promo, err := s.repo.GetPromoCode(ctx, promoCodeID.String())
+	if err != nil {
+		log.Error("error while getting promo code from repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parsePromoCodeToResponse(promo), nil
+}
+
+// GetPromoCodes возвращает промокоды с учетом фильтров и пагинации.
+func (s *PromoCodeService) GetPromoCodes(ctx context.Context, filter *dto.PromoCodeFilter) (*dto.PromoCodesResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PromoCodeService.GetPromoCodes"),
+	)
+
+	promos, err := s.repo.GetPromoCodes(ctx, filter)
+	if err != nil {
+		log.Error("error while getting promo codes from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.PromoCodesResponse{
+		Page:       filter.Pagination.Page,
+		Limit:      filter.Pagination.Limit,
+		PromoCodes: make([]*dto.PromoCodeResponse, 0, len(promos)),
+	}
+
+	for _, promo := range promos {
+		resp.PromoCodes = append(resp.PromoCodes, parsePromoCodeToResponse(promo))
+	}
+
+	return resp, nil
+}
+
+// DeletePromoCode удаляет промокод.
+func (s *PromoCodeService) DeletePromoCode(ctx context.Context, promoCodeID uuid.UUID) error {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PromoCodeService.DeletePromoCode"),
+	)
+
+	err := s.repo.DeletePromoCode(ctx, promoCodeID.String())
+	if err != nil {
+		log.Error("error while deleting promo code from repository", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// parsePromoCodeRequestToDomain преобразует запрос промокода в доменный объект.
+func parsePromoCodeRequestToDomain(req *dto.PromoCodeRequest) (*domain.PromoCode, error) {
+	promo := &domain.PromoCode{
+		Code:       req.Code,
+		Type:       domain.DiscountType(req.Type),
+		UsageLimit: req.UsageLimit,
+		ExpiresAt:  req.ExpiresAt,
+	}
+
+	if req.WarehouseID != "" {
+		warehouseID, err := uuid.Parse(req.WarehouseID)
+		if err != nil {
+			return nil, err
+		}
+		promo.Warehouse = &domain.Warehouse{ID: warehouseID}
+	}
+
+	if req.Percent != nil {
+		promo.Percent = *req.Percent
+	}
+	if req.Amount != nil {
+		promo.Amount = *req.Amount
+	}
+	if req.MinTotal != nil {
+		promo.MinTotal = *req.MinTotal
+	}
+
+	return promo, nil
+}
+
+// parsePromoCodeToResponse преобразует промокод в DTO.
+func parsePromoCodeToResponse(promo *domain.PromoCode) *dto.PromoCodeResponse {
+	resp := &dto.PromoCodeResponse{
+		PromoCodeID: promo.ID.String(),
+		Code:        promo.Code,
+		Type:        string(promo.Type),
+		MinTotal:    promo.MinTotal,
+		UsageLimit:  promo.UsageLimit,
+		UsedCount:   promo.UsedCount,
+		ExpiresAt:   promo.ExpiresAt,
+		CreatedAt:   promo.CreatedAt,
+	}
+
+	switch promo.Type {
+	case domain.DiscountPercent:
+		resp.Percent = &promo.Percent
+	case domain.DiscountFixed:
+		resp.Amount = &promo.Amount
+	}
+
+	if promo.Warehouse != nil {
+		resp.WarehouseID = promo.Warehouse.ID.String()
+	}
+
+	return resp
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PurchaseOrderService предоставляет методы для работы с заказами поставщикам.
+type PurchaseOrderService struct {
+	repo repository.PurchaseOrderRepository
+}
+
+// NewPurchaseOrderService создает новый экземпляр PurchaseOrderService.
+func NewPurchaseOrderService(repo repository.PurchaseOrderRepository) *PurchaseOrderService {
+	return &PurchaseOrderService{
+		repo: repo,
+	}
+}
+
+// CreatePurchaseOrder создает заказ поставщику.
+func (s *PurchaseOrderService) CreatePurchaseOrder(ctx context.Context, request *dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PurchaseOrderService.CreatePurchaseOrder"),
+	)
+
+	order, err := parsePurchaseOrderRequestToDomain(request)
+	if err != nil {
+		log.Error("error while parsing purchase order request", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.CreatePurchaseOrder(ctx, order)
+	if err != nil {
+		log.Error("error while creating purchase order in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parsePurchaseOrderToResponse(order), nil
+}
+
+// parsePurchaseOrderRequestToDomain преобразует запрос на создание заказа поставщику в доменный объект.
+func parsePurchaseOrderRequestToDomain(req *dto.PurchaseOrderRequest) (*domain.PurchaseOrder, error) {
+	supplierID, err := uuid.Parse(req.SupplierID)
+	if err != nil {
+		return nil, err
+	}
+
+	warehouseID, err := uuid.Parse(req.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &domain.PurchaseOrder{
+		Supplier:  &domain.Supplier{ID: supplierID},
+		Warehouse: &domain.Warehouse{ID: warehouseID},
+		Lines:     make([]*domain.PurchaseOrderLine, 0, len(req.Lines)),
+	}
+
+	for _, line := range req.Lines {
+		productID, err := uuid.Parse(line.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		order.Lines = append(order.Lines, &domain.PurchaseOrderLine{
+			Product:      &domain.Product{ID: productID},
+			OrderedCount: *line.Count,
+			UnitCost:     *line.UnitCost,
+			ExpectedAt:   line.ExpectedAt,
+		})
+	}
+
+	return order, nil
+}
+
+// GetPurchaseOrder возвращает заказ поставщику по его идентификатору.
+func (s *PurchaseOrderService) GetPurchaseOrder(ctx context.Context, orderID uuid.UUID) (*dto.PurchaseOrderResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PurchaseOrderService.GetPurchaseOrder"),
+	)
+
+	order, err := s.repo.GetPurchaseOrder(ctx, orderID.String())
+	if err != nil {
+		log.Error("error while getting purchase order from repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parsePurchaseOrderToResponse(order), nil
+}
+
+// GetPurchaseOrders возвращает заказы поставщикам с фильтрацией и пагинацией.
+func (s *PurchaseOrderService) GetPurchaseOrders(ctx context.Context, filter *dto.PurchaseOrderFilter) (*dto.PurchaseOrdersResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PurchaseOrderService.GetPurchaseOrders"),
+	)
+
+	orders, err := s.repo.GetPurchaseOrders(ctx, filter)
+	if err != nil {
+		log.Error("error while getting purchase orders from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.PurchaseOrdersResponse{
+		Page:           filter.Pagination.Page,
+		Limit:          filter.Pagination.Limit,
+		PurchaseOrders: make([]*dto.PurchaseOrderResponse, 0, len(orders)),
+	}
+
+	for _, order := range orders {
+		resp.PurchaseOrders = append(resp.PurchaseOrders, parsePurchaseOrderToResponse(order))
+	}
+
+	return resp, nil
+}
+
+// ReceivePurchaseOrder принимает товары по заказу поставщику на склад.
+func (s *PurchaseOrderService) ReceivePurchaseOrder(ctx context.Context, orderID uuid.UUID, request *dto.PurchaseOrderReceiptRequest) (*dto.PurchaseOrderResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PurchaseOrderService.ReceivePurchaseOrder"),
+	)
+
+	receipt := &domain.PurchaseOrderReceipt{
+		Order: &domain.PurchaseOrder{ID: orderID},
+		Lines: make([]*domain.PurchaseOrderReceiptLine, 0, len(request.Lines)),
+	}
+
+	for _, line := range request.Lines {
+		productID, err := uuid.Parse(line.ProductID)
+		if err != nil {
+			log.Error("error while parsing product ID", zap.Error(err))
+			return nil, err
+		}
+
+		receipt.Lines = append(receipt.Lines, &domain.PurchaseOrderReceiptLine{
+			Product:   &domain.Product{ID: productID},
+			Count:     *line.Count,
+			LotNumber: line.LotNumber,
+			ExpiresAt: line.ExpiresAt,
+			Serials:   line.Serials,
+		})
+	}
+
+	err := s.repo.ReceivePurchaseOrder(ctx, receipt)
+	if err != nil {
+		log.Error("error while receiving purchase order in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parsePurchaseOrderToResponse(receipt.Order), nil
+}
+
+// CancelPurchaseOrder отменяет заказ поставщику.
+func (s *PurchaseOrderService) CancelPurchaseOrder(ctx context.Context, orderID uuid.UUID) (*dto.PurchaseOrderResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.PurchaseOrderService.CancelPurchaseOrder"),
+	)
+
+	order := &domain.PurchaseOrder{ID: orderID}
+
+	err := s.repo.CancelPurchaseOrder(ctx, order)
+	if err != nil {
+		log.Error("error while cancelling purchase order in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parsePurchaseOrderToResponse(order), nil
+}
+
+// parsePurchaseOrderToResponse преобразует заказ поставщику в DTO.
+func parsePurchaseOrderToResponse(order *domain.PurchaseOrder) *dto.PurchaseOrderResponse {
+	resp := &dto.PurchaseOrderResponse{
+		PurchaseOrderID: order.ID.String(),
+		SupplierID:      order.Supplier.ID.String(),
+		WarehouseID:     order.Warehouse.ID.String(),
+		Status:          string(order.Status),
+		Lines:           make([]*dto.PurchaseOrderLineResponse, 0, len(order.Lines)),
+		TotalCost:       order.Total(),
+		CreatedAt:       order.CreatedAt,
+		UpdatedAt:       order.UpdatedAt,
+	}
+
+	for _, line := range order.Lines {
+		resp.Lines = append(resp.Lines, &dto.PurchaseOrderLineResponse{
+			ProductID:     line.Product.ID.String(),
+			OrderedCount:  line.OrderedCount,
+			ReceivedCount: line.ReceivedCount,
+			UnitCost:      line.UnitCost,
+			ExpectedAt:    line.ExpectedAt,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ReservationSweeper периодически освобождает просроченные резервы товаров.
+type ReservationSweeper struct {
+	repo     repository.ReservationRepository
+	interval time.Duration
+}
+
+// NewReservationSweeper создает новый экземпляр ReservationSweeper.
+// interval задает периодичность проверки просроченных резервов.
+//
+// Если interval не положительный, то возвращает ошибку.
+func NewReservationSweeper(repo repository.ReservationRepository, interval time.Duration) (*ReservationSweeper, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("reservation sweep interval must be positive, got %s", interval)
+	}
+
+	return &ReservationSweeper{
+		repo:     repo,
+		interval: interval,
+	}, nil
+}
+
+// Run освобождает просроченные резервы, пока не будет отменен контекст.
+func (s *ReservationSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sweep(ctx)
+		}
+	}
+}
+
+// sweep освобождает просроченные резервы один раз.
+func (s *ReservationSweeper) sweep(ctx context.Context) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.ReservationSweeper.sweep"),
+	)
+
+	released, err := s.repo.ReleaseExpiredReservations(ctx)
+	if err != nil {
+		log.Error("error while releasing expired reservations", zap.Error(err))
+		return
+	}
+
+	if released > 0 {
+		log.Info("expired reservations released", zap.Int("count", released))
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReservationSweeper(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		wantErr  bool
+	}{
+		{name: "positive interval", interval: time.Minute},
+		{name: "zero interval", interval: 0, wantErr: true},
+		{name: "negative interval", interval: -time.Second, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sweeper, err := NewReservationSweeper(nil, tt.interval)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, sweeper)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, sweeper)
+		})
+	}
+}
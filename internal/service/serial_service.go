@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SerialService предоставляет методы для работы с серийными номерами товаров.
+type SerialService struct {
+	repo repository.SerialRepository
+}
+
+// NewSerialService создает новый экземпляр SerialService.
+func NewSerialService(repo repository.SerialRepository) *SerialService {
+	return &SerialService{
+		repo: repo,
+	}
+}
+
+// GetSerial возвращает единицы товаров с серийным номером serialNumber и их местоположение.
+func (s *SerialService) GetSerial(ctx context.Context, serialNumber string) (*dto.SerialLookupResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.SerialService.GetSerial"),
+	)
+
+	serials, err := s.repo.GetSerials(ctx, serialNumber)
+	if err != nil {
+		log.Error("error while getting serials from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.SerialLookupResponse{
+		SerialNumber: serialNumber,
+		Units:        make([]*dto.SerialResponse, 0, len(serials)),
+	}
+
+	for _, serial := range serials {
+		unit := &dto.SerialResponse{
+			ProductID:   serial.Product.ID.String(),
+			ProductName: serial.Product.Name,
+			Status:      string(serial.Status),
+			WarehouseID: serial.Warehouse.ID.String(),
+			ReceivedAt:  serial.ReceivedAt,
+			UpdatedAt:   serial.UpdatedAt,
+		}
+		if serial.OrderID != uuid.Nil {
+			unit.OrderID = serial.OrderID.String()
+		}
+		if serial.TransferID != uuid.Nil {
+			unit.TransferID = serial.TransferID.String()
+		}
+
+		resp.Units = append(resp.Units, unit)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/notifier"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// stockAlertDrainTimeout - максимальное время, за которое диспетчер доставляет
+// оставшиеся оповещения при остановке приложения.
+const stockAlertDrainTimeout = 10 * time.Second
+
+// StockAlertDispatcher периодически доставляет оповещения об остатках из outbox.
+type StockAlertDispatcher struct {
+	repo       repository.StockAlertOutboxRepository
+	notifier   notifier.Notifier
+	interval   time.Duration
+	batchSize  int
+	retryDelay time.Duration
+}
+
+// NewStockAlertDispatcher создает новый экземпляр StockAlertDispatcher.
+//
+// Оповещения доставляются через n. interval задает периодичность доставки,
+// batchSize - количество оповещений, доставляемых за одну транзакцию,
+// retryDelay - задержку перед повторной попыткой доставить оповещение.
+//
+// Если interval, batchSize или retryDelay не положительные, то возвращает ошибку.
+func NewStockAlertDispatcher(repo repository.StockAlertOutboxRepository, n notifier.Notifier, interval time.Duration, batchSize int, retryDelay time.Duration) (*StockAlertDispatcher, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("stock alert dispatch interval must be positive, got %s", interval)
+	}
+
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("stock alert dispatch batch size must be positive, got %d", batchSize)
+	}
+
+	if retryDelay <= 0 {
+		return nil, fmt.Errorf("stock alert retry delay must be positive, got %s", retryDelay)
+	}
+
+	return &StockAlertDispatcher{
+		repo:       repo,
+		notifier:   n,
+		interval:   interval,
+		batchSize:  batchSize,
+		retryDelay: retryDelay,
+	}, nil
+}
+
+// Run доставляет оповещения, пока не будет отменен контекст.
+//
+// После отмены контекста доставляет оставшиеся оповещения, чтобы оповещения
+// об операциях, выполненных до остановки приложения, не ждали следующего запуска.
+func (d *StockAlertDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.drain()
+			return
+		case <-ticker.C:
+			d.dispatch(ctx)
+		}
+	}
+}
+
+// drain доставляет оставшиеся оповещения при остановке приложения.
+func (d *StockAlertDispatcher) drain() {
+	ctx, cancel := context.WithTimeout(context.Background(), stockAlertDrainTimeout)
+	defer cancel()
+
+	d.dispatch(ctx)
+}
+
+// dispatch доставляет готовые оповещения партиями, пока они не закончатся.
+func (d *StockAlertDispatcher) dispatch(ctx context.Context) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.StockAlertDispatcher.dispatch"),
+	)
+
+	total := 0
+	for ctx.Err() == nil {
+		dispatched, err := d.repo.DispatchStockAlerts(ctx, d.batchSize, d.retryDelay, d.notifier.Notify)
+		if err != nil {
+			log.Error("error while dispatching stock alerts", zap.Error(err))
+			break
+		}
+
+		total += dispatched
+		if dispatched < d.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Debug("stock alerts dispatched", zap.Int("count", total))
+	}
+}
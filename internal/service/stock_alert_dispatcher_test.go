@@ -0,0 +1,38 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/notifier"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStockAlertDispatcher(t *testing.T) {
+	tests := []struct {
+		name       string
+		interval   time.Duration
+		batchSize  int
+		retryDelay time.Duration
+		wantErr    bool
+	}{
+		{name: "valid settings", interval: time.Second, batchSize: 100, retryDelay: time.Minute},
+		{name: "zero interval", interval: 0, batchSize: 100, retryDelay: time.Minute, wantErr: true},
+		{name: "zero batch size", interval: time.Second, batchSize: 0, retryDelay: time.Minute, wantErr: true},
+		{name: "zero retry delay", interval: time.Second, batchSize: 100, retryDelay: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dispatcher, err := NewStockAlertDispatcher(nil, notifier.NewMemoryNotifier(), tt.interval, tt.batchSize, tt.retryDelay)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, dispatcher)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, dispatcher)
+		})
+	}
+}
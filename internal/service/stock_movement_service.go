@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// StockMovementService предоставляет методы для работы с журналом движения товаров.
+type StockMovementService struct {
+	repo repository.StockMovementRepository
+}
+
+// NewStockMovementService создает новый экземпляр StockMovementService.
+func NewStockMovementService(repo repository.StockMovementRepository) *StockMovementService {
+	return &StockMovementService{
+		repo: repo,
+	}
+}
+
+// GetStockMovements возвращает движения товаров на складе с учетом фильтров и пагинации.
+func (s *StockMovementService) GetStockMovements(ctx context.Context, filter *dto.StockMovementFilter) (*dto.StockMovementsResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.StockMovementService.GetStockMovements"),
+	)
+
+	movements, err := s.repo.GetStockMovements(ctx, filter)
+	if err != nil {
+		log.Error("error while getting stock movements from repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseStockMovementsToResponse(movements, filter.Pagination), nil
+}
+
+// parseStockMovementsToResponse преобразует записи журнала в ответ с пагинацией.
+func parseStockMovementsToResponse(movements []*domain.StockMovement, params *dto.Pagination) *dto.StockMovementsResponse {
+	resp := &dto.StockMovementsResponse{
+		Page:      params.Page,
+		Limit:     params.Limit,
+		Movements: make([]*dto.StockMovementResponse, 0, len(movements)),
+	}
+
+	for _, m := range movements {
+		movement := &dto.StockMovementResponse{
+			MovementID: m.ID.String(),
+			ProductID:  m.Product.ID.String(),
+			Delta:      m.Delta,
+			Reason:     string(m.Reason),
+			RequestID:  m.RequestID,
+			CreatedAt:  m.CreatedAt,
+		}
+		if m.StocktakeID != uuid.Nil {
+			movement.StocktakeID = m.StocktakeID.String()
+		}
+		if m.PurchaseOrderID != uuid.Nil {
+			movement.PurchaseOrderID = m.PurchaseOrderID.String()
+		}
+
+		resp.Movements = append(resp.Movements, movement)
+	}
+
+	return resp
+}
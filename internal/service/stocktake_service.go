@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// StocktakeService предоставляет методы для работы с пересчетами товаров на складах.
+type StocktakeService struct {
+	repo repository.StocktakeRepository
+}
+
+// NewStocktakeService создает новый экземпляр StocktakeService.
+func NewStocktakeService(repo repository.StocktakeRepository) *StocktakeService {
+	return &StocktakeService{
+		repo: repo,
+	}
+}
+
+// CreateStocktake открывает пересчет товаров на складе.
+func (s *StocktakeService) CreateStocktake(ctx context.Context, request *dto.StocktakeRequest) (*dto.StocktakeResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.StocktakeService.CreateStocktake"),
+	)
+
+	warehouseID, err := uuid.Parse(request.WarehouseID)
+	if err != nil {
+		log.Error("error while parsing warehouse ID", zap.Error(err))
+		return nil, err
+	}
+
+	stocktake := &domain.Stocktake{
+		Warehouse: &domain.Warehouse{ID: warehouseID},
+	}
+
+	for _, id := range request.ProductIDs {
+		productID, err := uuid.Parse(id)
+		if err != nil {
+			log.Error("error while parsing product ID", zap.Error(err))
+			return nil, err
+		}
+		stocktake.Lines = append(stocktake.Lines, &domain.StocktakeLine{
+			Product: &domain.Product{ID: productID},
+		})
+	}
+
+	err = s.repo.CreateStocktake(ctx, stocktake)
+	if err != nil {
+		log.Error("error while creating stocktake in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseStocktakeToResponse(stocktake), nil
+}
+
+// GetStocktake возвращает пересчет по его идентификатору.
+func (s *StocktakeService) GetStocktake(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.StocktakeService.GetStocktake"),
+	)
+
+	stocktake, err := s.repo.GetStocktake(ctx, stocktakeID.String())
+	if err != nil {
+		log.Error("error while getting stocktake from repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseStocktakeToResponse(stocktake), nil
+}
+
+// SubmitStocktakeCounts записывает посчитанное количество товаров.
+func (s *StocktakeService) SubmitStocktakeCounts(ctx context.Context, stocktakeID uuid.UUID, request *dto.StocktakeCountsRequest) (*dto.StocktakeResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.StocktakeService.SubmitStocktakeCounts"),
+	)
+
+	stocktake := &domain.Stocktake{
+		ID:    stocktakeID,
+		Lines: make([]*domain.StocktakeLine, 0, len(request.Counts)),
+	}
+
+	for _, count := range request.Counts {
+		productID, err := uuid.Parse(count.ProductID)
+		if err != nil {
+			log.Error("error while parsing product ID", zap.Error(err))
+			return nil, err
+		}
+		stocktake.Lines = append(stocktake.Lines, &domain.StocktakeLine{
+			Product:      &domain.Product{ID: productID},
+			CountedCount: count.CountedCount,
+		})
+	}
+
+	err := s.repo.SubmitStocktakeCounts(ctx, stocktake)
+	if err != nil {
+		log.Error("error while submitting stocktake counts in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseStocktakeToResponse(stocktake), nil
+}
+
+// GetStocktakeVariance возвращает отчет о расхождениях пересчета с текущими остатками.
+func (s *StocktakeService) GetStocktakeVariance(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeVarianceResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.StocktakeService.GetStocktakeVariance"),
+	)
+
+	stocktake, err := s.repo.GetStocktake(ctx, stocktakeID.String())
+	if err != nil {
+		log.Error("error while getting stocktake from repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseStocktakeToVarianceResponse(stocktake), nil
+}
+
+// PostStocktake проводит расхождения пересчета и закрывает его.
+func (s *StocktakeService) PostStocktake(ctx context.Context, stocktakeID uuid.UUID, request *dto.StocktakePostRequest) (*dto.StocktakeResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.StocktakeService.PostStocktake"),
+	)
+
+	productIDs := make([]uuid.UUID, 0, len(request.ProductIDs))
+	for _, id := range request.ProductIDs {
+		productID, err := uuid.Parse(id)
+		if err != nil {
+			log.Error("error while parsing product ID", zap.Error(err))
+			return nil, err
+		}
+		productIDs = append(productIDs, productID)
+	}
+
+	stocktake := &domain.Stocktake{ID: stocktakeID}
+
+	err := s.repo.PostStocktake(ctx, stocktake, productIDs)
+	if err != nil {
+		log.Error("error while posting stocktake in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseStocktakeToResponse(stocktake), nil
+}
+
+// CancelStocktake отменяет пересчет без изменения остатков.
+func (s *StocktakeService) CancelStocktake(ctx context.Context, stocktakeID uuid.UUID) (*dto.StocktakeResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.StocktakeService.CancelStocktake"),
+	)
+
+	stocktake := &domain.Stocktake{ID: stocktakeID}
+
+	err := s.repo.CancelStocktake(ctx, stocktake)
+	if err != nil {
+		log.Error("error while cancelling stocktake in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseStocktakeToResponse(stocktake), nil
+}
+
+// parseStocktakeToResponse преобразует пересчет в DTO.
+func parseStocktakeToResponse(stocktake *domain.Stocktake) *dto.StocktakeResponse {
+	resp := &dto.StocktakeResponse{
+		StocktakeID: stocktake.ID.String(),
+		WarehouseID: stocktake.Warehouse.ID.String(),
+		Status:      string(stocktake.Status),
+		Lines:       make([]*dto.StocktakeLineResponse, 0, len(stocktake.Lines)),
+		CreatedAt:   stocktake.CreatedAt,
+		ClosedAt:    stocktake.ClosedAt,
+	}
+
+	for _, line := range stocktake.Lines {
+		resp.Lines = append(resp.Lines, &dto.StocktakeLineResponse{
+			ProductID:     line.Product.ID.String(),
+			ProductName:   line.Product.Name,
+			ExpectedCount: line.ExpectedCount,
+			CountedCount:  line.CountedCount,
+			CountedAt:     line.CountedAt,
+			PostedDelta:   line.PostedDelta,
+		})
+	}
+
+	return resp
+}
+
+// parseStocktakeToVarianceResponse преобразует пересчет в отчет о расхождениях.
+func parseStocktakeToVarianceResponse(stocktake *domain.Stocktake) *dto.StocktakeVarianceResponse {
+	resp := &dto.StocktakeVarianceResponse{
+		StocktakeID: stocktake.ID.String(),
+		WarehouseID: stocktake.Warehouse.ID.String(),
+		Status:      string(stocktake.Status),
+		Lines:       make([]*dto.StocktakeVarianceLineResponse, 0, len(stocktake.Lines)),
+	}
+
+	for _, line := range stocktake.Lines {
+		if line.CountedCount == nil {
+			resp.UncountedLines++
+		} else {
+			resp.CountedLines++
+		}
+
+		variance := line.Variance()
+		if variance > 0 {
+			resp.Surplus += variance
+		} else {
+			resp.Shortage -= variance
+		}
+		resp.VarianceValue += line.VarianceValue()
+
+		resp.Lines = append(resp.Lines, &dto.StocktakeVarianceLineResponse{
+			ProductID:     line.Product.ID.String(),
+			ProductName:   line.Product.Name,
+			SystemCount:   line.SystemCount,
+			CountedCount:  line.CountedCount,
+			Variance:      variance,
+			VarianceValue: line.VarianceValue(),
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// SupplierService предоставляет методы для работы с поставщиками.
+type SupplierService struct {
+	repo repository.SupplierRepository
+}
+
+// NewSupplierService создает новый экземпляр SupplierService.
+func NewSupplierService(repo repository.SupplierRepository) *SupplierService {
+	return &SupplierService{
+		repo: repo,
+	}
+}
+
+// GetSuppliers возвращает список поставщиков.
+func (s *SupplierService) GetSuppliers(ctx context.Context) ([]*dto.SupplierResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.SupplierService.GetSuppliers"),
+	)
+
+	suppliers, err := s.repo.GetSuppliers(ctx)
+	if err != nil {
+		log.Error("error while getting suppliers from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := make([]*dto.SupplierResponse, 0, len(suppliers))
+	for _, supplier := range suppliers {
+		resp = append(resp, parseSupplierToResponse(supplier))
+	}
+
+	return resp, nil
+}
+
+// CreateSupplier создает нового поставщика.
+func (s *SupplierService) CreateSupplier(ctx context.Context, request *dto.SupplierRequest) (*dto.SupplierResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.SupplierService.CreateSupplier"),
+	)
+
+	supplier := &domain.Supplier{
+		Name:  request.Name,
+		Email: request.Email,
+		Phone: request.Phone,
+	}
+
+	err := s.repo.CreateSupplier(ctx, supplier)
+	if err != nil {
+		log.Error("error while creating supplier in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseSupplierToResponse(supplier), nil
+}
+
+// parseSupplierToResponse преобразует поставщика в DTO.
+func parseSupplierToResponse(supplier *domain.Supplier) *dto.SupplierResponse {
+	return &dto.SupplierResponse{
+		SupplierID: supplier.ID.String(),
+		Name:       supplier.Name,
+		Email:      supplier.Email,
+		Phone:      supplier.Phone,
+		CreatedAt:  supplier.CreatedAt,
+	}
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/domain"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// TransferService предоставляет методы для перемещения товаров между складами.
+type TransferService struct {
+	repo repository.TransferRepository
+}
+
+// NewTransferService создает новый экземпляр TransferService.
+func NewTransferService(repo repository.TransferRepository) *TransferService {
+	return &TransferService{
+		repo: repo,
+	}
+}
+
+// CreateTransfer создает перемещение товаров между складами.
+//
+// Если в запросе не указано, что товар отправляется в пути,
+// то перемещение выполняется сразу целиком.
+func (s *TransferService) CreateTransfer(ctx context.Context, request *dto.TransferRequest) (*dto.TransferResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.TransferService.CreateTransfer"),
+	)
+
+	transfer, err := parseTransferRequestToDomain(request)
+	if err != nil {
+		log.Error("error while parsing transfer request", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.repo.CreateTransfer(ctx, transfer)
+	if err != nil {
+		log.Error("error while creating transfer in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseTransferToResponse(transfer), nil
+}
+
+// parseTransferRequestToDomain преобразует запрос на перемещение в доменный объект.
+func parseTransferRequestToDomain(req *dto.TransferRequest) (*domain.Transfer, error) {
+	sourceID, err := uuid.Parse(req.SourceWarehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	destinationID, err := uuid.Parse(req.DestinationWarehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := &domain.Transfer{
+		Source:      &domain.Warehouse{ID: sourceID},
+		Destination: &domain.Warehouse{ID: destinationID},
+		Status:      domain.TransferReceived,
+	}
+	if req.InTransit {
+		transfer.Status = domain.TransferInTransit
+	}
+
+	for _, p := range req.Products {
+		productID, err := uuid.Parse(p.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		transfer.Products = append(transfer.Products, &domain.TransferProduct{
+			Product:      &domain.Product{ID: productID},
+			ProductCount: *p.Count,
+		})
+	}
+
+	return transfer, nil
+}
+
+// ReceiveTransfer принимает находящееся в пути перемещение на складе-получателе.
+func (s *TransferService) ReceiveTransfer(ctx context.Context, transferID uuid.UUID) (*dto.TransferResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.TransferService.ReceiveTransfer"),
+	)
+
+	transfer := &domain.Transfer{ID: transferID}
+
+	err := s.repo.ReceiveTransfer(ctx, transfer)
+	if err != nil {
+		log.Error("error while receiving transfer in repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseTransferToResponse(transfer), nil
+}
+
+// GetTransfer возвращает информацию о перемещении.
+func (s *TransferService) GetTransfer(ctx context.Context, transferID uuid.UUID) (*dto.TransferResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.TransferService.GetTransfer"),
+	)
+
+	transfer, err := s.repo.GetTransfer(ctx, transferID.String())
+	if err != nil {
+		log.Error("error while getting transfer from repository", zap.Error(err))
+		return nil, err
+	}
+
+	return parseTransferToResponse(transfer), nil
+}
+
+// parseTransferToResponse преобразует перемещение в DTO.
+func parseTransferToResponse(transfer *domain.Transfer) *dto.TransferResponse {
+	resp := &dto.TransferResponse{
+		TransferID:             transfer.ID.String(),
+		SourceWarehouseID:      transfer.Source.ID.String(),
+		DestinationWarehouseID: transfer.Destination.ID.String(),
+		Status:                 string(transfer.Status),
+		Products:               make([]*dto.TransferProductResponse, 0, len(transfer.Products)),
+		CreatedAt:              transfer.CreatedAt,
+		ReceivedAt:             transfer.ReceivedAt,
+	}
+
+	for _, p := range transfer.Products {
+		resp.Products = append(resp.Products, &dto.TransferProductResponse{
+			ProductID: p.Product.ID.String(),
+			Count:     p.ProductCount,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PIRSON21/mediasoft-intership2025/internal/dto"
+	"github.com/PIRSON21/mediasoft-intership2025/internal/repository"
+	"github.com/PIRSON21/mediasoft-intership2025/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ValuationService предоставляет методы для оценки себестоимости остатков товаров.
+type ValuationService struct {
+	repo repository.ValuationRepository
+}
+
+// NewValuationService создает новый экземпляр ValuationService.
+func NewValuationService(repo repository.ValuationRepository) *ValuationService {
+	return &ValuationService{
+		repo: repo,
+	}
+}
+
+// GetStockValuation возвращает оценку себестоимости остатков товаров на складе с пагинацией.
+func (s *ValuationService) GetStockValuation(ctx context.Context, params *dto.Pagination, warehouseID string) (*dto.StockValuationResponse, error) {
+	log := logger.GetLogger().With(
+		zap.String("op", "service.ValuationService.GetStockValuation"),
+	)
+
+	valuation, err := s.repo.GetStockValuation(ctx, warehouseID, params)
+	if err != nil {
+		log.Error("error while getting stock valuation from repository", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &dto.StockValuationResponse{
+		WarehouseID: valuation.Warehouse.ID.String(),
+		Method:      string(valuation.Method),
+		Page:        params.Page,
+		Limit:       params.Limit,
+		TotalValue:  valuation.TotalValue,
+		Products:    make([]*dto.ProductValuationResponse, 0, len(valuation.Products)),
+	}
+
+	for _, product := range valuation.Products {
+		resp.Products = append(resp.Products, &dto.ProductValuationResponse{
+			ProductID:     product.Product.ID.String(),
+			ProductName:   product.Product.Name,
+			ProductCount:  product.ProductCount,
+			UncostedCount: max(product.ProductCount-product.CostedCount, 0),
+			AverageCost:   product.AverageCost(),
+			StockValue:    product.Value,
+		})
+	}
+
+	return resp, nil
+}
@@ -41,10 +41,14 @@ func createWarehouseListResponse(warehouses []*domain.Warehouse) []*dto.Warehous
 	warehousesResp := make([]*dto.WarehouseAtListResponse, 0, len(warehouses))
 
 	for _, v := range warehouses {
-		warehousesResp = append(warehousesResp, &dto.WarehouseAtListResponse{
+		resp := &dto.WarehouseAtListResponse{
 			ID:      v.ID.String(),
 			Address: v.Address,
-		})
+		}
+		if v.Location != nil {
+			resp.Latitude, resp.Longitude = &v.Location.Latitude, &v.Location.Longitude
+		}
+		warehousesResp = append(warehousesResp, resp)
 	}
 	return warehousesResp
 }
@@ -56,6 +60,12 @@ func (s *WarehouseService) CreateWarehouse(ctx context.Context, request *dto.War
 	warehouse := domain.Warehouse{
 		Address: request.Address,
 	}
+	if request.Latitude != nil && request.Longitude != nil {
+		warehouse.Location = &domain.Location{
+			Latitude:  *request.Latitude,
+			Longitude: *request.Longitude,
+		}
+	}
 
 	if err := s.repo.CreateWarehouse(ctx, &warehouse); err != nil {
 		log.Error("error while creating warehouse", zap.String("err", err.Error()))
@@ -2,6 +2,7 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -12,6 +13,12 @@ type Config struct {
 	Address     string `env:"ADDRESS" env-default:":8080"`
 	DBConfig
 	LoggerConfig
+	ReservationConfig
+	IdempotencyConfig
+	AnalyticsOutboxConfig
+	StockAlertConfig
+	ValuationConfig
+	FulfillmentConfig
 }
 
 // DBConfig - конфигурация базы данных.
@@ -29,6 +36,55 @@ type LoggerConfig struct {
 	Level string `env:"LEVEL" env-default:"INFO"`
 }
 
+// ReservationConfig - конфигурация резервирования товаров.
+type ReservationConfig struct {
+	ReservationTTL           time.Duration `env:"RESERVATION_TTL" env-default:"15m"`
+	ReservationSweepInterval time.Duration `env:"RESERVATION_SWEEP_INTERVAL" env-default:"1m"`
+}
+
+// IdempotencyConfig - конфигурация хранения ключей идемпотентности.
+//
+// Ключ, ответ по которому не сохранен дольше IdempotencyLockTTL, может быть захвачен
+// повторным запросом. Ключи удаляются через IdempotencyKeyTTL после захвата.
+type IdempotencyConfig struct {
+	IdempotencyLockTTL       time.Duration `env:"IDEMPOTENCY_LOCK_TTL" env-default:"1m"`
+	IdempotencyKeyTTL        time.Duration `env:"IDEMPOTENCY_KEY_TTL" env-default:"24h"`
+	IdempotencySweepInterval time.Duration `env:"IDEMPOTENCY_SWEEP_INTERVAL" env-default:"1h"`
+}
+
+// AnalyticsOutboxConfig - конфигурация переноса событий продаж в аналитику.
+type AnalyticsOutboxConfig struct {
+	AnalyticsDispatchInterval time.Duration `env:"ANALYTICS_DISPATCH_INTERVAL" env-default:"5s"`
+	AnalyticsDispatchBatch    int           `env:"ANALYTICS_DISPATCH_BATCH" env-default:"100"`
+	AnalyticsRetryDelay       time.Duration `env:"ANALYTICS_RETRY_DELAY" env-default:"30s"`
+}
+
+// StockAlertConfig - конфигурация оповещений об остатках товаров.
+//
+// Если адрес вебхука не задан, то оповещения записываются в лог.
+// Оповещения доставляются из outbox в фоне.
+type StockAlertConfig struct {
+	StockAlertWebhookURL       string        `env:"STOCK_ALERT_WEBHOOK_URL"`
+	StockAlertWebhookTimeout   time.Duration `env:"STOCK_ALERT_WEBHOOK_TIMEOUT" env-default:"5s"`
+	StockAlertDispatchInterval time.Duration `env:"STOCK_ALERT_DISPATCH_INTERVAL" env-default:"5s"`
+	StockAlertDispatchBatch    int           `env:"STOCK_ALERT_DISPATCH_BATCH" env-default:"100"`
+	StockAlertRetryDelay       time.Duration `env:"STOCK_ALERT_RETRY_DELAY" env-default:"30s"`
+}
+
+// ValuationConfig - конфигурация оценки себестоимости товаров.
+//
+// Метод оценки: fifo или average.
+type ValuationConfig struct {
+	ValuationMethod string `env:"VALUATION_METHOD" env-default:"fifo"`
+}
+
+// FulfillmentConfig - конфигурация сборки заказа с нескольких складов.
+//
+// Стратегия по умолчанию: fewest_shipments, cheapest или nearest.
+type FulfillmentConfig struct {
+	FulfillmentStrategy string `env:"FULFILLMENT_STRATEGY" env-default:"fewest_shipments"`
+}
+
 // MustParseConfig читает данные конфига из переменных окружения.
 //
 // При ошибке возвращает панику.